@@ -13,4 +13,9 @@ var (
 	ActivityTaskTeamArray      = ActivityType(6)
 	ActivityTaskWasDeleted     = ActivityType(8)
 	ActivityTaskFileWasDeleted = ActivityType(9)
+	ActivityTaskLinkAdded      = ActivityType(10)
+	ActivityTaskLinkRemoved    = ActivityType(11)
+	ActivityTaskWasRestored    = ActivityType(12)
+	ActivityTaskWasMerged      = ActivityType(13)
+	ActivityTaskChecklist      = ActivityType(14)
 )
@@ -0,0 +1,468 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// Automation triggers - task events which run the rules.
+const (
+	AutomationTaskCreated   = "task_created"
+	AutomationStatusChanged = "status_changed"
+	AutomationFieldChanged  = "field_changed"
+	AutomationCommentAdded  = "comment_added"
+	AutomationDuePassed     = "due_passed"
+)
+
+// Automation actions.
+const (
+	AutomationSetField       = "set_field"
+	AutomationSetTeam        = "set_team"
+	AutomationAddTag         = "add_tag"
+	AutomationCreateSubtask  = "create_subtask"
+	AutomationSendSms        = "send_sms"
+	AutomationSendEmail      = "send_email"
+	AutomationCreateReminder = "create_reminder"
+)
+
+// Automation run statuses.
+const (
+	AutomationRunDone    = "done"
+	AutomationRunFailed  = "failed"
+	AutomationRunStopped = "stopped"
+)
+
+// Task roles used by conditions and actions, created_by can be only the source of the user.
+const (
+	AutomationImplementBy   = "implement_by"
+	AutomationResponsibleBy = "responsible_by"
+	AutomationManagedBy     = "managed_by"
+	AutomationCreatedBy     = "created_by"
+)
+
+const (
+	// MaxAutomationDepth - max length of the chain of rules triggered by changes of other rules.
+	MaxAutomationDepth = 3
+	// AutomationDueLookback - due_passed is triggered only during this time after the deadline,
+	// so long overdue tasks are not processed when the rule is created.
+	AutomationDueLookback = 24 * time.Hour
+
+	automationMaxItems  = 10
+	automationMaxText   = 1000
+	automationMaxOffset = 30 * 24 * 60
+)
+
+// AutomationActor - creator of changes made by rules. Task events of the actor do not run rules,
+// changes of rules are passed to the engine with the chain instead.
+var AutomationActor = Creator{UUID: uuid.Nil, Email: "automation"}
+
+type AutomationTrigger struct {
+	Type string `json:"type"`
+	// Status - new status for status_changed, any status when nil
+	Status *int `json:"status,omitempty"`
+	// Field - hash of the field for field_changed, any field when empty
+	Field string `json:"field,omitempty"`
+}
+
+// AutomationCondition - check of the task: Attr is status, priority, tag, task role or custom field as "fields.<hash>".
+type AutomationCondition struct {
+	Attr  string      `json:"attr"`
+	Op    string      `json:"op"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// AutomationAction - change made by the rule. Params depend on the type:
+// set_field - Field and Value (nil clears the field); set_team - Role and User (email or the role to copy from);
+// add_tag - Tag; create_subtask - Name; send_sms, send_email - Role and Text;
+// create_reminder - Role, Text and Offset in minutes from now. Text and Name can use {id} and {name} of the task.
+type AutomationAction struct {
+	Type   string      `json:"type"`
+	Field  string      `json:"field,omitempty"`
+	Value  interface{} `json:"value,omitempty"`
+	Role   string      `json:"role,omitempty"`
+	User   string      `json:"user,omitempty"`
+	Tag    string      `json:"tag,omitempty"`
+	Name   string      `json:"name,omitempty"`
+	Text   string      `json:"text,omitempty"`
+	Offset int         `json:"offset,omitempty"`
+}
+
+type AutomationRule struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+
+	Name    string
+	Enabled bool
+
+	Trigger    AutomationTrigger
+	Conditions []AutomationCondition
+	Actions    []AutomationAction
+
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AutomationRun - log record of the rule applied to the task.
+type AutomationRun struct {
+	UUID      uuid.UUID
+	RuleUUID  uuid.UUID
+	TaskUUID  uuid.UUID
+	Trigger   string
+	Depth     int
+	Status    string
+	Error     string
+	Actions   int
+	CreatedAt time.Time
+}
+
+// TaskEvent - change of the task which can run automation rules. Depth and Rules are the chain of rules
+// which caused the event, they are empty for changes made by users.
+type TaskEvent struct {
+	Trigger     string
+	TaskUUID    uuid.UUID
+	ProjectUUID uuid.UUID
+	Actor       string
+	Status      int
+	Fields      []string
+
+	Depth int
+	Rules []uuid.UUID
+}
+
+func GetAutomationTriggers() []string {
+	return []string{AutomationTaskCreated, AutomationStatusChanged, AutomationFieldChanged, AutomationCommentAdded, AutomationDuePassed}
+}
+
+func GetAutomationActions() []string {
+	return []string{AutomationSetField, AutomationSetTeam, AutomationAddTag, AutomationCreateSubtask, AutomationSendSms, AutomationSendEmail, AutomationCreateReminder}
+}
+
+func getAutomationRoles() []string {
+	return []string{AutomationImplementBy, AutomationResponsibleBy, AutomationManagedBy}
+}
+
+func NewAutomationRule(crtr Creator, federationUUID, companyUUID, projectUUID uuid.UUID, name string, trigger AutomationTrigger, conditions []AutomationCondition, actions []AutomationAction) (AutomationRule, error) {
+	r := AutomationRule{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		CompanyUUID:    companyUUID,
+		ProjectUUID:    projectUUID,
+		Name:           strings.TrimSpace(name),
+		Enabled:        true,
+		Trigger:        trigger,
+		Conditions:     conditions,
+		Actions:        actions,
+		CreatedBy:      crtr.Email,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	return r, r.Validate()
+}
+
+// Validate checks the rule structure, fields and users are checked against the project by the service.
+func (r AutomationRule) Validate() error {
+	if r.Name == "" || utf8.RuneCountInString(r.Name) > 100 {
+		return errors.New("название правила от 1 до 100 символов")
+	}
+
+	if !lo.Contains(GetAutomationTriggers(), r.Trigger.Type) {
+		return fmt.Errorf("неизвестный триггер: %s", r.Trigger.Type)
+	}
+
+	if r.Trigger.Status != nil && r.Trigger.Type != AutomationStatusChanged {
+		return errors.New("статус указывается только для триггера status_changed")
+	}
+
+	if r.Trigger.Field != "" && r.Trigger.Type != AutomationFieldChanged {
+		return errors.New("поле указывается только для триггера field_changed")
+	}
+
+	if len(r.Conditions) > automationMaxItems {
+		return fmt.Errorf("условий не больше %d", automationMaxItems)
+	}
+
+	for _, c := range r.Conditions {
+		err := c.validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(r.Actions) == 0 || len(r.Actions) > automationMaxItems {
+		return fmt.Errorf("действий должно быть от 1 до %d", automationMaxItems)
+	}
+
+	for _, a := range r.Actions {
+		err := a.validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Fields returns hashes of custom fields used by the rule.
+func (r AutomationRule) Fields() []string {
+	fields := []string{}
+
+	if r.Trigger.Field != "" {
+		fields = append(fields, r.Trigger.Field)
+	}
+
+	for _, c := range r.Conditions {
+		if hash, ok := strings.CutPrefix(c.Attr, "fields."); ok {
+			fields = append(fields, hash)
+		}
+	}
+
+	for _, a := range r.Actions {
+		if a.Type == AutomationSetField {
+			fields = append(fields, a.Field)
+		}
+	}
+
+	return lo.Uniq(fields)
+}
+
+// Matches checks the event against the trigger of the rule.
+func (r AutomationRule) Matches(ev TaskEvent) bool {
+	if !r.Enabled || r.Trigger.Type != ev.Trigger || r.ProjectUUID != ev.ProjectUUID {
+		return false
+	}
+
+	switch ev.Trigger {
+	case AutomationStatusChanged:
+		return r.Trigger.Status == nil || *r.Trigger.Status == ev.Status
+	case AutomationFieldChanged:
+		return r.Trigger.Field == "" || lo.Contains(ev.Fields, r.Trigger.Field)
+	}
+
+	return true
+}
+
+// Check evaluates all conditions of the rule on the task.
+func (r AutomationRule) Check(task Task) bool {
+	for _, c := range r.Conditions {
+		if !c.Match(task) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c AutomationCondition) validate() error {
+	switch {
+	case c.Attr == "status", c.Attr == "priority", c.Attr == "tag", lo.Contains(getAutomationRoles(), c.Attr):
+	case strings.HasPrefix(c.Attr, "fields.") && len(c.Attr) > len("fields."):
+	default:
+		return fmt.Errorf("неизвестный атрибут условия: %s", c.Attr)
+	}
+
+	switch c.Op {
+	case FilterEmpty, FilterNotEmpty:
+		return nil
+	case FilterEq, FilterNe:
+	case FilterGt, FilterLt:
+		if _, ok := c.Value.(float64); !ok {
+			return fmt.Errorf("условие %s %s: ожидается число", c.Attr, c.Op)
+		}
+	case FilterIn:
+		if items, ok := c.Value.([]interface{}); !ok || len(items) == 0 {
+			return fmt.Errorf("условие %s %s: ожидается список", c.Attr, c.Op)
+		}
+	default:
+		return fmt.Errorf("неизвестная операция условия: %s", c.Op)
+	}
+
+	if c.Value == nil {
+		return fmt.Errorf("условие %s %s: не указано значение", c.Attr, c.Op)
+	}
+
+	return nil
+}
+
+// Match evaluates the condition, numbers are compared as float64 like in json.
+func (c AutomationCondition) Match(task Task) bool {
+	if c.Attr == "tag" {
+		switch c.Op {
+		case FilterEmpty:
+			return len(task.Tags) == 0
+		case FilterNotEmpty:
+			return len(task.Tags) > 0
+		case FilterEq:
+			return lo.Contains(task.Tags, fmt.Sprint(c.Value))
+		case FilterNe:
+			return !lo.Contains(task.Tags, fmt.Sprint(c.Value))
+		case FilterIn:
+			items, _ := c.Value.([]interface{})
+			return lo.SomeBy(items, func(i interface{}) bool { return lo.Contains(task.Tags, fmt.Sprint(i)) })
+		}
+
+		return false
+	}
+
+	v := task.automationAttr(c.Attr)
+
+	switch c.Op {
+	case FilterEmpty:
+		return isEmptyFieldValue(v)
+	case FilterNotEmpty:
+		return !isEmptyFieldValue(v)
+	case FilterEq:
+		return reflect.DeepEqual(fieldRuleValue(v), fieldRuleValue(c.Value))
+	case FilterNe:
+		return !reflect.DeepEqual(fieldRuleValue(v), fieldRuleValue(c.Value))
+	case FilterIn:
+		items, _ := c.Value.([]interface{})
+		return lo.SomeBy(items, func(i interface{}) bool { return reflect.DeepEqual(fieldRuleValue(v), fieldRuleValue(i)) })
+	case FilterGt, FilterLt:
+		n, ok := fieldRuleValue(v).(float64)
+		if !ok {
+			return false
+		}
+
+		if c.Op == FilterGt {
+			return n > c.Value.(float64)
+		}
+
+		return n < c.Value.(float64)
+	}
+
+	return false
+}
+
+func (t Task) automationAttr(attr string) interface{} {
+	switch attr {
+	case "status":
+		return t.Status
+	case "priority":
+		return t.Priority
+	}
+
+	if hash, ok := strings.CutPrefix(attr, "fields."); ok {
+		return t.Fields[hash]
+	}
+
+	return t.TeamMember(attr)
+}
+
+// TeamMember returns email of the task role, empty for unknown role.
+func (t Task) TeamMember(role string) string {
+	switch role {
+	case AutomationImplementBy:
+		return t.ImplementBy
+	case AutomationResponsibleBy:
+		return t.ResponsibleBy
+	case AutomationManagedBy:
+		return t.ManagedBy
+	case AutomationCreatedBy:
+		return t.CreatedBy
+	}
+
+	return ""
+}
+
+func (a AutomationAction) validate() error {
+	switch a.Type {
+	case AutomationSetField:
+		if a.Field == "" {
+			return errors.New("set_field: не указано поле")
+		}
+	case AutomationSetTeam:
+		if !lo.Contains(getAutomationRoles(), a.Role) {
+			return fmt.Errorf("set_team: неизвестная роль %s", a.Role)
+		}
+
+		if a.User == "" {
+			return errors.New("set_team: не указан пользователь")
+		}
+	case AutomationAddTag:
+		if strings.TrimSpace(a.Tag) == "" {
+			return errors.New("add_tag: не указан тег")
+		}
+	case AutomationCreateSubtask:
+		if strings.TrimSpace(a.Name) == "" {
+			return errors.New("create_subtask: не указано название")
+		}
+	case AutomationSendSms, AutomationSendEmail, AutomationCreateReminder:
+		if !lo.Contains(append(getAutomationRoles(), AutomationCreatedBy), a.Role) {
+			return fmt.Errorf("%s: неизвестная роль %s", a.Type, a.Role)
+		}
+
+		if strings.TrimSpace(a.Text) == "" || utf8.RuneCountInString(a.Text) > automationMaxText {
+			return fmt.Errorf("%s: текст от 1 до %d символов", a.Type, automationMaxText)
+		}
+
+	default:
+		return fmt.Errorf("неизвестное действие: %s", a.Type)
+	}
+
+	if a.Offset != 0 && a.Type != AutomationCreateReminder {
+		return fmt.Errorf("%s: смещение указывается только для напоминания", a.Type)
+	}
+
+	if a.Offset < 0 || a.Offset > automationMaxOffset {
+		return fmt.Errorf("%s: смещение от 0 до %d минут", a.Type, automationMaxOffset)
+	}
+
+	return nil
+}
+
+// TeamUser returns the email for set_team: the user of the task role or the email itself.
+func (a AutomationAction) TeamUser(task Task) string {
+	if lo.Contains(append(getAutomationRoles(), AutomationCreatedBy), a.User) {
+		return task.TeamMember(a.User)
+	}
+
+	return a.User
+}
+
+// AutomationText puts {id} and {name} of the task to the text.
+func AutomationText(text string, task Task) string {
+	return strings.NewReplacer("{id}", strconv.Itoa(task.ID), "{name}", task.Name).Replace(text)
+}
+
+// Next returns the event caused by the rule, it carries the chain of rules to stop loops.
+func (ev TaskEvent) Next(rule uuid.UUID, trigger string, taskUUID uuid.UUID, fields []string) TaskEvent {
+	return TaskEvent{
+		Trigger:     trigger,
+		TaskUUID:    taskUUID,
+		ProjectUUID: ev.ProjectUUID,
+		Actor:       AutomationActor.Email,
+		Fields:      fields,
+		Depth:       ev.Depth + 1,
+		Rules:       append(append([]uuid.UUID{}, ev.Rules...), rule),
+	}
+}
+
+// Stopped tells if the rule can not run for the event: it is already in the chain or the chain is too long.
+func (ev TaskEvent) Stopped(rule uuid.UUID) bool {
+	return ev.Depth >= MaxAutomationDepth || lo.Contains(ev.Rules, rule)
+}
+
+// ChangedFields returns hashes of the fields whose values differ, changes are new values by hash.
+func ChangedFields(values, changes map[string]interface{}) []string {
+	changed := []string{}
+
+	for hash, v := range changes {
+		if !reflect.DeepEqual(fieldRuleValue(v), fieldRuleValue(values[hash])) {
+			changed = append(changed, hash)
+		}
+	}
+
+	return changed
+}
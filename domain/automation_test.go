@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestAutomationRule(t *testing.T) {
+	projectUUID := uuid.New()
+	done := StatusDone
+
+	rule, err := NewAutomationRule(Creator{Email: "a@a.ru"}, uuid.New(), uuid.New(), projectUUID, "Закрытие",
+		AutomationTrigger{Type: AutomationStatusChanged, Status: &done},
+		[]AutomationCondition{
+			{Attr: "fields.sum", Op: FilterGt, Value: 100.0},
+			{Attr: "tag", Op: FilterIn, Value: []interface{}{"vip", "b2b"}},
+			{Attr: AutomationImplementBy, Op: FilterNotEmpty},
+		},
+		[]AutomationAction{{Type: AutomationSetField, Field: "closed", Value: true}},
+	)
+	if err != nil {
+		t.Fatalf("NewAutomationRule() error = %v", err)
+	}
+
+	task := Task{ProjectUUID: projectUUID, Status: StatusDone, Tags: []string{"vip"}, ImplementBy: "b@b.ru", Fields: map[string]interface{}{"sum": 150}}
+	ev := TaskEvent{Trigger: AutomationStatusChanged, ProjectUUID: projectUUID, Status: StatusDone}
+
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{name: "matches", got: rule.Matches(ev), want: true},
+		{name: "other status", got: rule.Matches(TaskEvent{Trigger: AutomationStatusChanged, ProjectUUID: projectUUID, Status: StatusCancel})},
+		{name: "other trigger", got: rule.Matches(TaskEvent{Trigger: AutomationFieldChanged, ProjectUUID: projectUUID})},
+		{name: "conditions", got: rule.Check(task), want: true},
+		{name: "condition failed", got: rule.Check(Task{Tags: []string{"vip"}, ImplementBy: "b@b.ru", Fields: map[string]interface{}{"sum": 50.0}})},
+		{name: "chain", got: ev.Next(rule.UUID, AutomationFieldChanged, task.UUID, nil).Stopped(uuid.New())},
+		{name: "loop", got: ev.Next(rule.UUID, AutomationFieldChanged, task.UUID, nil).Stopped(rule.UUID), want: true},
+		{name: "depth", got: TaskEvent{Depth: MaxAutomationDepth}.Stopped(rule.UUID), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutomationRuleValidate(t *testing.T) {
+	action := AutomationAction{Type: AutomationAddTag, Tag: "vip"}
+
+	tests := []struct {
+		name    string
+		rule    AutomationRule
+		wantErr bool
+	}{
+		{name: "valid", rule: AutomationRule{Name: "r", Trigger: AutomationTrigger{Type: AutomationTaskCreated}, Actions: []AutomationAction{action}}},
+		{name: "no name", rule: AutomationRule{Trigger: AutomationTrigger{Type: AutomationTaskCreated}, Actions: []AutomationAction{action}}, wantErr: true},
+		{name: "unknown trigger", rule: AutomationRule{Name: "r", Trigger: AutomationTrigger{Type: "x"}, Actions: []AutomationAction{action}}, wantErr: true},
+		{name: "field of other trigger", rule: AutomationRule{Name: "r", Trigger: AutomationTrigger{Type: AutomationTaskCreated, Field: "a"}, Actions: []AutomationAction{action}}, wantErr: true},
+		{name: "no actions", rule: AutomationRule{Name: "r", Trigger: AutomationTrigger{Type: AutomationTaskCreated}}, wantErr: true},
+		{name: "bad condition", rule: AutomationRule{Name: "r", Trigger: AutomationTrigger{Type: AutomationTaskCreated}, Conditions: []AutomationCondition{{Attr: "priority", Op: FilterGt, Value: "x"}}, Actions: []AutomationAction{action}}, wantErr: true},
+		{name: "bad role", rule: AutomationRule{Name: "r", Trigger: AutomationTrigger{Type: AutomationTaskCreated}, Actions: []AutomationAction{{Type: AutomationSendEmail, Role: "x", Text: "t"}}}, wantErr: true},
+		{name: "offset", rule: AutomationRule{Name: "r", Trigger: AutomationTrigger{Type: AutomationTaskCreated}, Actions: []AutomationAction{{Type: AutomationSendSms, Role: AutomationImplementBy, Text: "t", Offset: 10}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rule.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	BulkSetStatus   = "set-status"
+	BulkSetTeam     = "set-team"
+	BulkAddTags     = "add-tags"
+	BulkRemoveTags  = "remove-tags"
+	BulkMoveProject = "move-project"
+	BulkDelete      = "delete"
+)
+
+// BulkMaxTasks - max tasks in one bulk operation.
+const BulkMaxTasks = 500
+
+var (
+	ErrBulkInvalidOperation = errors.New("неизвестная операция")
+	ErrBulkEmpty            = errors.New("не выбраны задачи")
+	ErrBulkTooMany          = fmt.Errorf("за один раз можно изменить не больше %d задач", BulkMaxTasks)
+)
+
+func GetBulkOperations() []string {
+	return []string{BulkSetStatus, BulkSetTeam, BulkAddTags, BulkRemoveTags, BulkMoveProject, BulkDelete}
+}
+
+// BulkOperation - one operation applied to many tasks, every task is changed separately.
+type BulkOperation struct {
+	UUID      uuid.UUID
+	Operation string
+	TaskUUIDs []uuid.UUID
+}
+
+func NewBulkOperation(operation string, taskUUIDs []uuid.UUID) (BulkOperation, error) {
+	op := BulkOperation{
+		UUID:      uuid.New(),
+		Operation: operation,
+		TaskUUIDs: lo.Uniq(taskUUIDs),
+	}
+
+	if lo.IndexOf(GetBulkOperations(), operation) == -1 {
+		return op, ErrBulkInvalidOperation
+	}
+
+	if len(op.TaskUUIDs) == 0 {
+		return op, ErrBulkEmpty
+	}
+
+	if len(op.TaskUUIDs) > BulkMaxTasks {
+		return op, ErrBulkTooMany
+	}
+
+	return op, nil
+}
+
+// BulkResult - result of the operation for one task, Error is empty on success.
+type BulkResult struct {
+	TaskUUID uuid.UUID
+	Error    string
+}
+
+func (r BulkResult) Ok() bool {
+	return r.Error == ""
+}
+
+// AddTags returns tags with added items, order is kept.
+func AddTags(tags, add []string) []string {
+	return lo.Uniq(append(append([]string{}, tags...), add...))
+}
+
+// RemoveTags returns tags without removed items.
+func RemoveTags(tags, remove []string) []string {
+	return lo.Filter(tags, func(tag string, _ int) bool {
+		return lo.IndexOf(remove, tag) == -1
+	})
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewBulkOperation(t *testing.T) {
+	uid := uuid.New()
+
+	many := make([]uuid.UUID, BulkMaxTasks+1)
+	for i := range many {
+		many[i] = uuid.New()
+	}
+
+	tests := []struct {
+		name      string
+		operation string
+		uuids     []uuid.UUID
+		want      int
+		wantErr   error
+	}{
+		{name: "duplicates are removed", operation: BulkDelete, uuids: []uuid.UUID{uid, uid}, want: 1},
+		{name: "unknown operation", operation: "archive", uuids: []uuid.UUID{uid}, wantErr: ErrBulkInvalidOperation},
+		{name: "no tasks", operation: BulkSetStatus, wantErr: ErrBulkEmpty},
+		{name: "too many tasks", operation: BulkSetStatus, uuids: many, wantErr: ErrBulkTooMany},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, err := NewBulkOperation(tt.operation, tt.uuids)
+			if err != tt.wantErr {
+				t.Fatalf("NewBulkOperation() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && len(op.TaskUUIDs) != tt.want {
+				t.Errorf("NewBulkOperation() tasks = %v, want %v", len(op.TaskUUIDs), tt.want)
+			}
+		})
+	}
+}
+
+func TestBulkTags(t *testing.T) {
+	tags := []string{"a", "b"}
+
+	if got := AddTags(tags, []string{"b", "c"}); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("AddTags() = %v", got)
+	}
+
+	if got := RemoveTags(tags, []string{"a", "x"}); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Errorf("RemoveTags() = %v", got)
+	}
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MaxChecklistItems - max items in the checklist of one task.
+const MaxChecklistItems = 100
+
+// Checklist actions stored in activities.
+const (
+	ChecklistAdded     = "added"
+	ChecklistChanged   = "changed"
+	ChecklistDone      = "done"
+	ChecklistUndone    = "undone"
+	ChecklistMoved     = "moved"
+	ChecklistRemoved   = "removed"
+	ChecklistConverted = "converted"
+)
+
+var (
+	ErrChecklistText     = errors.New("текст пункта должен быть от 1 до 500 символов")
+	ErrChecklistFull     = fmt.Errorf("в чек-листе может быть не больше %d пунктов", MaxChecklistItems)
+	ErrChecklistNotFound = errors.New("пункт чек-листа не найден")
+)
+
+// ChecklistItem - small step of the task, it is not a task in the tree till it is converted.
+type ChecklistItem struct {
+	UUID     uuid.UUID
+	TaskUUID uuid.UUID
+
+	Text       string
+	Done       bool
+	DoneAt     *time.Time
+	DoneBy     string
+	AssignedTo string
+	DueAt      *time.Time
+
+	// Rank - position in the checklist, see RankBetween
+	Rank string
+
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+func NewChecklistItem(crtr Creator, taskUUID uuid.UUID, text, assignedTo string, dueAt *time.Time, rank string) (ChecklistItem, error) {
+	item := ChecklistItem{
+		UUID:       uuid.New(),
+		TaskUUID:   taskUUID,
+		AssignedTo: assignedTo,
+		DueAt:      dueAt,
+		Rank:       rank,
+		CreatedBy:  crtr.Email,
+		CreatedAt:  time.Now(),
+	}
+
+	return item, item.SetText(text)
+}
+
+func (i *ChecklistItem) SetText(text string) error {
+	text = strings.TrimSpace(text)
+
+	if text == "" || utf8.RuneCountInString(text) > 500 {
+		return ErrChecklistText
+	}
+
+	i.Text = text
+
+	return nil
+}
+
+func (i *ChecklistItem) SetDone(done bool, by string, at time.Time) {
+	i.Done = done
+	i.DoneBy = ""
+	i.DoneAt = nil
+
+	if done {
+		i.DoneBy = by
+		i.DoneAt = &at
+	}
+}
+
+// ChecklistProgress - "3/7", empty for the task without checklist.
+func ChecklistProgress(done, total int) string {
+	if total == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d/%d", done, total)
+}
+
+// ChecklistRanks returns new ranks to place the item after afterUUID (nil - first).
+// Items are sorted by rank. When there is no room between neighbours all items get new ranks.
+func ChecklistRanks(items []ChecklistItem, uid uuid.UUID, afterUUID *uuid.UUID) (ranks map[uuid.UUID]string, err error) {
+	others := lo.Filter(items, func(item ChecklistItem, _ int) bool { return item.UUID != uid })
+
+	pos := 0
+	if afterUUID != nil {
+		i := lo.IndexOf(lo.Map(others, func(item ChecklistItem, _ int) uuid.UUID { return item.UUID }), *afterUUID)
+		if i == -1 {
+			return ranks, ErrChecklistNotFound
+		}
+
+		pos = i + 1
+	}
+
+	prev, next := "", ""
+	if pos > 0 {
+		prev = others[pos-1].Rank
+	}
+
+	if pos < len(others) {
+		next = others[pos].Rank
+	}
+
+	rank, err := RankBetween(prev, next)
+	if err == nil && len(rank) <= RankMaxLen {
+		return map[uuid.UUID]string{uid: rank}, nil
+	}
+
+	ordered := append(append(append([]uuid.UUID{}, lo.Map(others[:pos], func(item ChecklistItem, _ int) uuid.UUID { return item.UUID })...), uid),
+		lo.Map(others[pos:], func(item ChecklistItem, _ int) uuid.UUID { return item.UUID })...)
+
+	sequence := RankSequence(len(ordered))
+
+	ranks = make(map[uuid.UUID]string, len(ordered))
+	for i, u := range ordered {
+		ranks[u] = sequence[i]
+	}
+
+	return ranks, nil
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func TestChecklistRanks(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name  string
+		ranks []string
+		uid   uuid.UUID
+		after *uuid.UUID
+		want  []uuid.UUID
+	}{
+		{"first", []string{"a", "b", "c"}, c, nil, []uuid.UUID{c, a, b}},
+		{"middle", []string{"a", "b", "c"}, c, &a, []uuid.UUID{a, c, b}},
+		{"last", []string{"a", "b", "c"}, a, &c, []uuid.UUID{b, c, a}},
+		{"no room", []string{"a", "a", "a"}, a, &b, []uuid.UUID{b, a, c}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := []ChecklistItem{{UUID: a, Rank: tt.ranks[0]}, {UUID: b, Rank: tt.ranks[1]}, {UUID: c, Rank: tt.ranks[2]}}
+
+			ranks, err := ChecklistRanks(items, tt.uid, tt.after)
+			if err != nil {
+				t.Fatalf("ChecklistRanks() error: %v", err)
+			}
+
+			for i := range items {
+				if r, ok := ranks[items[i].UUID]; ok {
+					items[i].Rank = r
+				}
+			}
+
+			sort.SliceStable(items, func(i, j int) bool { return items[i].Rank < items[j].Rank })
+
+			got := lo.Map(items, func(item ChecklistItem, _ int) uuid.UUID { return item.UUID })
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChecklistRanks() order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChecklistProgress(t *testing.T) {
+	if got := ChecklistProgress(3, 7); got != "3/7" {
+		t.Errorf("ChecklistProgress() = %q, want 3/7", got)
+	}
+
+	if got := ChecklistProgress(0, 0); got != "" {
+		t.Errorf("ChecklistProgress() = %q, want empty", got)
+	}
+}
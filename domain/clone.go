@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MaxCloneTasks - max tasks copied at once with the subtree.
+const MaxCloneTasks = 500
+
+// CloneField - project field used to move values of the copied task to another project.
+type CloneField struct {
+	Hash     string
+	Name     string
+	DataType FieldDataType
+}
+
+// RemapFields moves values to fields of the target project: by the same hash (company field),
+// otherwise by the same name and data type. Values of fields missing in the target are dropped.
+func RemapFields(values map[string]interface{}, from, to []CloneField) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	toByHash := lo.KeyBy(to, func(f CloneField) string { return f.Hash })
+	fromByHash := lo.KeyBy(from, func(f CloneField) string { return f.Hash })
+
+	for hash, value := range values {
+		if _, ok := toByHash[hash]; ok {
+			result[hash] = value
+			continue
+		}
+
+		source, ok := fromByHash[hash]
+		if !ok {
+			continue
+		}
+
+		target, ok := lo.Find(to, func(f CloneField) bool {
+			return f.Name == source.Name && f.DataType == source.DataType
+		})
+		if !ok {
+			continue
+		}
+
+		if _, taken := values[target.Hash]; taken {
+			continue
+		}
+
+		result[target.Hash] = value
+	}
+
+	return result
+}
+
+// Clone returns a new task with attributes, team and fields of t under the parent path.
+// The copy is not started: status, stops, logged time and comments are not copied.
+func (t Task) Clone(createdBy string, projectUUID uuid.UUID, parentPath []string, fields map[string]interface{}) (Task, error) {
+	c, err := NewTask(
+		t.Name,
+		t.FederationUUID,
+		t.CompanyUUID,
+		projectUUID,
+		createdBy,
+		nil,
+		t.Tags,
+		t.Description,
+		append([]string{}, parentPath...),
+		t.CoWorkersBy,
+		t.ImplementBy,
+		t.ResponsibleBy,
+		t.Priority,
+		t.FinishTo,
+		t.Icon,
+		t.ManagedBy,
+		t.TaskEntities,
+	)
+	if err != nil {
+		return c, err
+	}
+
+	c.Fields = fields
+	c.RawFields = nil
+	c.WatchBy = t.WatchBy
+	c.IsEpic = t.IsEpic
+	c.StartAt = t.StartAt
+	c.Estimate = t.Estimate
+
+	return c, nil
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRemapFields(t *testing.T) {
+	from := []CloneField{
+		{Hash: "a", Name: "Бюджет", DataType: Integer},
+		{Hash: "b", Name: "Город", DataType: String},
+		{Hash: "c", Name: "Срок", DataType: DateTime},
+		{Hash: "d", Name: "Заметка", DataType: Text},
+	}
+
+	to := []CloneField{
+		{Hash: "a", Name: "Бюджет", DataType: Integer},
+		{Hash: "x", Name: "Город", DataType: String},
+		{Hash: "y", Name: "Срок", DataType: String},
+	}
+
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		want   map[string]interface{}
+	}{
+		{
+			name:   "same hash",
+			values: map[string]interface{}{"a": 10.0},
+			want:   map[string]interface{}{"a": 10.0},
+		},
+		{
+			name:   "by name",
+			values: map[string]interface{}{"b": "Москва"},
+			want:   map[string]interface{}{"x": "Москва"},
+		},
+		{
+			name:   "other data type is dropped",
+			values: map[string]interface{}{"c": "2026-01-01T10:00:00Z"},
+			want:   map[string]interface{}{},
+		},
+		{
+			name:   "missing field is dropped",
+			values: map[string]interface{}{"d": "текст", "z": 1.0},
+			want:   map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RemapFields(tt.values, from, to)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RemapFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloneKeepsPlan(t *testing.T) {
+	estimate := 3.0
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	src := Task{
+		UUID:          uuid.New(),
+		Name:          "Задача",
+		CreatedBy:     "a@a.ru",
+		Path:          []string{"p"},
+		Estimate:      &estimate,
+		EstimateTotal: 5,
+		StartAt:       &start,
+	}
+
+	c, err := src.Clone("b@a.ru", uuid.New(), []string{"p"}, nil)
+	if err != nil {
+		t.Fatalf("Clone() error = %v", err)
+	}
+
+	if c.Estimate == nil || *c.Estimate != estimate {
+		t.Errorf("Clone() estimate = %v, want %v", c.Estimate, estimate)
+	}
+
+	if c.EstimateTotal != 0 {
+		t.Errorf("Clone() estimate total = %v, want 0 till roll-up", c.EstimateTotal)
+	}
+
+	if c.StartAt == nil || !c.StartAt.Equal(start) {
+		t.Errorf("Clone() start = %v, want %v", c.StartAt, start)
+	}
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TotalExact     = "exact"
+	TotalEstimated = "estimated"
+	TotalNone      = "none"
+)
+
+var (
+	ErrInvalidCursor = errors.New("некорректный курсор")
+	ErrCursorOrder   = errors.New("курсор получен для другой сортировки")
+	ErrCursorSearch  = errors.New("курсор не поддерживается при полнотекстовом поиске")
+)
+
+func GetTotalModes() []string {
+	return []string{TotalExact, TotalEstimated, TotalNone}
+}
+
+// TaskCursor - position in the task list for keyset pagination: sort key of the last task and its uuid.
+// Key is nil when the sort key of the last task is NULL.
+type TaskCursor struct {
+	Order string    `json:"o"`
+	By    string    `json:"b"`
+	Key   *string   `json:"k"`
+	UUID  uuid.UUID `json:"u"`
+}
+
+func (c TaskCursor) Encode() string {
+	js, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(js)
+}
+
+func DecodeTaskCursor(token string) (c TaskCursor, err error) {
+	js, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+
+	err = json.Unmarshal(js, &c)
+	if err != nil || c.UUID == uuid.Nil || c.Order == "" || (c.By != "asc" && c.By != "desc") {
+		return c, ErrInvalidCursor
+	}
+
+	return c, nil
+}
+
+// Check returns error if the cursor was issued for another sort.
+func (c TaskCursor) Check(order, by string) error {
+	if c.Order != order || c.By != by {
+		return ErrCursorOrder
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func TestTaskCursor(t *testing.T) {
+	uid := uuid.New()
+
+	tests := []TaskCursor{
+		{Order: "created_at", By: "desc", Key: lo.ToPtr("2026-10-17 10:00:00.123+00"), UUID: uid},
+		{Order: "finish_to", By: "asc", UUID: uid},
+	}
+
+	for _, tt := range tests {
+		got, err := DecodeTaskCursor(tt.Encode())
+		if err != nil || !reflect.DeepEqual(got, tt) {
+			t.Errorf("DecodeTaskCursor = %+v, %v, want %+v", got, err, tt)
+		}
+	}
+
+	for _, token := range []string{"", "!!!", "e30", TaskCursor{Order: "id", By: "up", UUID: uid}.Encode()} {
+		if _, err := DecodeTaskCursor(token); err != ErrInvalidCursor {
+			t.Errorf("DecodeTaskCursor(%q) error = %v, want %v", token, err, ErrInvalidCursor)
+		}
+	}
+}
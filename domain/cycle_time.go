@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// Groups of the cycle time report.
+const (
+	CycleGroupImplementBy = "implement_by"
+	CycleGroupPriority    = "priority"
+	CycleGroupTag         = "tag"
+)
+
+// MaxReportDays - max period of reports.
+const MaxReportDays = 366
+
+var ErrCycleInvalidGroup = errors.New("неизвестная группировка отчета")
+
+func GetCycleGroups() []string {
+	return []string{CycleGroupImplementBy, CycleGroupPriority, CycleGroupTag}
+}
+
+// TaskFlow - seconds spent by the task in statuses within the period,
+// lead time (creation to Done) and cycle time (first In Work to Done) of tasks done in the period.
+type TaskFlow struct {
+	Statuses map[int]float64
+	Lead     *float64
+	Cycle    *float64
+}
+
+// Flow replays Stops of the task from its creation in StatusUnknown, time after Done or Cancel is not counted.
+func (t Task) Flow(from, to time.Time) TaskFlow {
+	flow := TaskFlow{Statuses: map[int]float64{}}
+
+	stops := append([]Stop{}, t.Stops...)
+	sort.SliceStable(stops, func(i, j int) bool {
+		return stops[i].CreatedAt.Before(stops[j].CreatedAt)
+	})
+
+	add := func(status int, start, end time.Time) {
+		if start.Before(from) {
+			start = from
+		}
+
+		if end.After(to) {
+			end = to
+		}
+
+		if end.After(start) {
+			flow.Statuses[status] += end.Sub(start).Seconds()
+		}
+	}
+
+	status, start := StatusUnknown, t.CreatedAt
+	for _, stop := range stops {
+		add(status, start, stop.CreatedAt)
+		status, start = stop.StatusID, stop.CreatedAt
+	}
+
+	if IsTaskOpen(status) {
+		add(status, start, to)
+	}
+
+	if status != StatusDone || len(stops) == 0 {
+		return flow
+	}
+
+	doneAt := stops[len(stops)-1].CreatedAt
+	if doneAt.Before(from) || doneAt.After(to) {
+		return flow
+	}
+
+	flow.Lead = lo.ToPtr(doneAt.Sub(t.CreatedAt).Seconds())
+
+	if work, ok := lo.Find(stops, func(s Stop) bool { return s.StatusID == StatusInWork }); ok {
+		flow.Cycle = lo.ToPtr(doneAt.Sub(work.CreatedAt).Seconds())
+	}
+
+	return flow
+}
+
+// DurationStats - seconds of tasks, percentiles are interpolated between the closest values.
+type DurationStats struct {
+	Count int
+	Sum   float64
+	Avg   float64
+	P50   float64
+	P85   float64
+	P95   float64
+}
+
+func NewDurationStats(values []float64) DurationStats {
+	if len(values) == 0 {
+		return DurationStats{}
+	}
+
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	sum := lo.Sum(sorted)
+
+	return DurationStats{
+		Count: len(sorted),
+		Sum:   sum,
+		Avg:   sum / float64(len(sorted)),
+		P50:   Percentile(sorted, 50),
+		P85:   Percentile(sorted, 85),
+		P95:   Percentile(sorted, 95),
+	}
+}
+
+// Percentile of sorted values, p is from 0 to 100.
+func Percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower, upper := int(math.Floor(rank)), int(math.Ceil(rank))
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+type StatusTimeStats struct {
+	Status int
+	Time   DurationStats
+}
+
+type CycleTimeGroup struct {
+	Key      string
+	Tasks    int
+	Lead     DurationStats
+	Cycle    DurationStats
+	Statuses []StatusTimeStats
+}
+
+type CycleTimeReport struct {
+	GroupBy string
+	Total   CycleTimeGroup
+	Groups  []CycleTimeGroup
+}
+
+// cycleTimeValues collects flows of the group.
+type cycleTimeValues struct {
+	tasks    int
+	lead     []float64
+	cycle    []float64
+	statuses map[int][]float64
+}
+
+func (v *cycleTimeValues) add(flow TaskFlow) {
+	v.tasks++
+
+	if flow.Lead != nil {
+		v.lead = append(v.lead, *flow.Lead)
+	}
+
+	if flow.Cycle != nil {
+		v.cycle = append(v.cycle, *flow.Cycle)
+	}
+
+	for status, seconds := range flow.Statuses {
+		v.statuses[status] = append(v.statuses[status], seconds)
+	}
+}
+
+func (v *cycleTimeValues) group(key string) CycleTimeGroup {
+	statuses := lo.Keys(v.statuses)
+	sort.Ints(statuses)
+
+	return CycleTimeGroup{
+		Key:   key,
+		Tasks: v.tasks,
+		Lead:  NewDurationStats(v.lead),
+		Cycle: NewDurationStats(v.cycle),
+		Statuses: lo.Map(statuses, func(status int, _ int) StatusTimeStats {
+			return StatusTimeStats{Status: status, Time: NewDurationStats(v.statuses[status])}
+		}),
+	}
+}
+
+// CycleTimeReportBuilder collects tasks one by one, the task goes to every group of its tags.
+type CycleTimeReportBuilder struct {
+	from, to time.Time
+	groupBy  string
+	total    *cycleTimeValues
+	groups   map[string]*cycleTimeValues
+}
+
+// NewCycleTimeReportBuilder - groupBy is empty for the report without groups.
+func NewCycleTimeReportBuilder(from, to time.Time, groupBy string) (*CycleTimeReportBuilder, error) {
+	if groupBy != "" && !lo.Contains(GetCycleGroups(), groupBy) {
+		return nil, ErrCycleInvalidGroup
+	}
+
+	return &CycleTimeReportBuilder{
+		from:    from,
+		to:      to,
+		groupBy: groupBy,
+		total:   &cycleTimeValues{statuses: map[int][]float64{}},
+		groups:  map[string]*cycleTimeValues{},
+	}, nil
+}
+
+func (b *CycleTimeReportBuilder) Add(t Task) {
+	flow := t.Flow(b.from, b.to)
+	b.total.add(flow)
+
+	for _, key := range b.keys(t) {
+		if b.groups[key] == nil {
+			b.groups[key] = &cycleTimeValues{statuses: map[int][]float64{}}
+		}
+
+		b.groups[key].add(flow)
+	}
+}
+
+func (b *CycleTimeReportBuilder) Report() CycleTimeReport {
+	keys := lo.Keys(b.groups)
+	sort.Strings(keys)
+
+	return CycleTimeReport{
+		GroupBy: b.groupBy,
+		Total:   b.total.group(""),
+		Groups: lo.Map(keys, func(key string, _ int) CycleTimeGroup {
+			return b.groups[key].group(key)
+		}),
+	}
+}
+
+// keys returns groups of the task, tasks without implementer or tags go to the group with empty key.
+func (b *CycleTimeReportBuilder) keys(t Task) []string {
+	switch b.groupBy {
+	case CycleGroupImplementBy:
+		return []string{t.ImplementBy}
+	case CycleGroupPriority:
+		return []string{strconv.Itoa(t.Priority)}
+	case CycleGroupTag:
+		if len(t.Tags) == 0 {
+			return []string{""}
+		}
+
+		return lo.Uniq(t.Tags)
+	}
+
+	return []string{}
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCycleTimeReport(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(h) * time.Hour)
+	}
+	hours := func(h int) float64 {
+		return float64(h) * 3600
+	}
+
+	tasks := []Task{
+		{CreatedAt: at(0), ImplementBy: "a@a.ru", Tags: []string{"api", "vip"}, Stops: []Stop{
+			{CreatedAt: at(2), StatusID: StatusNew},
+			{CreatedAt: at(4), StatusID: StatusInWork},
+			{CreatedAt: at(10), StatusID: StatusDone},
+		}},
+		{CreatedAt: at(0), ImplementBy: "b@b.ru", Tags: []string{"api"}, Stops: []Stop{
+			{CreatedAt: at(6), StatusID: StatusInWork},
+			{CreatedAt: at(8), StatusID: StatusNeedReview},
+			{CreatedAt: at(9), StatusID: StatusInWork},
+			{CreatedAt: at(12), StatusID: StatusDone},
+		}},
+		// open task, the last status is counted to the end of the period
+		{CreatedAt: at(20), ImplementBy: "a@a.ru", Stops: []Stop{
+			{CreatedAt: at(22), StatusID: StatusInWork},
+		}},
+	}
+
+	builder, err := NewCycleTimeReportBuilder(at(1), at(24), CycleGroupTag)
+	if err != nil {
+		t.Fatalf("NewCycleTimeReportBuilder() error = %v", err)
+	}
+
+	for _, task := range tasks {
+		builder.Add(task)
+	}
+
+	report := builder.Report()
+
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "tasks", got: float64(report.Total.Tasks), want: 3},
+		{name: "lead count", got: float64(report.Total.Lead.Count), want: 2},
+		{name: "lead p50", got: report.Total.Lead.P50, want: hours(11)},
+		{name: "cycle avg", got: report.Total.Cycle.Avg, want: hours(6)},
+		{name: "lead p95", got: report.Total.Lead.P95, want: hours(10) + hours(2)*0.95},
+		// the period starts at 1h, so the first task spent 1h of the period in the unknown status
+		{name: "unknown clipped", got: report.Total.Statuses[0].Time.Sum, want: hours(1) + hours(5) + hours(2)},
+		{name: "in work", got: report.Total.Statuses[2].Time.Sum, want: hours(6) + hours(2) + hours(3) + hours(2)},
+		{name: "groups", got: float64(len(report.Groups)), want: 3},
+		{name: "no tag group", got: float64(report.Groups[0].Tasks), want: 1},
+		{name: "api group", got: float64(report.Groups[1].Lead.Count), want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewCycleTimeReportBuilder(at(0), at(1), "status"); err != ErrCycleInvalidGroup {
+		t.Errorf("NewCycleTimeReportBuilder() error = %v, want %v", err, ErrCycleInvalidGroup)
+	}
+}
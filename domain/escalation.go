@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	EscalationDueSoon = "due_soon"
+	EscalationOverdue = "overdue"
+)
+
+const (
+	EscalationImplementer = "implementer"
+	EscalationResponsible = "responsible"
+	EscalationManager     = "manager"
+)
+
+const (
+	// EscalationMaxOffset - max offset of the level from the deadline, minutes.
+	EscalationMaxOffset = 7 * 24 * 60
+	// EscalationLookback - reached level is sent only during this time,
+	// so tasks overdue long ago are not escalated after the project config is changed.
+	EscalationLookback = 24 * time.Hour
+
+	escalationMaxLevels = 10
+)
+
+// EscalationLevel - notification about the task deadline for the task roles.
+// Offset is minutes from FinishTo: negative is before the deadline (due soon), zero or positive is after (overdue).
+type EscalationLevel struct {
+	Offset int      `json:"offset"`
+	Roles  []string `json:"roles"`
+}
+
+// DefaultEscalationLevels are used when the project has no own levels:
+// a day before and at the deadline for the implementer and responsible, a day after for the manager.
+func DefaultEscalationLevels() []EscalationLevel {
+	return []EscalationLevel{
+		{Offset: -24 * 60, Roles: []string{EscalationImplementer, EscalationResponsible}},
+		{Offset: 0, Roles: []string{EscalationImplementer, EscalationResponsible}},
+		{Offset: 24 * 60, Roles: []string{EscalationManager}},
+	}
+}
+
+func GetEscalationRoles() []string {
+	return []string{EscalationImplementer, EscalationResponsible, EscalationManager}
+}
+
+func ValidateEscalationLevels(levels []EscalationLevel) error {
+	if len(levels) > escalationMaxLevels {
+		return fmt.Errorf("уровней эскалации не больше %d", escalationMaxLevels)
+	}
+
+	offsets := make(map[int]bool, len(levels))
+
+	for _, l := range levels {
+		if l.Offset < -EscalationMaxOffset || l.Offset > EscalationMaxOffset {
+			return errors.New("уровень эскалации не может быть дальше 7 дней от срока")
+		}
+
+		if offsets[l.Offset] {
+			return fmt.Errorf("уровень эскалации %d указан дважды", l.Offset)
+		}
+
+		offsets[l.Offset] = true
+
+		if len(l.Roles) == 0 {
+			return errors.New("у уровня эскалации должны быть роли")
+		}
+
+		for _, role := range l.Roles {
+			if lo.IndexOf(GetEscalationRoles(), role) == -1 {
+				return fmt.Errorf("неизвестная роль эскалации: %s", role)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (l EscalationLevel) Kind() string {
+	if l.Offset < 0 {
+		return EscalationDueSoon
+	}
+
+	return EscalationOverdue
+}
+
+func (l EscalationLevel) At(finishTo time.Time) time.Time {
+	return finishTo.Add(time.Duration(l.Offset) * time.Minute)
+}
+
+// Reached - the level time has come within the lookback.
+func (l EscalationLevel) Reached(finishTo, now time.Time) bool {
+	at := l.At(finishTo)
+
+	return !now.Before(at) && now.Sub(at) <= EscalationLookback
+}
+
+// People returns emails of the task people by the level roles, without duplicates.
+func (l EscalationLevel) People(t Task) []string {
+	people := []string{}
+
+	for _, role := range l.Roles {
+		switch role {
+		case EscalationImplementer:
+			people = append(people, t.ImplementBy)
+		case EscalationResponsible:
+			people = append(people, t.ResponsibleBy)
+		case EscalationManager:
+			people = append(people, t.ManagedBy)
+		}
+	}
+
+	return lo.Uniq(lo.Compact(people))
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEscalationLevel(t *testing.T) {
+	finishTo := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	task := Task{ImplementBy: "a@a.ru", ResponsibleBy: "a@a.ru", ManagedBy: "m@a.ru"}
+
+	tests := []struct {
+		name    string
+		level   EscalationLevel
+		now     time.Time
+		reached bool
+		kind    string
+		people  []string
+	}{
+		{"due soon", EscalationLevel{-60, []string{EscalationImplementer, EscalationResponsible}}, finishTo.Add(-30 * time.Minute), true, EscalationDueSoon, []string{"a@a.ru"}},
+		{"not yet", EscalationLevel{0, []string{EscalationImplementer}}, finishTo.Add(-time.Minute), false, EscalationOverdue, []string{"a@a.ru"}},
+		{"manager a day after", EscalationLevel{24 * 60, []string{EscalationManager}}, finishTo.Add(25 * time.Hour), true, EscalationOverdue, []string{"m@a.ru"}},
+		{"too old", EscalationLevel{0, []string{EscalationManager}}, finishTo.Add(EscalationLookback + time.Minute), false, EscalationOverdue, []string{"m@a.ru"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.level.Reached(finishTo, tt.now); got != tt.reached {
+				t.Errorf("Reached() = %v, want %v", got, tt.reached)
+			}
+
+			if got := tt.level.Kind(); got != tt.kind {
+				t.Errorf("Kind() = %v, want %v", got, tt.kind)
+			}
+
+			if got := tt.level.People(task); !reflect.DeepEqual(got, tt.people) {
+				t.Errorf("People() = %v, want %v", got, tt.people)
+			}
+		})
+	}
+}
+
+func TestValidateEscalationLevels(t *testing.T) {
+	if err := ValidateEscalationLevels(DefaultEscalationLevels()); err != nil {
+		t.Errorf("ValidateEscalationLevels(default) = %v", err)
+	}
+
+	invalid := [][]EscalationLevel{
+		{{Offset: 0, Roles: []string{EscalationManager}}, {Offset: 0, Roles: []string{EscalationImplementer}}},
+		{{Offset: EscalationMaxOffset + 1, Roles: []string{EscalationManager}}},
+		{{Offset: 60, Roles: []string{"boss"}}},
+		{{Offset: 60}},
+	}
+
+	for _, levels := range invalid {
+		if err := ValidateEscalationLevels(levels); err == nil {
+			t.Errorf("ValidateEscalationLevels(%v) = nil, want error", levels)
+		}
+	}
+}
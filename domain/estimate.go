@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// Estimate units of the project, points are used when not set.
+const (
+	EstimatePoints = "points"
+	EstimateHours  = "hours"
+)
+
+// MaxBurnDays - max days in burndown and burnup series.
+const MaxBurnDays = 366
+
+var (
+	ErrEstimateNegative    = errors.New("оценка не может быть отрицательной")
+	ErrEstimateInvalidUnit = errors.New("неизвестная единица оценки")
+)
+
+func GetEstimateUnits() []string {
+	return []string{EstimatePoints, EstimateHours}
+}
+
+func ValidateEstimateUnit(unit string) error {
+	if !lo.Contains(GetEstimateUnits(), unit) {
+		return ErrEstimateInvalidUnit
+	}
+
+	return nil
+}
+
+// CheckEstimate - the estimate is optional and can not be negative.
+func (t Task) CheckEstimate() error {
+	if t.Estimate != nil && *t.Estimate < 0 {
+		return ErrEstimateNegative
+	}
+
+	return nil
+}
+
+// StatusAt returns the status of the task at the moment by Stops, StatusUnknown before the first change.
+func (t Task) StatusAt(at time.Time) int {
+	status := StatusUnknown
+	last := time.Time{}
+
+	for _, stop := range t.Stops {
+		if stop.CreatedAt.After(at) || stop.CreatedAt.Before(last) {
+			continue
+		}
+
+		status, last = stop.StatusID, stop.CreatedAt
+	}
+
+	return status
+}
+
+// ProjectSnapshot - estimated work of the project at the end of the day, canceled tasks are not counted.
+type ProjectSnapshot struct {
+	ProjectUUID uuid.UUID
+	Date        time.Time
+
+	Total     float64
+	Completed float64
+	Remaining float64
+
+	TasksTotal int
+	TasksDone  int
+
+	CreatedAt time.Time
+}
+
+// BurnPoint - work of the project at the end of the day.
+type BurnPoint struct {
+	Date      time.Time
+	Total     float64
+	Completed float64
+	Remaining float64
+	// Ideal - remaining work burned evenly from the first point to zero at the end of the period
+	Ideal float64
+}
+
+// BurnSeries builds points by days from the first snapshot in the period: the scope is taken from the last snapshot
+// of the day or before it, completed work is counted by Stops of estimated tasks.
+func BurnSeries(from, to time.Time, snapshots []ProjectSnapshot, tasks []Task) []BurnPoint {
+	points := []BurnPoint{}
+	days := burnDays(from, to)
+
+	for _, day := range days {
+		end := day.AddDate(0, 0, 1)
+
+		snapshot, ok := lastSnapshot(snapshots, end)
+		if !ok {
+			continue
+		}
+
+		completed := 0.0
+		for _, t := range tasks {
+			if t.Estimate != nil && t.StatusAt(end) == StatusDone {
+				completed += *t.Estimate
+			}
+		}
+
+		points = append(points, BurnPoint{
+			Date:      day,
+			Total:     snapshot.Total,
+			Completed: completed,
+			Remaining: lo.Max([]float64{snapshot.Total - completed, 0}),
+		})
+	}
+
+	if len(points) == 0 {
+		return points
+	}
+
+	first, last := points[0].Date, days[len(days)-1]
+	span := last.Sub(first).Hours() / 24
+
+	for i := range points {
+		points[i].Ideal = points[0].Remaining
+		if span > 0 {
+			points[i].Ideal = points[0].Remaining * (1 - points[i].Date.Sub(first).Hours()/24/span)
+		}
+	}
+
+	return points
+}
+
+// burnDays returns starts of days from the day of "from" to the day of "to".
+func burnDays(from, to time.Time) []time.Time {
+	days := []time.Time{}
+
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	for !day.After(to) && len(days) < MaxBurnDays {
+		days = append(days, day)
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return days
+}
+
+// lastSnapshot returns the latest snapshot made before the moment.
+func lastSnapshot(snapshots []ProjectSnapshot, before time.Time) (snapshot ProjectSnapshot, ok bool) {
+	for _, s := range snapshots {
+		if s.Date.Before(before) && (!ok || s.Date.After(snapshot.Date)) {
+			snapshot, ok = s, true
+		}
+	}
+
+	return snapshot, ok
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func TestBurnSeries(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	snapshots := []ProjectSnapshot{
+		{Date: day(1), Total: 10},
+		{Date: day(3), Total: 12},
+	}
+
+	tasks := []Task{
+		{Estimate: lo.ToPtr(3.0), Stops: []Stop{
+			{CreatedAt: day(2).Add(10 * time.Hour), StatusID: StatusDone},
+		}},
+		{Estimate: lo.ToPtr(4.0), Stops: []Stop{
+			{CreatedAt: day(2).Add(9 * time.Hour), StatusID: StatusDone},
+			{CreatedAt: day(3).Add(9 * time.Hour), StatusID: StatusInWork},
+			{CreatedAt: day(4).Add(9 * time.Hour), StatusID: StatusDone},
+		}},
+		{Stops: []Stop{{CreatedAt: day(1), StatusID: StatusDone}}},
+	}
+
+	points := BurnSeries(day(1).Add(12*time.Hour), day(5), snapshots, tasks)
+
+	want := []BurnPoint{
+		{Date: day(1), Total: 10, Completed: 0, Remaining: 10, Ideal: 10},
+		{Date: day(2), Total: 10, Completed: 7, Remaining: 3, Ideal: 7.5},
+		{Date: day(3), Total: 12, Completed: 3, Remaining: 9, Ideal: 5},
+		{Date: day(4), Total: 12, Completed: 7, Remaining: 5, Ideal: 2.5},
+		{Date: day(5), Total: 12, Completed: 7, Remaining: 5, Ideal: 0},
+	}
+
+	if len(points) != len(want) {
+		t.Fatalf("BurnSeries() = %d points, want %d", len(points), len(want))
+	}
+
+	for i := range want {
+		if !points[i].Date.Equal(want[i].Date) || points[i].Total != want[i].Total || points[i].Completed != want[i].Completed ||
+			points[i].Remaining != want[i].Remaining || points[i].Ideal != want[i].Ideal {
+			t.Errorf("BurnSeries()[%d] = %+v, want %+v", i, points[i], want[i])
+		}
+	}
+
+	if got := BurnSeries(day(1), day(5), nil, tasks); len(got) != 0 {
+		t.Errorf("BurnSeries() without snapshots = %v, want empty", got)
+	}
+
+	if err := (Task{Estimate: lo.ToPtr(-1.0)}).CheckEstimate(); err != ErrEstimateNegative {
+		t.Errorf("CheckEstimate() error = %v, want %v", err, ErrEstimateNegative)
+	}
+}
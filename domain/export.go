@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	ExportCSV    = "csv"
+	ExportXLSX   = "xlsx"
+	ExportNDJSON = "ndjson"
+)
+
+const (
+	ExportRunning = "running"
+	ExportDone    = "done"
+	ExportFailed  = "failed"
+)
+
+var (
+	ErrExportInvalidFormat = errors.New("неизвестный формат выгрузки")
+	ErrExportForbidden     = errors.New("нет доступа к выгрузке")
+)
+
+func GetExportFormats() []string {
+	return []string{ExportCSV, ExportXLSX, ExportNDJSON}
+}
+
+func ExportContentType(format string) string {
+	switch format {
+	case ExportXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ExportNDJSON:
+		return "application/x-ndjson"
+	}
+
+	return "text/csv; charset=utf-8"
+}
+
+// TaskExport - background export job, result file is stored in private s3.
+type TaskExport struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	ProjectUUID    uuid.UUID
+	CreatedByUUID  uuid.UUID
+
+	Format   string
+	Status   string
+	FileUUID *uuid.UUID
+	Count    int
+	Error    string
+
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+func NewTaskExport(federationUUID, projectUUID, createdByUUID uuid.UUID, format string) (TaskExport, error) {
+	e := TaskExport{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		ProjectUUID:    projectUUID,
+		CreatedByUUID:  createdByUUID,
+		Format:         format,
+		Status:         ExportRunning,
+		CreatedAt:      time.Now(),
+	}
+
+	if lo.IndexOf(GetExportFormats(), format) == -1 {
+		return e, ErrExportInvalidFormat
+	}
+
+	return e, nil
+}
+
+func (e TaskExport) FileName() string {
+	return "tasks-" + e.CreatedAt.Format("2006-01-02-150405") + "." + e.Format
+}
+
+func (e *TaskExport) Finish(fileUUID *uuid.UUID, count int, err error) {
+	now := time.Now()
+
+	e.FinishedAt = &now
+	e.FileUUID = fileUUID
+	e.Count = count
+	e.Status = ExportDone
+
+	if err != nil {
+		e.Status = ExportFailed
+		e.Error = err.Error()
+	}
+}
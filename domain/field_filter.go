@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	FilterEq       = "eq"
+	FilterNe       = "ne"
+	FilterGt       = "gt"
+	FilterGte      = "gte"
+	FilterLt       = "lt"
+	FilterLte      = "lte"
+	FilterBetween  = "between"
+	FilterIn       = "in"
+	FilterContains = "contains"
+	FilterHasAny   = "has_any"
+	FilterHasAll   = "has_all"
+	FilterEmpty    = "empty"
+	FilterNotEmpty = "not_empty"
+)
+
+const (
+	fieldFilterMaxDepth      = 5
+	fieldFilterMaxConditions = 50
+)
+
+var (
+	ErrFieldFilterGroup = errors.New("условие фильтра должно быть либо группой and/or, либо условием по полю")
+	ErrFieldFilterLimit = errors.New("слишком сложный фильтр по полям")
+)
+
+// FieldFilter - condition on a custom field or AND/OR group of conditions.
+// Field is the field hash, value type depends on the field data type and the operator.
+type FieldFilter struct {
+	And []FieldFilter `json:"and,omitempty"`
+	Or  []FieldFilter `json:"or,omitempty"`
+
+	Field string      `json:"field,omitempty"`
+	Op    string      `json:"op,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+
+	// DataType is set by Prepare
+	DataType FieldDataType `json:"-"`
+}
+
+// FieldFilterOperators returns operators available for the field data type.
+func FieldFilterOperators(dataType FieldDataType) []string {
+	switch dataType {
+	case Integer, Float, Switch, Phone, Formula:
+		return []string{FilterEq, FilterNe, FilterGt, FilterGte, FilterLt, FilterLte, FilterBetween, FilterIn, FilterEmpty, FilterNotEmpty}
+	case String, Text, Link, Email:
+		return []string{FilterEq, FilterNe, FilterContains, FilterIn, FilterEmpty, FilterNotEmpty}
+	case Bool:
+		return []string{FilterEq, FilterEmpty, FilterNotEmpty}
+	case DateTime, Time:
+		return []string{FilterGt, FilterGte, FilterLt, FilterLte, FilterBetween, FilterEmpty, FilterNotEmpty}
+	case Array, People:
+		return []string{FilterHasAny, FilterHasAll, FilterEmpty, FilterNotEmpty}
+	}
+
+	return []string{FilterEmpty, FilterNotEmpty}
+}
+
+func (f FieldFilter) IsGroup() bool {
+	return len(f.And) > 0 || len(f.Or) > 0
+}
+
+// Prepare checks the filter against project fields (hash -> data type) and converts values to the field type:
+// float64 for numbers, string, bool, time.Time for dates, "15:04:05" for time and []interface{} of them for lists.
+func (f FieldFilter) Prepare(fields map[string]FieldDataType) (FieldFilter, error) {
+	conditions := 0
+
+	return f.prepare(fields, 1, &conditions)
+}
+
+func (f FieldFilter) prepare(fields map[string]FieldDataType, depth int, conditions *int) (FieldFilter, error) {
+	if depth > fieldFilterMaxDepth {
+		return f, ErrFieldFilterLimit
+	}
+
+	if f.IsGroup() {
+		if (len(f.And) > 0 && len(f.Or) > 0) || f.Field != "" {
+			return f, ErrFieldFilterGroup
+		}
+
+		prepared := FieldFilter{}
+
+		for _, item := range f.And {
+			p, err := item.prepare(fields, depth+1, conditions)
+			if err != nil {
+				return f, err
+			}
+
+			prepared.And = append(prepared.And, p)
+		}
+
+		for _, item := range f.Or {
+			p, err := item.prepare(fields, depth+1, conditions)
+			if err != nil {
+				return f, err
+			}
+
+			prepared.Or = append(prepared.Or, p)
+		}
+
+		return prepared, nil
+	}
+
+	*conditions++
+	if *conditions > fieldFilterMaxConditions {
+		return f, ErrFieldFilterLimit
+	}
+
+	if f.Field == "" {
+		return f, ErrFieldFilterGroup
+	}
+
+	dataType, ok := fields[f.Field]
+	if !ok {
+		return f, fmt.Errorf("поле %s не найдено в проекте", f.Field)
+	}
+
+	if lo.IndexOf(FieldFilterOperators(dataType), f.Op) == -1 {
+		return f, fmt.Errorf("оператор %s недоступен для поля %s", f.Op, f.Field)
+	}
+
+	value, err := filterValue(dataType, f.Op, f.Value)
+	if err != nil {
+		return f, fmt.Errorf("некорректное значение фильтра поля %s: %w", f.Field, err)
+	}
+
+	return FieldFilter{Field: f.Field, Op: f.Op, Value: value, DataType: dataType}, nil
+}
+
+func filterValue(dataType FieldDataType, op string, v interface{}) (interface{}, error) {
+	switch op {
+	case FilterEmpty, FilterNotEmpty:
+		return nil, nil
+	case FilterBetween:
+		items, ok := v.([]interface{})
+		if !ok || len(items) != 2 {
+			return nil, errors.New("ожидается массив из двух значений")
+		}
+
+		return filterList(dataType, items)
+	case FilterIn, FilterHasAny, FilterHasAll:
+		items, ok := v.([]interface{})
+		if !ok || len(items) == 0 {
+			return nil, errors.New("ожидается непустой массив")
+		}
+
+		return filterList(dataType, items)
+	}
+
+	return filterScalar(dataType, v)
+}
+
+func filterList(dataType FieldDataType, items []interface{}) ([]interface{}, error) {
+	values := make([]interface{}, 0, len(items))
+
+	for _, item := range items {
+		value, err := filterScalar(dataType, item)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func filterScalar(dataType FieldDataType, v interface{}) (interface{}, error) {
+	switch dataType {
+	case Integer, Float, Switch, Phone, Formula:
+		if f, ok := v.(float64); ok {
+			return f, nil
+		}
+
+		return nil, errors.New("ожидается число")
+	case Bool:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+
+		return nil, errors.New("ожидается true или false")
+	case DateTime:
+		if s, ok := v.(string); ok {
+			for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+				if t, err := time.Parse(layout, s); err == nil {
+					return t, nil
+				}
+			}
+		}
+
+		return nil, errors.New("ожидается дата в формате RFC3339 или ГГГГ-ММ-ДД")
+	case Time:
+		if s, ok := v.(string); ok {
+			for _, layout := range []string{"15:04:05", "15:04"} {
+				if t, err := time.Parse(layout, s); err == nil {
+					return t.Format("15:04:05"), nil
+				}
+			}
+		}
+
+		return nil, errors.New("ожидается время ЧЧ:ММ")
+	}
+
+	s, ok := v.(string)
+	if !ok || strings.TrimSpace(s) == "" {
+		return nil, errors.New("ожидается строка")
+	}
+
+	return s, nil
+}
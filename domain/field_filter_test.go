@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFieldFilterPrepare(t *testing.T) {
+	fields := map[string]FieldDataType{"amount": Float, "deadline": DateTime, "people": People, "done": Bool}
+
+	tests := []struct {
+		name    string
+		filter  FieldFilter
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "gt", filter: FieldFilter{Field: "amount", Op: FilterGt, Value: 1000.0}, want: 1000.0},
+		{name: "between dates", filter: FieldFilter{Field: "deadline", Op: FilterBetween, Value: []interface{}{"2026-10-01", "2026-10-17T10:00:00Z"}},
+			want: []interface{}{time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)}},
+		{name: "any of", filter: FieldFilter{Field: "people", Op: FilterHasAny, Value: []interface{}{"a@a.ru", "b@b.ru"}}, want: []interface{}{"a@a.ru", "b@b.ru"}},
+		{name: "empty", filter: FieldFilter{Field: "done", Op: FilterEmpty}, want: nil},
+		{name: "unknown field", filter: FieldFilter{Field: "x", Op: FilterEq, Value: "a"}, wantErr: true},
+		{name: "operator of other type", filter: FieldFilter{Field: "done", Op: FilterGt, Value: true}, wantErr: true},
+		{name: "wrong value", filter: FieldFilter{Field: "amount", Op: FilterLt, Value: "много"}, wantErr: true},
+		{name: "between one value", filter: FieldFilter{Field: "amount", Op: FilterBetween, Value: []interface{}{1.0}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filter.Prepare(fields)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Prepare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got.Value, tt.want) {
+				t.Errorf("Prepare() value = %#v, want %#v", got.Value, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldFilterPrepareGroup(t *testing.T) {
+	fields := map[string]FieldDataType{"amount": Integer}
+
+	group := FieldFilter{Or: []FieldFilter{
+		{Field: "amount", Op: FilterEmpty},
+		{And: []FieldFilter{{Field: "amount", Op: FilterGte, Value: 1.0}, {Field: "amount", Op: FilterLt, Value: 5.0}}},
+	}}
+
+	got, err := group.Prepare(fields)
+	if err != nil || len(got.Or) != 2 || got.Or[1].And[1].DataType != Integer {
+		t.Errorf("Prepare() = %+v, %v", got, err)
+	}
+
+	mixed := FieldFilter{And: group.Or, Or: group.Or}
+	if _, err := mixed.Prepare(fields); err != ErrFieldFilterGroup {
+		t.Errorf("Prepare() error = %v, want %v", err, ErrFieldFilterGroup)
+	}
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const (
+	// FieldRuleRequired - the field should be filled to move the task to the statuses.
+	FieldRuleRequired = "required"
+	// FieldRuleReadonly - the field can not be changed while the task is in the statuses.
+	FieldRuleReadonly = "readonly"
+)
+
+const fieldRulesMax = 50
+
+// FieldRule - declarative project rule for the custom field (hash) tied to task statuses.
+type FieldRule struct {
+	Field    string `json:"field"`
+	Rule     string `json:"rule"`
+	Statuses []int  `json:"statuses"`
+}
+
+// FieldRuleError - violated rules, one message per field.
+type FieldRuleError struct {
+	Errors []string
+}
+
+func (e FieldRuleError) Error() string {
+	return strings.Join(e.Errors, "; ")
+}
+
+func GetFieldRules() []string {
+	return []string{FieldRuleRequired, FieldRuleReadonly}
+}
+
+// ValidateFieldRules checks the rules against project fields (hash -> name) and statuses.
+func ValidateFieldRules(rules []FieldRule, fields map[string]string, statuses []int) error {
+	if len(rules) > fieldRulesMax {
+		return fmt.Errorf("правил полей не больше %d", fieldRulesMax)
+	}
+
+	seen := make(map[string]bool, len(rules))
+
+	for _, r := range rules {
+		if lo.IndexOf(GetFieldRules(), r.Rule) == -1 {
+			return fmt.Errorf("неизвестное правило поля: %s", r.Rule)
+		}
+
+		if _, ok := fields[r.Field]; !ok {
+			return fmt.Errorf("поле %s не найдено в проекте", r.Field)
+		}
+
+		key := r.Field + ":" + r.Rule
+		if seen[key] {
+			return fmt.Errorf("правило %s для поля %s указано дважды", r.Rule, r.Field)
+		}
+
+		seen[key] = true
+
+		if len(r.Statuses) == 0 {
+			return fmt.Errorf("у правила %s для поля %s должны быть статусы", r.Rule, r.Field)
+		}
+
+		for _, status := range r.Statuses {
+			if lo.IndexOf(statuses, status) == -1 {
+				return fmt.Errorf("статус %d не найден в проекте", status)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckRequiredFields returns FieldRuleError if fields required on the status are empty.
+// names maps field hash to the name used in messages.
+func CheckRequiredFields(rules []FieldRule, names map[string]string, values map[string]interface{}, status int) error {
+	errs := []string{}
+
+	for _, r := range rules {
+		if r.Rule != FieldRuleRequired || lo.IndexOf(r.Statuses, status) == -1 {
+			continue
+		}
+
+		if isEmptyFieldValue(values[r.Field]) {
+			errs = append(errs, fmt.Sprintf("поле %s (%s) обязательно для статуса %d", fieldRuleName(names, r.Field), r.Field, status))
+		}
+	}
+
+	return fieldRuleError(errs)
+}
+
+// CheckReadonlyFields returns FieldRuleError if changed fields are read-only on the current status of the task.
+// changes are new values by hash, nil removes the value.
+func CheckReadonlyFields(rules []FieldRule, names map[string]string, values, changes map[string]interface{}, status int) error {
+	errs := []string{}
+
+	for _, r := range rules {
+		if r.Rule != FieldRuleReadonly || lo.IndexOf(r.Statuses, status) == -1 {
+			continue
+		}
+
+		v, ok := changes[r.Field]
+		if !ok || reflect.DeepEqual(fieldRuleValue(v), fieldRuleValue(values[r.Field])) {
+			continue
+		}
+
+		errs = append(errs, fmt.Sprintf("поле %s (%s) недоступно для изменения в статусе %d", fieldRuleName(names, r.Field), r.Field, status))
+	}
+
+	return fieldRuleError(errs)
+}
+
+func fieldRuleError(errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	sort.Strings(errs)
+
+	return FieldRuleError{Errors: errs}
+}
+
+func fieldRuleName(names map[string]string, hash string) string {
+	if name, ok := names[hash]; ok {
+		return name
+	}
+
+	return hash
+}
+
+func isEmptyFieldValue(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(t) == ""
+	case []interface{}:
+		return len(t) == 0
+	case []string:
+		return len(t) == 0
+	}
+
+	return false
+}
+
+// fieldRuleValue brings stored (json) and sent values to one form, so unchanged values are equal.
+func fieldRuleValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case int:
+		return float64(t)
+	case []string:
+		return lo.Map(t, func(s string, _ int) interface{} { return s })
+	}
+
+	return v
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFieldRules(t *testing.T) {
+	rules := []FieldRule{
+		{Field: "a", Rule: FieldRuleRequired, Statuses: []int{StatusDone}},
+		{Field: "b", Rule: FieldRuleRequired, Statuses: []int{StatusDone, StatusCancel}},
+		{Field: "b", Rule: FieldRuleReadonly, Statuses: []int{StatusDone}},
+	}
+	names := map[string]string{"a": "Сумма", "b": "Договор"}
+
+	tests := []struct {
+		name    string
+		check   func() error
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "required filled",
+			check: func() error {
+				return CheckRequiredFields(rules, names, map[string]interface{}{"a": 1.0, "b": "x"}, StatusDone)
+			},
+		},
+		{
+			name: "required empty",
+			check: func() error {
+				return CheckRequiredFields(rules, names, map[string]interface{}{"b": " "}, StatusDone)
+			},
+			want: []string{"поле Договор (b) обязательно для статуса 5", "поле Сумма (a) обязательно для статуса 5"},
+		},
+		{
+			name: "required other status",
+			check: func() error {
+				return CheckRequiredFields(rules, names, map[string]interface{}{}, StatusCancel)
+			},
+			want: []string{"поле Договор (b) обязательно для статуса 6"},
+		},
+		{
+			name: "readonly unchanged",
+			check: func() error {
+				return CheckReadonlyFields(rules, names, map[string]interface{}{"b": 5.0}, map[string]interface{}{"b": 5}, StatusDone)
+			},
+		},
+		{
+			name: "readonly changed",
+			check: func() error {
+				return CheckReadonlyFields(rules, names, map[string]interface{}{"b": 5.0}, map[string]interface{}{"a": 1, "b": nil}, StatusDone)
+			},
+			want: []string{"поле Договор (b) недоступно для изменения в статусе 5"},
+		},
+		{
+			name: "readonly other status",
+			check: func() error {
+				return CheckReadonlyFields(rules, names, map[string]interface{}{}, map[string]interface{}{"b": 1}, StatusCancel)
+			},
+		},
+		{
+			name: "valid rules",
+			check: func() error {
+				return ValidateFieldRules(rules, names, []int{StatusDone, StatusCancel})
+			},
+		},
+		{
+			name: "unknown field",
+			check: func() error {
+				return ValidateFieldRules([]FieldRule{{Field: "z", Rule: FieldRuleRequired, Statuses: []int{StatusDone}}}, names, []int{StatusDone})
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown status",
+			check: func() error {
+				return ValidateFieldRules(rules, names, []int{StatusDone})
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate rule",
+			check: func() error {
+				return ValidateFieldRules(append(rules, rules[0]), names, []int{StatusDone, StatusCancel})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.check()
+
+			var ruleErr FieldRuleError
+			if errors.As(err, &ruleErr) {
+				if !reflect.DeepEqual(ruleErr.Errors, tt.want) {
+					t.Errorf("Errors = %v, want %v", ruleErr.Errors, tt.want)
+				}
+
+				return
+			}
+
+			if (err != nil) != tt.wantErr || tt.want != nil {
+				t.Errorf("error = %v, wantErr %v, want %v", err, tt.wantErr, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,434 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// MaxFormulaLen - max length of the formula expression.
+const MaxFormulaLen = 500
+
+// maxFormulaDepth limits nesting of the expression, so parsing and evaluation stay cheap.
+const maxFormulaDepth = 32
+
+var ErrFormulaEmpty = errors.New("формула не может быть пустой")
+
+type formulaKind int
+
+const (
+	formulaNumber formulaKind = iota
+	formulaDate
+)
+
+// formulaFuncs - functions available in formulas: argument kinds, result is always a number.
+var formulaFuncs = map[string][]formulaKind{
+	"days":  {formulaDate, formulaDate},
+	"hours": {formulaDate, formulaDate},
+	"round": {formulaNumber},
+	"abs":   {formulaNumber},
+	"min":   {formulaNumber, formulaNumber},
+	"max":   {formulaNumber, formulaNumber},
+}
+
+type formulaNode struct {
+	// op - "num", "field", "neg", binary operator or function name
+	op    string
+	num   float64
+	field string
+	kind  formulaKind
+	args  []formulaNode
+}
+
+// FieldFormula - computed field expression over number and datetime fields of the same task.
+// Fields are referenced by hash: `a * b`, `days(c, d) / 7`, `round(a / 3)`. Only + - * /, parentheses,
+// number literals and functions days, hours, round, abs, min, max are allowed, the result is a number.
+type FieldFormula struct {
+	root formulaNode
+
+	// Fields - hashes of the fields used in the formula
+	Fields []string
+}
+
+// ParseFormula parses the expression and checks types against the fields (hash -> data type).
+// Formulas can not reference other formulas, so values do not depend on the order of computing.
+func ParseFormula(expr string, fields map[string]FieldDataType) (f FieldFormula, err error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return f, ErrFormulaEmpty
+	}
+
+	if len(expr) > MaxFormulaLen {
+		return f, fmt.Errorf("формула длиннее %d символов", MaxFormulaLen)
+	}
+
+	tokens, err := formulaTokens(expr)
+	if err != nil {
+		return f, err
+	}
+
+	p := formulaParser{tokens: tokens, fields: fields, used: map[string]bool{}}
+
+	root, err := p.expr(0)
+	if err != nil {
+		return f, err
+	}
+
+	if p.pos < len(p.tokens) {
+		return f, fmt.Errorf("неожиданный символ в формуле: %s", p.tokens[p.pos])
+	}
+
+	if root.kind != formulaNumber {
+		return f, errors.New("результатом формулы должно быть число")
+	}
+
+	f.root = root
+	for hash := range p.used {
+		f.Fields = append(f.Fields, hash)
+	}
+
+	sort.Strings(f.Fields)
+
+	return f, nil
+}
+
+// Eval computes the formula over the task fields. False is returned when a used field is empty
+// or has a value of another type and when the result is not a finite number (division by zero).
+func (f FieldFormula) Eval(values map[string]interface{}) (float64, bool) {
+	v, ok := f.root.eval(values)
+	if !ok || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false
+	}
+
+	return v, true
+}
+
+func (n formulaNode) eval(values map[string]interface{}) (float64, bool) {
+	switch n.op {
+	case "num":
+		return n.num, true
+	case "field":
+		if n.kind == formulaDate {
+			return formulaDateValue(values[n.field])
+		}
+
+		return formulaNumberValue(values[n.field])
+	}
+
+	args := make([]float64, len(n.args))
+	for i, arg := range n.args {
+		v, ok := arg.eval(values)
+		if !ok {
+			return 0, false
+		}
+
+		args[i] = v
+	}
+
+	switch n.op {
+	case "neg":
+		return -args[0], true
+	case "+":
+		return args[0] + args[1], true
+	case "-":
+		return args[0] - args[1], true
+	case "*":
+		return args[0] * args[1], true
+	case "/":
+		if args[1] == 0 {
+			return 0, false
+		}
+
+		return args[0] / args[1], true
+	case "days":
+		return (args[1] - args[0]) / (24 * 3600), true
+	case "hours":
+		return (args[1] - args[0]) / 3600, true
+	case "round":
+		return math.Round(args[0]), true
+	case "abs":
+		return math.Abs(args[0]), true
+	case "min":
+		return math.Min(args[0], args[1]), true
+	case "max":
+		return math.Max(args[0], args[1]), true
+	}
+
+	return 0, false
+}
+
+func formulaNumberValue(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	}
+
+	return 0, false
+}
+
+// formulaDateValue returns unix seconds of the datetime field.
+func formulaDateValue(v interface{}) (float64, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, false
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, false
+	}
+
+	return float64(t.Unix()), true
+}
+
+func formulaTokens(expr string) (tokens []string, err error) {
+	runes := []rune(expr)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case strings.ContainsRune("+-*/(),", r):
+			tokens = append(tokens, string(r))
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			j := i
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		case r >= 'a' && r <= 'z' || r == '_':
+			j := i
+			for j < len(runes) && (runes[j] >= 'a' && runes[j] <= 'z' || runes[j] == '_' || unicode.IsDigit(runes[j])) {
+				j++
+			}
+
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		default:
+			return tokens, fmt.Errorf("недопустимый символ в формуле: %c", r)
+		}
+	}
+
+	return tokens, nil
+}
+
+type formulaParser struct {
+	tokens []string
+	pos    int
+	fields map[string]FieldDataType
+	used   map[string]bool
+}
+
+func (p *formulaParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return ""
+}
+
+func (p *formulaParser) expect(token string) error {
+	if p.peek() != token {
+		return fmt.Errorf("в формуле ожидается %s", token)
+	}
+
+	p.pos++
+
+	return nil
+}
+
+// expr := term (("+" | "-") term)*
+func (p *formulaParser) expr(depth int) (n formulaNode, err error) {
+	if depth > maxFormulaDepth {
+		return n, errors.New("слишком большая вложенность формулы")
+	}
+
+	n, err = p.term(depth)
+	if err != nil {
+		return n, err
+	}
+
+	for op := p.peek(); op == "+" || op == "-"; op = p.peek() {
+		p.pos++
+
+		right, err := p.term(depth)
+		if err != nil {
+			return n, err
+		}
+
+		n, err = formulaBinary(op, n, right)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// term := unary (("*" | "/") unary)*
+func (p *formulaParser) term(depth int) (n formulaNode, err error) {
+	n, err = p.unary(depth)
+	if err != nil {
+		return n, err
+	}
+
+	for op := p.peek(); op == "*" || op == "/"; op = p.peek() {
+		p.pos++
+
+		right, err := p.unary(depth)
+		if err != nil {
+			return n, err
+		}
+
+		n, err = formulaBinary(op, n, right)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// unary := "-" unary | primary
+func (p *formulaParser) unary(depth int) (n formulaNode, err error) {
+	if p.peek() != "-" {
+		return p.primary(depth)
+	}
+
+	p.pos++
+
+	if depth+1 > maxFormulaDepth {
+		return n, errors.New("слишком большая вложенность формулы")
+	}
+
+	arg, err := p.unary(depth + 1)
+	if err != nil {
+		return n, err
+	}
+
+	if arg.kind != formulaNumber {
+		return n, errors.New("минус применим только к числу")
+	}
+
+	return formulaNode{op: "neg", kind: formulaNumber, args: []formulaNode{arg}}, nil
+}
+
+// primary := number | hash | func "(" expr ("," expr)* ")" | "(" expr ")"
+func (p *formulaParser) primary(depth int) (n formulaNode, err error) {
+	token := p.peek()
+
+	switch {
+	case token == "":
+		return n, errors.New("формула не закончена")
+	case token == "(":
+		p.pos++
+
+		n, err = p.expr(depth + 1)
+		if err != nil {
+			return n, err
+		}
+
+		return n, p.expect(")")
+	case unicode.IsDigit(rune(token[0])) || token[0] == '.':
+		p.pos++
+
+		v, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			return n, fmt.Errorf("неверное число в формуле: %s", token)
+		}
+
+		return formulaNode{op: "num", num: v, kind: formulaNumber}, nil
+	case token[0] >= 'a' && token[0] <= 'z' || token[0] == '_':
+		p.pos++
+
+		if p.peek() == "(" {
+			return p.call(token, depth)
+		}
+
+		return p.field(token)
+	}
+
+	return n, fmt.Errorf("неожиданный символ в формуле: %s", token)
+}
+
+func (p *formulaParser) call(name string, depth int) (n formulaNode, err error) {
+	kinds, ok := formulaFuncs[name]
+	if !ok {
+		return n, fmt.Errorf("неизвестная функция в формуле: %s", name)
+	}
+
+	p.pos++
+
+	n = formulaNode{op: name, kind: formulaNumber}
+
+	for i := range kinds {
+		if i > 0 {
+			err = p.expect(",")
+			if err != nil {
+				return n, fmt.Errorf("функция %s принимает %d аргумента", name, len(kinds))
+			}
+		}
+
+		arg, err := p.expr(depth + 1)
+		if err != nil {
+			return n, err
+		}
+
+		if arg.kind != kinds[i] {
+			return n, fmt.Errorf("неверный тип аргумента %d функции %s", i+1, name)
+		}
+
+		n.args = append(n.args, arg)
+	}
+
+	err = p.expect(")")
+	if err != nil {
+		return n, fmt.Errorf("функция %s принимает %d аргумента", name, len(kinds))
+	}
+
+	return n, nil
+}
+
+func (p *formulaParser) field(hash string) (n formulaNode, err error) {
+	dataType, ok := p.fields[hash]
+	if !ok {
+		return n, fmt.Errorf("поле %s не найдено", hash)
+	}
+
+	n = formulaNode{op: "field", field: hash}
+
+	switch dataType {
+	case Integer, Float:
+		n.kind = formulaNumber
+	case DateTime:
+		n.kind = formulaDate
+	case Formula:
+		return n, fmt.Errorf("формула не может ссылаться на другую формулу: %s", hash)
+	default:
+		return n, fmt.Errorf("поле %s должно быть числом или датой", hash)
+	}
+
+	p.used[hash] = true
+
+	return n, nil
+}
+
+func formulaBinary(op string, left, right formulaNode) (formulaNode, error) {
+	if left.kind != formulaNumber || right.kind != formulaNumber {
+		return formulaNode{}, fmt.Errorf("операция %s применима только к числам, для дат используйте days или hours", op)
+	}
+
+	return formulaNode{op: op, kind: formulaNumber, args: []formulaNode{left, right}}, nil
+}
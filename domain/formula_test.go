@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseFormula(t *testing.T) {
+	fields := map[string]FieldDataType{"a": Integer, "b": Float, "c": DateTime, "d": DateTime, "e": String, "f": Formula, "g": Float}
+	values := map[string]interface{}{"a": 3, "b": 2.5, "c": "2024-01-01T00:00:00Z", "d": "2024-01-11T12:00:00Z", "e": "x"}
+
+	tests := []struct {
+		name    string
+		expr    string
+		fields  []string
+		want    float64
+		noValue bool
+		wantErr bool
+	}{
+		{name: "product", expr: "a * b", fields: []string{"a", "b"}, want: 7.5},
+		{name: "precedence", expr: "1 + a * 2 - -b", fields: []string{"a", "b"}, want: 9.5},
+		{name: "parentheses", expr: "(1 + a) * 2", fields: []string{"a"}, want: 8},
+		{name: "days", expr: "days(c, d)", fields: []string{"c", "d"}, want: 10.5},
+		{name: "functions", expr: "max(round(b), abs(0 - a)) / min(a, 2)", fields: []string{"a", "b"}, want: 1.5},
+		{name: "division by zero", expr: "a / (b - 2.5)", fields: []string{"a", "b"}, noValue: true},
+		{name: "empty field", expr: "a + g", fields: []string{"a", "g"}, noValue: true},
+		{name: "empty", expr: " ", wantErr: true},
+		{name: "unknown field", expr: "a + z", wantErr: true},
+		{name: "string field", expr: "e * 2", wantErr: true},
+		{name: "formula field", expr: "f + 1", wantErr: true},
+		{name: "date arithmetic", expr: "d - c", wantErr: true},
+		{name: "date result", expr: "c", wantErr: true},
+		{name: "unknown function", expr: "sqrt(a)", wantErr: true},
+		{name: "wrong argument", expr: "days(a, d)", wantErr: true},
+		{name: "arguments count", expr: "min(a)", wantErr: true},
+		{name: "not closed", expr: "(a + b", wantErr: true},
+		{name: "tail", expr: "a b", wantErr: true},
+		{name: "symbol", expr: "a; b", wantErr: true},
+		{name: "nesting", expr: strings.Repeat("(", 40) + "a" + strings.Repeat(")", 40), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := ParseFormula(tt.expr, fields)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFormula() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if !reflect.DeepEqual(f.Fields, tt.fields) {
+				t.Errorf("Fields = %v, want %v", f.Fields, tt.fields)
+			}
+
+			got, ok := f.Eval(values)
+			if ok == tt.noValue {
+				t.Fatalf("Eval() ok = %v, want %v", ok, !tt.noValue)
+			}
+
+			if got != tt.want {
+				t.Errorf("Eval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,354 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	ImportUploaded = "uploaded"
+	ImportChecked  = "checked"
+	ImportRunning  = "running"
+	ImportDone     = "done"
+	ImportFailed   = "failed"
+)
+
+// Import targets, project fields are mapped as "fields.<hash>".
+const (
+	ImportName          = "name"
+	ImportDescription   = "description"
+	ImportTags          = "tags"
+	ImportPriority      = "priority"
+	ImportFinishTo      = "finish_to"
+	ImportImplementBy   = "implement_by"
+	ImportResponsibleBy = "responsible_by"
+	ImportManagedBy     = "managed_by"
+	ImportCoworkersBy   = "coworkers_by"
+
+	ImportFieldPrefix = "fields."
+)
+
+const ImportMaxRows = 10000
+
+var (
+	ErrImportEmpty      = errors.New("файл не содержит строк")
+	ErrImportTooMany    = fmt.Errorf("файл содержит больше %d строк", ImportMaxRows)
+	ErrImportNameMiss   = errors.New("не выбрана колонка с названием задачи")
+	ErrImportNotChecked = errors.New("импорт нужно сначала проверить")
+	ErrImportStarted    = errors.New("импорт уже запущен")
+	ErrImportForbidden  = errors.New("нет доступа к импорту")
+)
+
+// importSynonyms - lower case column titles recognized for task attributes.
+var importSynonyms = map[string][]string{
+	ImportName:          {"name", "title", "summary", "название", "задача", "тема"},
+	ImportDescription:   {"description", "описание"},
+	ImportTags:          {"tags", "labels", "теги", "метки"},
+	ImportPriority:      {"priority", "приоритет"},
+	ImportFinishTo:      {"finish to", "due date", "deadline", "срок", "дедлайн"},
+	ImportImplementBy:   {"implement by", "assignee", "исполнитель"},
+	ImportResponsibleBy: {"responsible by", "responsible", "ответственный"},
+	ImportManagedBy:     {"managed by", "manager", "менеджер"},
+	ImportCoworkersBy:   {"coworkers by", "coworkers", "соисполнители"},
+}
+
+func GetImportTargets() []string {
+	return []string{
+		ImportName, ImportDescription, ImportTags, ImportPriority, ImportFinishTo,
+		ImportImplementBy, ImportResponsibleBy, ImportManagedBy, ImportCoworkersBy,
+	}
+}
+
+// ImportField - project field available for mapping.
+type ImportField struct {
+	Hash     string
+	Name     string
+	DataType FieldDataType
+}
+
+type ImportRowError struct {
+	// Row - row number in the file, header is the first row
+	Row   int
+	Error string
+}
+
+// TaskImport - uploaded file with tasks, Mapping is column index -> target.
+type TaskImport struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+	CreatedBy      string
+	CreatedByUUID  uuid.UUID
+
+	FileName string
+	Header   []string
+	Rows     [][]string
+	Mapping  map[int]string
+
+	Status  string
+	Errors  []ImportRowError
+	Created int
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewTaskImport(crtr Creator, federationUUID, companyUUID, projectUUID uuid.UUID, fileName string, records [][]string) (imp TaskImport, err error) {
+	imp = TaskImport{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		CompanyUUID:    companyUUID,
+		ProjectUUID:    projectUUID,
+		CreatedBy:      crtr.Email,
+		CreatedByUUID:  crtr.UUID,
+		FileName:       fileName,
+		Mapping:        make(map[int]string),
+		Status:         ImportUploaded,
+		Errors:         []ImportRowError{},
+		CreatedAt:      time.Now(),
+	}
+
+	// skip empty rows
+	records = lo.Filter(records, func(row []string, _ int) bool {
+		return lo.ContainsBy(row, func(v string) bool { return strings.TrimSpace(v) != "" })
+	})
+
+	if len(records) < 2 {
+		return imp, ErrImportEmpty
+	}
+
+	if len(records)-1 > ImportMaxRows {
+		return imp, ErrImportTooMany
+	}
+
+	imp.Header = lo.Map(records[0], func(v string, _ int) string { return strings.TrimSpace(v) })
+	imp.Rows = records[1:]
+
+	return imp, nil
+}
+
+// SuggestImportMapping maps columns to task attributes and project fields by title, every target is used once.
+func SuggestImportMapping(header []string, fields []ImportField) map[int]string {
+	mapping := make(map[int]string)
+	used := make(map[string]bool)
+
+	for i, title := range header {
+		title = normalizeImportTitle(title)
+		if title == "" {
+			continue
+		}
+
+		target := ""
+
+		for _, t := range GetImportTargets() {
+			if lo.Contains(importSynonyms[t], title) {
+				target = t
+				break
+			}
+		}
+
+		if target == "" {
+			field, ok := lo.Find(fields, func(f ImportField) bool {
+				return normalizeImportTitle(f.Name) == title || strings.ToLower(f.Hash) == title
+			})
+			if ok {
+				target = ImportFieldPrefix + field.Hash
+			}
+		}
+
+		if target != "" && !used[target] {
+			mapping[i] = target
+			used[target] = true
+		}
+	}
+
+	return mapping
+}
+
+// ValidateMapping checks columns and targets of the mapping.
+func (imp *TaskImport) ValidateMapping(mapping map[int]string, fields []ImportField) error {
+	used := make(map[string]bool)
+
+	for col, target := range mapping {
+		if col < 0 || col >= len(imp.Header) {
+			return fmt.Errorf("колонка %d не найдена", col)
+		}
+
+		if used[target] {
+			return fmt.Errorf("поле %s выбрано несколько раз", target)
+		}
+		used[target] = true
+
+		if hash, ok := strings.CutPrefix(target, ImportFieldPrefix); ok {
+			if !lo.ContainsBy(fields, func(f ImportField) bool { return f.Hash == hash }) {
+				return fmt.Errorf("поле проекта %s не найдено", hash)
+			}
+
+			continue
+		}
+
+		if !lo.Contains(GetImportTargets(), target) {
+			return fmt.Errorf("неизвестное поле %s", target)
+		}
+	}
+
+	if !used[ImportName] {
+		return ErrImportNameMiss
+	}
+
+	return nil
+}
+
+// RowTask builds task from the row by the import mapping, project fields are put to RawFields.
+func (imp *TaskImport) RowTask(i int, fields []ImportField) (task Task, err error) {
+	row := imp.Rows[i]
+
+	value := func(target string) string {
+		for col, t := range imp.Mapping {
+			if t == target && col < len(row) {
+				return strings.TrimSpace(row[col])
+			}
+		}
+
+		return ""
+	}
+
+	priority := 0
+	if v := value(ImportPriority); v != "" {
+		priority, err = strconv.Atoi(v)
+		if err != nil {
+			return task, fmt.Errorf("приоритет должен быть числом: %s", v)
+		}
+	}
+
+	var finishTo *time.Time
+	if v := value(ImportFinishTo); v != "" {
+		t, err := parseImportTime(v)
+		if err != nil {
+			return task, fmt.Errorf("некорректный срок: %s", v)
+		}
+		finishTo = &t
+	}
+
+	rawFields := make(map[string]interface{})
+
+	for _, field := range fields {
+		v := value(ImportFieldPrefix + field.Hash)
+		if v == "" {
+			continue
+		}
+
+		parsed, err := ParseImportValue(field.DataType, v)
+		if err != nil {
+			return task, fmt.Errorf("поле %s: %w", field.Name, err)
+		}
+
+		rawFields[field.Hash] = parsed
+	}
+
+	return NewTask(
+		value(ImportName),
+		imp.FederationUUID,
+		imp.CompanyUUID,
+		imp.ProjectUUID,
+		imp.CreatedBy,
+		rawFields,
+		splitImportList(value(ImportTags)),
+		value(ImportDescription),
+		[]string{},
+		splitImportList(value(ImportCoworkersBy)),
+		value(ImportImplementBy),
+		value(ImportResponsibleBy),
+		priority,
+		finishTo,
+		"",
+		value(ImportManagedBy),
+		make(map[uuid.UUID][]string),
+	)
+}
+
+// ParseImportValue converts cell text to the value expected by the project field type.
+func ParseImportValue(dataType FieldDataType, v string) (interface{}, error) {
+	switch dataType {
+	case Integer, Phone:
+		i, err := strconv.Atoi(strings.ReplaceAll(v, " ", ""))
+		if err != nil {
+			return nil, fmt.Errorf("ожидается целое число: %s", v)
+		}
+
+		return i, nil
+	case Float:
+		f, err := strconv.ParseFloat(strings.ReplaceAll(v, ",", "."), 64)
+		if err != nil {
+			return nil, fmt.Errorf("ожидается число: %s", v)
+		}
+
+		return f, nil
+	case Switch:
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("ожидается 0, 1 или 2: %s", v)
+		}
+
+		return float64(i), nil
+	case Bool:
+		switch strings.ToLower(v) {
+		case "1", "true", "yes", "да":
+			return true, nil
+		case "0", "false", "no", "нет":
+			return false, nil
+		}
+
+		return nil, fmt.Errorf("ожидается да или нет: %s", v)
+	case Array, People:
+		return lo.Map(splitImportList(v), func(item string, _ int) interface{} { return item }), nil
+	case Time:
+		t, err := time.Parse("15:04", v)
+		if err != nil {
+			return nil, fmt.Errorf("ожидается время ЧЧ:ММ: %s", v)
+		}
+
+		return t.Format(time.RFC3339), nil
+	case DateTime:
+		t, err := parseImportTime(v)
+		if err != nil {
+			return nil, fmt.Errorf("ожидается дата: %s", v)
+		}
+
+		return t.Format(time.RFC3339), nil
+	case Data, DataArray:
+		return nil, errors.New("импорт значений справочника не поддерживается")
+	}
+
+	return v, nil
+}
+
+func parseImportTime(v string) (t time.Time, err error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04", "2006-01-02", "02.01.2006 15:04", "02.01.2006"} {
+		t, err = time.Parse(layout, v)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return t, err
+}
+
+func splitImportList(v string) []string {
+	items := strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ';' })
+
+	return lo.WithoutEmpty(lo.Map(items, func(item string, _ int) string { return strings.TrimSpace(item) }))
+}
+
+func normalizeImportTitle(title string) string {
+	title = strings.ToLower(strings.TrimSpace(title))
+
+	return strings.Join(strings.FieldsFunc(title, func(r rune) bool { return r == '_' || r == '-' || r == ' ' }), " ")
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSuggestImportMapping(t *testing.T) {
+	header := []string{"Название", "Due_Date", "Budget", "comment", "Теги", "title"}
+	fields := []ImportField{{Hash: "f1", Name: "budget", DataType: Float}}
+
+	got := SuggestImportMapping(header, fields)
+	want := map[int]string{0: ImportName, 1: ImportFinishTo, 2: ImportFieldPrefix + "f1", 4: ImportTags}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SuggestImportMapping = %v, want %v", got, want)
+	}
+}
+
+func TestParseImportValue(t *testing.T) {
+	tests := []struct {
+		dataType FieldDataType
+		value    string
+		want     interface{}
+		wantErr  bool
+	}{
+		{dataType: Integer, value: "1 200", want: 1200},
+		{dataType: Integer, value: "abc", wantErr: true},
+		{dataType: Float, value: "2,5", want: 2.5},
+		{dataType: Bool, value: "Да", want: true},
+		{dataType: Switch, value: "2", want: float64(2)},
+		{dataType: Array, value: "a; b,,c", want: []interface{}{"a", "b", "c"}},
+		{dataType: DateTime, value: "17.10.2026", want: "2026-10-17T00:00:00Z"},
+		{dataType: Data, value: "x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseImportValue(tt.dataType, tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseImportValue(%v, %q) error = %v, wantErr %v", tt.dataType, tt.value, err, tt.wantErr)
+			continue
+		}
+
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseImportValue(%v, %q) = %v, want %v", tt.dataType, tt.value, got, tt.want)
+		}
+	}
+}
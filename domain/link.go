@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	TaskLinkBlocks     = "blocks"
+	TaskLinkRelatesTo  = "relates-to"
+	TaskLinkDuplicates = "duplicates"
+)
+
+const (
+	TaskLinkOutward = "outward"
+	TaskLinkInward  = "inward"
+)
+
+var (
+	ErrTaskLinkInvalidType = errors.New("неизвестный тип связи")
+	ErrTaskLinkSelf        = errors.New("задача не может быть связана сама с собой")
+	ErrTaskLinkExists      = errors.New("связь уже существует")
+	ErrTaskLinkCycle       = errors.New("связь образует цикл")
+)
+
+func GetTaskLinkTypes() []string {
+	return []string{TaskLinkBlocks, TaskLinkRelatesTo, TaskLinkDuplicates}
+}
+
+// TaskLink - typed link "from <type> to", e.g. from blocks to.
+type TaskLink struct {
+	UUID     uuid.UUID
+	FromUUID uuid.UUID
+	ToUUID   uuid.UUID
+	Type     string
+
+	CreatedBy     string
+	CreatedByUUID uuid.UUID
+	CreatedAt     time.Time
+
+	// Task - the other side of the link, filled on read
+	Task *Task
+}
+
+func NewTaskLink(crtr Creator, fromUUID, toUUID uuid.UUID, linkType string) (TaskLink, error) {
+	link := TaskLink{
+		UUID:          uuid.New(),
+		FromUUID:      fromUUID,
+		ToUUID:        toUUID,
+		Type:          linkType,
+		CreatedBy:     crtr.Email,
+		CreatedByUUID: crtr.UUID,
+		CreatedAt:     time.Now(),
+	}
+
+	if lo.IndexOf(GetTaskLinkTypes(), linkType) == -1 {
+		return link, ErrTaskLinkInvalidType
+	}
+
+	if fromUUID == toUUID {
+		return link, ErrTaskLinkSelf
+	}
+
+	return link, nil
+}
+
+// IsDirected - directed links can not form a cycle, relates-to is symmetric.
+func (l TaskLink) IsDirected() bool {
+	return l.Type != TaskLinkRelatesTo
+}
+
+// Direction returns link direction relative to the task.
+func (l TaskLink) Direction(taskUUID uuid.UUID) string {
+	if l.FromUUID == taskUUID {
+		return TaskLinkOutward
+	}
+
+	return TaskLinkInward
+}
+
+// Other returns uuid of the other side of the link.
+func (l TaskLink) Other(taskUUID uuid.UUID) uuid.UUID {
+	if l.FromUUID == taskUUID {
+		return l.ToUUID
+	}
+
+	return l.FromUUID
+}
+
+// HasPath checks that "to" is reachable from "from" by links of the type.
+func HasPath(links []TaskLink, from, to uuid.UUID, linkType string) bool {
+	next := lo.GroupBy(lo.Filter(links, func(l TaskLink, _ int) bool {
+		return l.Type == linkType
+	}), func(l TaskLink) uuid.UUID {
+		return l.FromUUID
+	})
+
+	seen := map[uuid.UUID]bool{from: true}
+	queue := []uuid.UUID{from}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == to {
+			return true
+		}
+
+		for _, l := range next[current] {
+			if !seen[l.ToUUID] {
+				seen[l.ToUUID] = true
+				queue = append(queue, l.ToUUID)
+			}
+		}
+	}
+
+	return false
+}
+
+func IsTaskOpen(status int) bool {
+	return status != StatusDone && status != StatusCancel
+}
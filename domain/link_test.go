@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestHasPath(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	link := func(from, to uuid.UUID, linkType string) TaskLink {
+		return TaskLink{FromUUID: from, ToUUID: to, Type: linkType}
+	}
+
+	// a blocks b, b blocks c, c duplicates d, d relates to a
+	links := []TaskLink{
+		link(a, b, TaskLinkBlocks),
+		link(b, c, TaskLinkBlocks),
+		link(c, d, TaskLinkDuplicates),
+		link(d, a, TaskLinkRelatesTo),
+	}
+
+	tests := []struct {
+		name     string
+		from, to uuid.UUID
+		linkType string
+		want     bool
+	}{
+		{name: "direct", from: a, to: b, linkType: TaskLinkBlocks, want: true},
+		{name: "transitive", from: a, to: c, linkType: TaskLinkBlocks, want: true},
+		{name: "against direction", from: c, to: a, linkType: TaskLinkBlocks, want: false},
+		{name: "other type is not followed", from: a, to: d, linkType: TaskLinkBlocks, want: false},
+		{name: "same task", from: d, to: d, linkType: TaskLinkBlocks, want: true},
+		{name: "no links of the type", from: a, to: b, linkType: TaskLinkDuplicates, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasPath(links, tt.from, tt.to, tt.linkType); got != tt.want {
+				t.Errorf("HasPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// existing cycle a -> b -> c -> a is walked once
+	cyclic := append([]TaskLink{link(c, a, TaskLinkBlocks)}, links...)
+	if HasPath(cyclic, a, d, TaskLinkBlocks) {
+		t.Errorf("HasPath() = true, want false for the cycle")
+	}
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Conflict policy for custom fields filled in both tasks.
+const (
+	MergeKeepTarget = "target"
+	MergeKeepSource = "source"
+	MergeFail       = "fail"
+)
+
+var (
+	ErrMergeSelf          = errors.New("задача не может быть объединена сама с собой")
+	ErrMergeInvalidPolicy = errors.New("неизвестная политика объединения полей")
+)
+
+func GetMergePolicies() []string {
+	return []string{MergeKeepTarget, MergeKeepSource, MergeFail}
+}
+
+// MergeFields adds fields of the source to the target. Fields filled in both tasks with different values
+// are conflicts, they are resolved by the policy or the merge fails.
+func MergeFields(target, source map[string]interface{}, policy string) (fields map[string]interface{}, conflicts []string, err error) {
+	if !lo.Contains(GetMergePolicies(), policy) {
+		return fields, conflicts, ErrMergeInvalidPolicy
+	}
+
+	fields = make(map[string]interface{}, len(target)+len(source))
+	for hash, value := range target {
+		fields[hash] = value
+	}
+
+	for hash, value := range source {
+		current, ok := fields[hash]
+		if !ok || current == nil {
+			fields[hash] = value
+			continue
+		}
+
+		if value == nil || reflect.DeepEqual(current, value) {
+			continue
+		}
+
+		conflicts = append(conflicts, hash)
+
+		if policy == MergeKeepSource {
+			fields[hash] = value
+		}
+	}
+
+	sort.Strings(conflicts)
+
+	if policy == MergeFail && len(conflicts) > 0 {
+		return fields, conflicts, fmt.Errorf("поля заполнены в обеих задачах: %s", strings.Join(conflicts, ", "))
+	}
+
+	return fields, conflicts, nil
+}
+
+// MergeFrom adds tags, watchers and co-workers of the source task.
+func (t *Task) MergeFrom(source Task) {
+	t.Tags = lo.Uniq(append(append([]string{}, t.Tags...), source.Tags...))
+	t.WatchBy = lo.Uniq(append(append([]string{}, t.WatchBy...), source.WatchBy...))
+	t.CoWorkersBy = lo.Uniq(append(append([]string{}, t.CoWorkersBy...), source.CoWorkersBy...))
+
+	t.People = lo.WithoutEmpty(lo.Uniq(append(append(append([]string{}, t.People...), t.WatchBy...), t.CoWorkersBy...)))
+}
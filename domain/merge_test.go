@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeFields(t *testing.T) {
+	target := map[string]interface{}{"a": "Москва", "b": 1.0, "c": nil}
+	source := map[string]interface{}{"a": "Казань", "b": 1.0, "c": true, "d": []interface{}{"x"}}
+
+	tests := []struct {
+		name      string
+		policy    string
+		want      map[string]interface{}
+		conflicts []string
+		wantErr   bool
+	}{
+		{
+			name:      "keep target",
+			policy:    MergeKeepTarget,
+			want:      map[string]interface{}{"a": "Москва", "b": 1.0, "c": true, "d": []interface{}{"x"}},
+			conflicts: []string{"a"},
+		},
+		{
+			name:      "keep source",
+			policy:    MergeKeepSource,
+			want:      map[string]interface{}{"a": "Казань", "b": 1.0, "c": true, "d": []interface{}{"x"}},
+			conflicts: []string{"a"},
+		},
+		{
+			name:      "fail",
+			policy:    MergeFail,
+			conflicts: []string{"a"},
+			wantErr:   true,
+		},
+		{
+			name:    "unknown policy",
+			policy:  "both",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflicts, err := MergeFields(target, source, tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MergeFields() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(conflicts, tt.conflicts) {
+				t.Errorf("MergeFields() conflicts = %v, want %v", conflicts, tt.conflicts)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -47,6 +47,15 @@ type ProjectOptions struct {
 	RequireDoneComment        *bool   `json:"require_done_comment,omitempty"`
 	StatusEnable              *bool   `json:"status_enable,omitempty"`
 	Color                     *string `json:"color,omitempty"`
+
+	// Escalations - deadline notification levels, default levels are used when nil
+	Escalations *[]EscalationLevel `json:"escalations,omitempty"`
+
+	// FieldRules - required and read-only custom fields by task statuses
+	FieldRules *[]FieldRule `json:"field_rules,omitempty"`
+
+	// EstimateUnit - points or hours, see GetEstimateUnits
+	EstimateUnit *string `json:"estimate_unit,omitempty"`
 }
 
 type ProjectParams struct {
@@ -140,6 +149,7 @@ const (
 	Time      FieldDataType = 12
 	DateTime  FieldDataType = 13
 	People    FieldDataType = 14
+	Formula   FieldDataType = 15
 )
 
 type ProjectCatalogType string
@@ -172,6 +182,7 @@ type CompanyField struct {
 	CompanyUUID        uuid.UUID     `validate:"uuid" ru:"компания uuid"`
 	RequiredOnStatuses []int         `validate:"lte=50" ru:"необходимо на статусе"`
 	Style              string        `validate:"lte=20" ru:"стиль"`
+	Formula            string        `validate:"lte=500" ru:"формула"`
 	CreatedBy          string
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
@@ -227,6 +238,8 @@ func (pf *CompanyField) FieldTypeDesc() string {
 		return "datetime"
 	case People:
 		return "people"
+	case Formula:
+		return "formula"
 	}
 
 	return "unknown"
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// Board rank is a lexicographically ordered string ("lexorank"), a card can always
+// be placed between two others without renumbering the column. Generated ranks
+// never end with the lowest digit, so there is always room before any rank.
+const (
+	rankAlphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+	rankBase     = len(rankAlphabet)
+
+	// RankMaxLen - longer ranks should be rebalanced
+	RankMaxLen = 64
+)
+
+var ErrInvalidRank = errors.New("некорректный порядок карточек")
+
+// RankBetween returns rank strictly between prev and next, empty prev and next mean column start and end.
+func RankBetween(prev, next string) (string, error) {
+	if !validRank(prev) || !validRank(next) {
+		return "", ErrInvalidRank
+	}
+
+	if next != "" && prev >= next {
+		return "", ErrInvalidRank
+	}
+
+	var b strings.Builder
+
+	upper := next != ""
+
+	for i := 0; ; i++ {
+		pd := 0
+		if i < len(prev) {
+			pd = strings.IndexByte(rankAlphabet, prev[i])
+		}
+
+		nd := rankBase
+		if upper {
+			nd = strings.IndexByte(rankAlphabet, next[i])
+		}
+
+		if pd == nd {
+			b.WriteByte(rankAlphabet[pd])
+			continue
+		}
+
+		if nd-pd > 1 {
+			b.WriteByte(rankAlphabet[(pd+nd)/2])
+			return b.String(), nil
+		}
+
+		// adjacent digits: keep prev digit, anything after it is less than next
+		b.WriteByte(rankAlphabet[pd])
+		upper = false
+	}
+}
+
+// RankSequence returns n evenly spaced ascending ranks.
+func RankSequence(n int) []string {
+	ranks := make([]string, 0, n)
+	if n <= 0 {
+		return ranks
+	}
+
+	width, space := 1, rankBase
+	for space <= n {
+		width++
+		space *= rankBase
+	}
+
+	step := space / (n + 1)
+
+	for k := 1; k <= n; k++ {
+		digits := make([]byte, width)
+
+		v := k * step
+		for i := width - 1; i >= 0; i-- {
+			digits[i] = rankAlphabet[v%rankBase]
+			v /= rankBase
+		}
+
+		ranks = append(ranks, strings.TrimRight(string(digits), rankAlphabet[:1]))
+	}
+
+	return ranks
+}
+
+func validRank(rank string) bool {
+	if strings.HasSuffix(rank, rankAlphabet[:1]) {
+		return false
+	}
+
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(rankAlphabet, rank[i]) == -1 {
+			return false
+		}
+	}
+
+	return true
+}
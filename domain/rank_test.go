@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		prev string
+		next string
+	}{
+		{prev: "", next: ""},
+		{prev: "", next: "i"},
+		{prev: "i", next: ""},
+		{prev: "a", next: "b"},
+		{prev: "az", next: "b"},
+		{prev: "zz", next: ""},
+		{prev: "", next: "01"},
+		{prev: "a", next: "a01"},
+		{prev: "a1", next: "a2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prev+"_"+tt.next, func(t *testing.T) {
+			got, err := RankBetween(tt.prev, tt.next)
+			if err != nil {
+				t.Fatalf("RankBetween(%v, %v) error: %v", tt.prev, tt.next, err)
+			}
+
+			if got <= tt.prev || (tt.next != "" && got >= tt.next) || !validRank(got) {
+				t.Errorf("RankBetween(%v, %v) = %v", tt.prev, tt.next, got)
+			}
+		})
+	}
+}
+
+func TestRankBetweenInvalid(t *testing.T) {
+	tests := [][2]string{{"b", "a"}, {"a", "a"}, {"a0", ""}, {"A", ""}}
+
+	for _, tt := range tests {
+		if _, err := RankBetween(tt[0], tt[1]); err == nil {
+			t.Errorf("RankBetween(%v, %v) expected error", tt[0], tt[1])
+		}
+	}
+}
+
+func TestRankRepeatedInsert(t *testing.T) {
+	prev, next := "", "i"
+
+	for i := 0; i < 100; i++ {
+		got, err := RankBetween(prev, next)
+		if err != nil || got <= prev || got >= next {
+			t.Fatalf("step %d: RankBetween(%v, %v) = %v, %v", i, prev, next, got, err)
+		}
+
+		prev = got
+	}
+}
+
+func TestRankSequence(t *testing.T) {
+	for _, n := range []int{1, 35, 36, 1000} {
+		ranks := RankSequence(n)
+
+		if len(ranks) != n || !sort.StringsAreSorted(ranks) {
+			t.Fatalf("RankSequence(%d) not sorted", n)
+		}
+
+		for i, r := range ranks {
+			if !validRank(r) || r == "" || (i > 0 && ranks[i-1] == r) {
+				t.Fatalf("RankSequence(%d)[%d] = %v", n, i, r)
+			}
+		}
+	}
+}
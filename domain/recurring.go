@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+const TemplateTypeRecurringTask = "recurring_task"
+
+var ErrScheduleHasNoOccurrences = errors.New("расписание не содержит будущих запусков")
+
+// TaskBlueprint keeps inputs of NewTask for recurring task occurrences.
+type TaskBlueprint struct {
+	Name          string                 `json:"name" validate:"lte=100,gte=3"  ru:"название"`
+	Description   string                 `json:"description" validate:"lte=5000"  ru:"описание"`
+	Fields        map[string]interface{} `json:"fields"`
+	Tags          []string               `json:"tags"`
+	Priority      int                    `json:"priority"`
+	Path          []string               `json:"path"`
+	ImplementBy   string                 `json:"implement_by"`
+	ResponsibleBy string                 `json:"responsible_by"`
+	ManagedBy     string                 `json:"managed_by"`
+	CoWorkersBy   []string               `json:"coworkers_by"`
+	Icon          string                 `json:"icon"`
+
+	// FinishIn - minutes from occurrence to task finish_to
+	FinishIn *int `json:"finish_in,omitempty"`
+}
+
+type RecurringTask struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+	CreatedBy      string
+	CreatedByUUID  uuid.UUID
+
+	Schedule  string `validate:"gte=5,lte=255"  ru:"расписание"`
+	Blueprint TaskBlueprint
+
+	IsPaused  bool
+	NextRunAt *time.Time
+	LastRunAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewRecurringTask(federationUUID, companyUUID, projectUUID uuid.UUID, createdBy string, createdByUUID uuid.UUID, schedule string, blueprint TaskBlueprint) (rt RecurringTask, err error) {
+	rt = RecurringTask{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		CompanyUUID:    companyUUID,
+		ProjectUUID:    projectUUID,
+		CreatedBy:      createdBy,
+		CreatedByUUID:  createdByUUID,
+		Schedule:       schedule,
+		Blueprint:      blueprint,
+		CreatedAt:      time.Now(),
+	}
+
+	// validate blueprint with the same rules as a task
+	_, err = rt.NewTask(rt.CreatedAt)
+	if err != nil {
+		return rt, err
+	}
+
+	next, err := rt.Next(rt.CreatedAt)
+	if err != nil {
+		return rt, err
+	}
+
+	if next == nil {
+		return rt, ErrScheduleHasNoOccurrences
+	}
+
+	rt.NextRunAt = next
+
+	return rt, nil
+}
+
+func (rt *RecurringTask) ParseSchedule() (*Schedule, error) {
+	return NewSchedule(rt.Schedule, rt.CreatedAt)
+}
+
+// Next returns the first occurrence after given time or nil when schedule is finished.
+func (rt *RecurringTask) Next(after time.Time) (*time.Time, error) {
+	s, err := rt.ParseSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	next, ok := s.Next(after)
+	if !ok {
+		return nil, nil
+	}
+
+	return &next, nil
+}
+
+func (rt *RecurringTask) Upcoming(after time.Time, n int) ([]time.Time, error) {
+	s, err := rt.ParseSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Upcoming(after, n), nil
+}
+
+// NewTask materialises an occurrence of the blueprint.
+func (rt *RecurringTask) NewTask(occurrence time.Time) (Task, error) {
+	bp := rt.Blueprint
+
+	var finishTo *time.Time
+	if bp.FinishIn != nil {
+		finishTo = helpers.Ptr(occurrence.Add(time.Duration(*bp.FinishIn) * time.Minute))
+	}
+
+	fields := make(map[string]interface{}, len(bp.Fields))
+	for k, v := range bp.Fields {
+		fields[k] = v
+	}
+
+	return NewTask(
+		bp.Name,
+		rt.FederationUUID,
+		rt.CompanyUUID,
+		rt.ProjectUUID,
+		rt.CreatedBy,
+		fields,
+		append([]string{}, bp.Tags...),
+		bp.Description,
+		append([]string{}, bp.Path...),
+		append([]string{}, bp.CoWorkersBy...),
+		bp.ImplementBy,
+		bp.ResponsibleBy,
+		bp.Priority,
+		finishTo,
+		bp.Icon,
+		bp.ManagedBy,
+		make(map[uuid.UUID][]string),
+	)
+}
@@ -0,0 +1,371 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+var ErrInvalidSchedule = errors.New("некорректное расписание")
+
+// scheduleHorizon limits the search of the next occurrence.
+const scheduleHorizon = 5 * 366 * 24 * time.Hour
+
+const (
+	FreqCron    = ""
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+	FreqYearly  = "YEARLY"
+)
+
+// Schedule is a parsed cron expression ("0 9 * * 1-5") or RRULE
+// ("FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;BYHOUR=9").
+type Schedule struct {
+	Expr string
+
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekdays uint64
+
+	// cron semantics: when both day of month and day of week are restricted, either matches
+	daysStar     bool
+	weekdaysStar bool
+
+	freq     string
+	interval int
+	anchor   time.Time
+}
+
+var rruleWeekdays = map[string]int{
+	"SU": 0, "MO": 1, "TU": 2, "WE": 3, "TH": 4, "FR": 5, "SA": 6,
+}
+
+// NewSchedule parses cron or RRULE expression. Anchor is used as DTSTART for RRULE:
+// it defines INTERVAL counting and default time/day parts.
+func NewSchedule(expr string, anchor time.Time) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, ErrInvalidSchedule
+	}
+
+	if strings.Contains(strings.ToUpper(expr), "FREQ=") {
+		return parseRRule(expr, anchor)
+	}
+
+	return parseCron(expr)
+}
+
+func parseCron(expr string) (*Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("%w: cron должен содержать 5 полей", ErrInvalidSchedule)
+	}
+
+	s := &Schedule{Expr: expr, interval: 1}
+
+	var err error
+
+	if s.minutes, err = parseCronField(parts[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if s.hours, err = parseCronField(parts[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if s.days, err = parseCronField(parts[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if s.months, err = parseCronField(parts[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if s.weekdays, err = parseCronField(parts[4], 0, 7); err != nil {
+		return nil, err
+	}
+
+	// 7 is sunday too
+	if s.weekdays&(1<<7) != 0 {
+		s.weekdays |= 1
+	}
+
+	s.daysStar = strings.HasPrefix(parts[2], "*")
+	s.weekdaysStar = strings.HasPrefix(parts[4], "*")
+
+	return s, nil
+}
+
+func parseCronField(field string, min, max int) (bits uint64, err error) {
+	for _, item := range strings.Split(field, ",") {
+		step, stepped := 1, false
+		if i := strings.Index(item, "/"); i >= 0 {
+			step, err = strconv.Atoi(item[i+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("%w: шаг %q", ErrInvalidSchedule, item)
+			}
+			item, stepped = item[:i], true
+		}
+
+		from, to := min, max
+
+		switch {
+		case item == "*":
+		case strings.Contains(item, "-"):
+			bounds := strings.SplitN(item, "-", 2)
+			from, err = strconv.Atoi(bounds[0])
+			if err != nil {
+				return 0, fmt.Errorf("%w: диапазон %q", ErrInvalidSchedule, item)
+			}
+			to, err = strconv.Atoi(bounds[1])
+			if err != nil {
+				return 0, fmt.Errorf("%w: диапазон %q", ErrInvalidSchedule, item)
+			}
+		default:
+			from, err = strconv.Atoi(item)
+			if err != nil {
+				return 0, fmt.Errorf("%w: значение %q", ErrInvalidSchedule, item)
+			}
+
+			// "N/step" runs from N to the end of the range
+			to = lo.Ternary(stepped, max, from)
+		}
+
+		if from < min || to > max || from > to {
+			return 0, fmt.Errorf("%w: значение %q вне диапазона %v-%v", ErrInvalidSchedule, item, min, max)
+		}
+
+		for v := from; v <= to; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+func parseRRule(expr string, anchor time.Time) (*Schedule, error) {
+	s := &Schedule{Expr: expr, interval: 1, anchor: anchor, daysStar: true, weekdaysStar: true}
+
+	rule := strings.TrimPrefix(strings.ToUpper(expr), "RRULE:")
+
+	params := make(map[string]string)
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSchedule, part)
+		}
+		params[kv[0]] = kv[1]
+	}
+
+	s.freq = params["FREQ"]
+	switch s.freq {
+	case FreqDaily, FreqWeekly, FreqMonthly, FreqYearly:
+	default:
+		return nil, fmt.Errorf("%w: FREQ=%s не поддерживается", ErrInvalidSchedule, s.freq)
+	}
+
+	if v, ok := params["INTERVAL"]; ok {
+		interval, err := strconv.Atoi(v)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("%w: INTERVAL=%s", ErrInvalidSchedule, v)
+		}
+		s.interval = interval
+	}
+
+	var err error
+
+	if s.minutes, err = parseRRuleList(params, "BYMINUTE", 0, 59, anchor.Minute()); err != nil {
+		return nil, err
+	}
+	if s.hours, err = parseRRuleList(params, "BYHOUR", 0, 23, anchor.Hour()); err != nil {
+		return nil, err
+	}
+
+	s.months = parseAll(1, 12)
+	s.days = parseAll(1, 31)
+	s.weekdays = parseAll(0, 6)
+
+	if v, ok := params["BYDAY"]; ok {
+		s.weekdays = 0
+		for _, day := range strings.Split(v, ",") {
+			n, found := rruleWeekdays[day]
+			if !found {
+				return nil, fmt.Errorf("%w: BYDAY=%s", ErrInvalidSchedule, day)
+			}
+			s.weekdays |= 1 << uint(n)
+		}
+		s.weekdaysStar = false
+	}
+
+	if _, ok := params["BYMONTHDAY"]; ok {
+		if s.days, err = parseRRuleList(params, "BYMONTHDAY", 1, 31, 0); err != nil {
+			return nil, err
+		}
+		s.daysStar = false
+	}
+
+	if _, ok := params["BYMONTH"]; ok {
+		if s.months, err = parseRRuleList(params, "BYMONTH", 1, 12, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	// defaults from DTSTART
+	switch s.freq {
+	case FreqWeekly:
+		if s.weekdaysStar {
+			s.weekdays = 1 << uint(anchor.Weekday())
+			s.weekdaysStar = false
+		}
+	case FreqMonthly:
+		if s.daysStar && s.weekdaysStar {
+			s.days = 1 << uint(anchor.Day())
+			s.daysStar = false
+		}
+	case FreqYearly:
+		if _, ok := params["BYMONTH"]; !ok {
+			s.months = 1 << uint(anchor.Month())
+		}
+		if s.daysStar && s.weekdaysStar {
+			s.days = 1 << uint(anchor.Day())
+			s.daysStar = false
+		}
+	}
+
+	// RRULE combines BYDAY and BYMONTHDAY with AND
+	if !s.daysStar && !s.weekdaysStar {
+		return nil, fmt.Errorf("%w: BYDAY и BYMONTHDAY одновременно не поддерживаются", ErrInvalidSchedule)
+	}
+
+	return s, nil
+}
+
+func parseRRuleList(params map[string]string, key string, min, max, def int) (bits uint64, err error) {
+	v, ok := params[key]
+	if !ok {
+		return 1 << uint(def), nil
+	}
+
+	for _, item := range strings.Split(v, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n < min || n > max {
+			return 0, fmt.Errorf("%w: %s=%s", ErrInvalidSchedule, key, v)
+		}
+		bits |= 1 << uint(n)
+	}
+
+	return bits, nil
+}
+
+func parseAll(min, max int) (bits uint64) {
+	for v := min; v <= max; v++ {
+		bits |= 1 << uint(v)
+	}
+
+	return bits
+}
+
+// Next returns the first occurrence strictly after given time.
+func (s *Schedule) Next(after time.Time) (time.Time, bool) {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := after.Add(scheduleHorizon)
+
+	for t.Before(limit) {
+		if s.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.hours&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t, true
+	}
+
+	return time.Time{}, false
+}
+
+// Upcoming returns up to n next occurrences after given time.
+func (s *Schedule) Upcoming(after time.Time, n int) []time.Time {
+	res := []time.Time{}
+
+	for i := 0; i < n; i++ {
+		next, ok := s.Next(after)
+		if !ok {
+			break
+		}
+
+		res = append(res, next)
+		after = next
+	}
+
+	return res
+}
+
+func (s *Schedule) matchDay(t time.Time) bool {
+	day := s.days&(1<<uint(t.Day())) != 0
+	weekday := s.weekdays&(1<<uint(t.Weekday())) != 0
+
+	var match bool
+	switch {
+	case s.daysStar || s.weekdaysStar:
+		match = day && weekday
+	default:
+		match = day || weekday
+	}
+
+	if !match {
+		return false
+	}
+
+	if s.interval <= 1 || s.freq == FreqCron {
+		return true
+	}
+
+	return s.periodsSinceAnchor(t)%s.interval == 0
+}
+
+func (s *Schedule) periodsSinceAnchor(t time.Time) int {
+	a := s.anchor.In(t.Location())
+
+	switch s.freq {
+	case FreqDaily:
+		return dayNumber(t) - dayNumber(a)
+	case FreqWeekly:
+		// weeks start on monday
+		aw := dayNumber(a) - (int(a.Weekday())+6)%7
+		tw := dayNumber(t) - (int(t.Weekday())+6)%7
+		return (tw - aw) / 7
+	case FreqMonthly:
+		return (t.Year()-a.Year())*12 + int(t.Month()) - int(a.Month())
+	case FreqYearly:
+		return t.Year() - a.Year()
+	}
+
+	return 0
+}
+
+// dayNumber returns number of the calendar day since unix epoch.
+func dayNumber(t time.Time) int {
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleNext(t *testing.T) {
+	// 2024-05-06 is monday
+	anchor := time.Date(2024, 5, 6, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		expr  string
+		after time.Time
+		want  time.Time
+	}{
+		{
+			name:  "cron every weekday at 9:00",
+			expr:  "0 9 * * 1-5",
+			after: time.Date(2024, 5, 10, 10, 0, 0, 0, time.UTC),
+			want:  time.Date(2024, 5, 13, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "cron first day of month",
+			expr:  "15 8 1 * *",
+			after: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC),
+			want:  time.Date(2024, 6, 1, 8, 15, 0, 0, time.UTC),
+		},
+		{
+			name:  "cron step",
+			expr:  "*/20 * * * *",
+			after: time.Date(2024, 5, 6, 10, 41, 0, 0, time.UTC),
+			want:  time.Date(2024, 5, 6, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "cron step from value",
+			expr:  "5/15 * * * *",
+			after: time.Date(2024, 5, 6, 10, 21, 0, 0, time.UTC),
+			want:  time.Date(2024, 5, 6, 10, 35, 0, 0, time.UTC),
+		},
+		{
+			name:  "cron step in range",
+			expr:  "0 8-18/4 * * *",
+			after: time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC),
+			want:  time.Date(2024, 5, 6, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "cron day of month or sunday",
+			expr:  "0 0 20 * 0",
+			after: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC),
+			want:  time.Date(2024, 5, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "rrule weekly defaults to anchor weekday and time",
+			expr:  "FREQ=WEEKLY",
+			after: time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC),
+			want:  time.Date(2024, 5, 13, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "rrule biweekly",
+			expr:  "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TH;BYHOUR=12;BYMINUTE=0",
+			after: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+			want:  time.Date(2024, 5, 23, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "rrule monthly by month day",
+			expr:  "FREQ=MONTHLY;BYMONTHDAY=31;BYHOUR=18;BYMINUTE=0",
+			after: time.Date(2024, 5, 31, 19, 0, 0, 0, time.UTC),
+			want:  time.Date(2024, 7, 31, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "rrule every third day",
+			expr:  "FREQ=DAILY;INTERVAL=3",
+			after: time.Date(2024, 5, 6, 10, 0, 0, 0, time.UTC),
+			want:  time.Date(2024, 5, 9, 9, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewSchedule(tt.expr, anchor)
+			if err != nil {
+				t.Fatalf("NewSchedule(%v) error: %v", tt.expr, err)
+			}
+
+			got, ok := s.Next(tt.after)
+			if !ok || !got.Equal(tt.want) {
+				t.Errorf("Schedule (%v) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"0 9 * *",
+		"61 * * * *",
+		"0 9 * * MON",
+		"*/0 * * * *",
+		"0/x * * * *",
+		"70/10 * * * *",
+		"FREQ=HOURLY",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYDAY=MO;BYMONTHDAY=1",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := NewSchedule(expr, time.Now()); err == nil {
+				t.Errorf("NewSchedule(%v) expected error", expr)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"html"
+	"strings"
+)
+
+// Highlight markers put by ts_headline around matches. Private use characters do not occur in
+// normal text, so the fragment can be escaped before the markers become tags.
+const (
+	HighlightStart = "\uE000"
+	HighlightStop  = "\uE001"
+)
+
+// SearchHighlight escapes the fragment of user text and wraps matches in <b>.
+func SearchHighlight(fragment string) string {
+	if fragment == "" {
+		return ""
+	}
+
+	return strings.NewReplacer(HighlightStart, "<b>", HighlightStop, "</b>").Replace(html.EscapeString(fragment))
+}
+
+// SearchText returns the trimmed full-text query, blank query means no search.
+func SearchText(search *string) *string {
+	if search == nil {
+		return nil
+	}
+
+	text := strings.TrimSpace(*search)
+	if text == "" {
+		return nil
+	}
+
+	return &text
+}
+
+// ParseTaskCursor decodes the cursor of the task list, results of full-text search are paged by offset only.
+func ParseTaskCursor(token string, search bool, order, by string) (c TaskCursor, err error) {
+	if search {
+		return c, ErrCursorSearch
+	}
+
+	c, err = DecodeTaskCursor(token)
+	if err != nil {
+		return c, err
+	}
+
+	return c, c.Check(order, by)
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func TestSearchHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		fragment string
+		want     string
+	}{
+		{
+			name:     "empty",
+			fragment: "",
+			want:     "",
+		},
+		{
+			name:     "match",
+			fragment: "Сдать " + HighlightStart + "отчет" + HighlightStop + " в пятницу",
+			want:     "Сдать <b>отчет</b> в пятницу",
+		},
+		{
+			name:     "markup is escaped",
+			fragment: `<img src=x onerror="alert(1)"> ` + HighlightStart + "отчет" + HighlightStop + " & <b>итоги</b>",
+			want:     `&lt;img src=x onerror=&#34;alert(1)&#34;&gt; <b>отчет</b> &amp; &lt;b&gt;итоги&lt;/b&gt;`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SearchHighlight(tt.fragment); got != tt.want {
+				t.Errorf("SearchHighlight() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchText(t *testing.T) {
+	tests := []struct {
+		name   string
+		search *string
+		want   *string
+	}{
+		{name: "no search", search: nil, want: nil},
+		{name: "blank", search: lo.ToPtr("  \t"), want: nil},
+		{name: "trimmed", search: lo.ToPtr(" отчет -черновик "), want: lo.ToPtr("отчет -черновик")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SearchText(tt.search); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SearchText() = %v, want %v", lo.FromPtr(got), lo.FromPtr(tt.want))
+			}
+		})
+	}
+}
+
+func TestParseTaskCursor(t *testing.T) {
+	token := TaskCursor{Order: "created_at", By: "desc", UUID: uuid.New()}.Encode()
+
+	tests := []struct {
+		name    string
+		token   string
+		search  bool
+		order   string
+		by      string
+		wantErr error
+	}{
+		{name: "same sort", token: token, order: "created_at", by: "desc"},
+		{name: "full-text search", token: token, search: true, order: "created_at", by: "desc", wantErr: ErrCursorSearch},
+		{name: "other order", token: token, order: "finish_to", by: "desc", wantErr: ErrCursorOrder},
+		{name: "other direction", token: token, order: "created_at", by: "asc", wantErr: ErrCursorOrder},
+		{name: "broken token", token: "!!!", order: "created_at", by: "desc", wantErr: ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseTaskCursor(tt.token, tt.search, tt.order, tt.by); err != tt.wantErr {
+				t.Errorf("ParseTaskCursor() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
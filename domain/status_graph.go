@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Status graph issues found by Analyze.
+const (
+	GraphIssueUnknownStatus = "unknown_status"
+	GraphIssueUnreachable   = "unreachable"
+	GraphIssueDeadEnd       = "dead_end"
+)
+
+// graphAny - the route to any status.
+const graphAny = "*"
+
+type StatusGraphIssue struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// StatusGraphError - the graph can not be saved, one message per issue.
+type StatusGraphError struct {
+	Issues []StatusGraphIssue
+}
+
+func (e StatusGraphError) Error() string {
+	return strings.Join(e.Messages(), "; ")
+}
+
+func (e StatusGraphError) Messages() []string {
+	return lo.Map(e.Issues, func(i StatusGraphIssue, _ int) string {
+		return i.Message
+	})
+}
+
+// DefaultStatusGraph - routes of the project without its own graph.
+func DefaultStatusGraph() *StatusGraph {
+	sg := NewStatusGraph("0")
+	sg.Graph["0"] = []string{"1"}
+	sg.Graph["1"] = []string{"2"}
+	sg.Graph["2"] = []string{"3", "4", "6"}
+	sg.Graph["3"] = []string{"2"}
+	sg.Graph["4"] = []string{"5", "2"}
+	sg.Graph["5"] = []string{"2"}
+	sg.Graph["6"] = []string{"2"}
+
+	return sg
+}
+
+// Analyze checks the graph against project statuses: statuses which are not in the project,
+// statuses which can not be reached from "0" and statuses without a path to Done or Cancel.
+func (s *StatusGraph) Analyze(statuses []int) []StatusGraphIssue {
+	issues := []StatusGraphIssue{}
+	known := lo.SliceToMap(statuses, func(n int) (string, bool) {
+		return strconv.Itoa(n), true
+	})
+
+	for _, node := range s.nodes() {
+		if node != graphAny && !known[node] {
+			issues = append(issues, StatusGraphIssue{
+				Type:    GraphIssueUnknownStatus,
+				Status:  node,
+				Message: fmt.Sprintf("статус %s не найден в проекте", node),
+			})
+		}
+	}
+
+	root := s.reachable(strconv.Itoa(StatusUnknown))
+
+	numbers := lo.Uniq(statuses)
+	sort.Ints(numbers)
+
+	for _, n := range numbers {
+		node := strconv.Itoa(n)
+		if n != StatusUnknown && !root[node] && !root[graphAny] {
+			issues = append(issues, StatusGraphIssue{
+				Type:    GraphIssueUnreachable,
+				Status:  node,
+				Message: fmt.Sprintf("статус %s недостижим из статуса %d", node, StatusUnknown),
+			})
+		}
+	}
+
+	done, cancel := strconv.Itoa(StatusDone), strconv.Itoa(StatusCancel)
+
+	for _, node := range s.nodes() {
+		if node == graphAny || node == done || node == cancel || !known[node] {
+			continue
+		}
+
+		r := s.reachable(node)
+		if !r[done] && !r[cancel] && !r[graphAny] {
+			issues = append(issues, StatusGraphIssue{
+				Type:    GraphIssueDeadEnd,
+				Status:  node,
+				Message: fmt.Sprintf("из статуса %s нет пути в статусы %s и %s", node, done, cancel),
+			})
+		}
+	}
+
+	return issues
+}
+
+// DOT renders the graph in Graphviz format, names are labels of statuses by number.
+func (s *StatusGraph) DOT(names map[int]string) string {
+	b := strings.Builder{}
+	b.WriteString("digraph status_graph {\n")
+	b.WriteString("  rankdir=LR;\n")
+
+	for _, node := range s.nodes() {
+		label := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(nodeLabel(node, names))
+		fmt.Fprintf(&b, "  %q [label=\"%s\"];\n", node, label)
+	}
+
+	for _, node := range s.nodes() {
+		for _, child := range s.Graph[node] {
+			fmt.Fprintf(&b, "  %q -> %q;\n", node, child)
+		}
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// Mermaid renders the graph as Mermaid flowchart, names are labels of statuses by number.
+func (s *StatusGraph) Mermaid(names map[int]string) string {
+	b := strings.Builder{}
+	b.WriteString("flowchart LR\n")
+
+	for _, node := range s.nodes() {
+		label := strings.NewReplacer(`"`, "#quot;").Replace(nodeLabel(node, names))
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", mermaidID(node), label)
+	}
+
+	for _, node := range s.nodes() {
+		for _, child := range s.Graph[node] {
+			fmt.Fprintf(&b, "  %s --> %s\n", mermaidID(node), mermaidID(child))
+		}
+	}
+
+	return b.String()
+}
+
+// nodes returns statuses of the graph sorted by number, "*" is the last.
+func (s *StatusGraph) nodes() []string {
+	nodes := []string{}
+
+	for node, childs := range s.Graph {
+		nodes = append(nodes, node)
+		nodes = append(nodes, childs...)
+	}
+
+	nodes = lo.Uniq(nodes)
+	sort.Slice(nodes, func(i, j int) bool {
+		return compareNodes(nodes[i], nodes[j])
+	})
+
+	return nodes
+}
+
+func (s *StatusGraph) reachable(from string) map[string]bool {
+	seen := map[string]bool{from: true}
+	queue := []string{from}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, child := range s.Graph[node] {
+			if !seen[child] {
+				seen[child] = true
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	return seen
+}
+
+func compareNodes(a, b string) bool {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+
+	if errA != nil || errB != nil {
+		return errA == nil || (errB != nil && a < b)
+	}
+
+	return na < nb
+}
+
+func nodeLabel(node string, names map[int]string) string {
+	if node == graphAny {
+		return "Любой статус"
+	}
+
+	n, err := strconv.Atoi(node)
+	if err != nil || names[n] == "" {
+		return node
+	}
+
+	return names[n]
+}
+
+func mermaidID(node string) string {
+	if node == graphAny {
+		return "any"
+	}
+
+	return "s" + strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return r
+		}
+
+		return '_'
+	}, node)
+}
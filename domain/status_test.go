@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -51,3 +52,53 @@ func TestNewStatusGraph(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusGraphAnalyze(t *testing.T) {
+	statuses := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	tests := []struct {
+		name string
+		json string
+		want []string
+	}{
+		{name: "default", json: `{"0":["1"],"1":["2"],"2":["3","4","6"],"3":["2"],"4":["5","2"],"5":["2"],"6":["2"],"7":["*"]}`, want: []string{"unreachable:7"}},
+		{name: "any status", json: `{"0":["1"],"1":["*"]}`, want: []string{}},
+		{name: "unknown", json: `{"0":["1","9"],"1":["*"]}`, want: []string{"unknown_status:9"}},
+		{name: "dead end", json: `{"0":["1","2","3","4","5","6","7"],"1":["2"],"2":["1"],"3":["5"],"4":["6"],"7":["6"]}`, want: []string{"dead_end:1", "dead_end:2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph, err := NewStatusGraphFromJSON(tt.json)
+			if err != nil {
+				t.Fatalf("NewStatusGraphFromJSON() error = %v", err)
+			}
+
+			got := []string{}
+			for _, issue := range graph.Analyze(statuses) {
+				got = append(got, issue.Type+":"+issue.Status)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Analyze() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusGraphRender(t *testing.T) {
+	graph, _ := NewStatusGraphFromJSON(`{"0":["1"],"1":["*"]}`)
+	names := map[int]string{0: "Необработана", 1: `Новая "срочная"`}
+
+	dot := "digraph status_graph {\n  rankdir=LR;\n" +
+		"  \"0\" [label=\"Необработана\"];\n  \"1\" [label=\"Новая \\\"срочная\\\"\"];\n  \"*\" [label=\"Любой статус\"];\n" +
+		"  \"0\" -> \"1\";\n  \"1\" -> \"*\";\n}\n"
+	if got := graph.DOT(names); got != dot {
+		t.Errorf("DOT() = %q, want %q", got, dot)
+	}
+
+	mermaid := "flowchart LR\n  s0[\"Необработана\"]\n  s1[\"Новая #quot;срочная#quot;\"]\n  any[\"Любой статус\"]\n  s0 --> s1\n  s1 --> any\n"
+	if got := graph.Mermaid(names); got != mermaid {
+		t.Errorf("Mermaid() = %q, want %q", got, mermaid)
+	}
+}
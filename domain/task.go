@@ -80,6 +80,8 @@ type Task struct {
 
 	Stops []Stop
 
+	// StartAt - planned start, optional, see CheckDates
+	StartAt    *time.Time
 	FinishTo   *time.Time
 	FinishedAt *time.Time
 
@@ -88,9 +90,27 @@ type Task struct {
 	ChildrensTotal int
 	ChildrensUUID  []uuid.UUID
 
+	// Duration - seconds logged on the task and its children
+	Duration int
+
+	// Estimate - points or hours by the project unit, nil when not estimated
+	Estimate *float64
+	// EstimateTotal - estimate of the task and its children
+	EstimateTotal float64
+
+	// BoardRank - card position in the board column, see RankBetween
+	BoardRank string
+
+	ChecklistTotal int
+	ChecklistDone  int
+
 	Activities      []Activity
 	ActivitiesTotal int64
 
+	Links []TaskLink
+
+	Search *TaskSearchHit
+
 	Dirty map[string]interface{}
 }
 
@@ -107,6 +127,14 @@ type Activity struct {
 	Type int
 }
 
+// TaskSearchHit - full-text search rank and highlighted fragments.
+type TaskSearchHit struct {
+	Rank        float64
+	Name        string
+	Description string
+	Comment     string
+}
+
 type Stop struct {
 	UUID          uuid.UUID `json:"uuid"`
 	CreatedAt     time.Time `json:"created_at"`
@@ -231,16 +259,7 @@ func (t *Task) PatchStatus(status int, opt ProjectOptions, comment string, sg *S
 	path := []string{}
 
 	if sg == nil || len(sg.Graph) == 0 {
-		sg = NewStatusGraph("0")
-		sg.Graph = make(map[string][]string)
-		sg.Graph["0"] = []string{"1"}
-		sg.Graph["1"] = []string{"2"}
-		sg.Graph["2"] = []string{"3", "4", "6"}
-		sg.Graph["3"] = []string{"2"}
-		sg.Graph["4"] = []string{"5", "2"}
-		sg.Graph["5"] = []string{"2"}
-		sg.Graph["6"] = []string{"2"}
-
+		sg = DefaultStatusGraph()
 	}
 
 	// @todo
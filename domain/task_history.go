@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// TaskState - attributes of the task tracked by activities, keys are activity names and values are in json form.
+type TaskState map[string]interface{}
+
+// TaskStateChange - the attribute changed between two states, custom fields are compared as "fields.<hash>".
+type TaskStateChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+var taskTeamRoles = []string{"implement_by", "responsible_by", "managed_by"}
+
+// NewTaskState takes tracked attributes of the task.
+func NewTaskState(t Task) (TaskState, error) {
+	mp := map[string]interface{}{
+		"name":           t.Name,
+		"description":    t.Description,
+		"status":         t.Status,
+		"priority":       t.Priority,
+		"tags":           lo.Ternary(t.Tags == nil, []string{}, t.Tags),
+		"icon":           t.Icon,
+		"start_at":       t.StartAt,
+		"finish_to":      t.FinishTo,
+		"estimate":       t.Estimate,
+		"fields":         lo.Ternary(t.Fields == nil, map[string]interface{}{}, t.Fields),
+		"project_uuid":   t.ProjectUUID,
+		"implement_by":   t.ImplementBy,
+		"responsible_by": t.ResponsibleBy,
+		"managed_by":     t.ManagedBy,
+		"co_workers_by":  lo.Ternary(t.CoWorkersBy == nil, []string{}, t.CoWorkersBy),
+		"watch_by":       lo.Ternary(t.WatchBy == nil, []string{}, t.WatchBy),
+	}
+
+	data, err := json.Marshal(mp)
+	if err != nil {
+		return nil, err
+	}
+
+	state := TaskState{}
+	err = json.Unmarshal(data, &state)
+
+	return state, err
+}
+
+// Revert rolls the state back to the moment before the activity. Activities of other types are skipped.
+func (s TaskState) Revert(a Activity) {
+	name, _ := a.Meta["name"].(string)
+
+	switch ActivityType(a.Type) {
+	case ActivityTaskField, ActivityTaskFieldArray:
+		if _, ok := s[name]; !ok {
+			return
+		}
+
+		s[name] = a.Meta["old"]
+	case ActivityTaskStatus:
+		s["status"] = a.Meta["old"]
+	case ActivityTaskTeamArray:
+		if _, ok := s[name]; !ok {
+			return
+		}
+
+		added, removed := activityEmails(a.Meta["add"]), activityEmails(a.Meta["remove"])
+
+		if lo.Contains(taskTeamRoles, name) {
+			s[name] = ""
+			if len(removed) > 0 {
+				s[name] = removed[0]
+			}
+
+			return
+		}
+
+		current, _ := s[name].([]interface{})
+		emails := lo.Filter(current, func(v interface{}, _ int) bool {
+			return !lo.Contains(added, v)
+		})
+
+		for _, email := range removed {
+			if !lo.Contains(emails, email) {
+				emails = append(emails, email)
+			}
+		}
+
+		s[name] = emails
+	}
+}
+
+// TaskStateAt rebuilds the state at the moment by reverting activities made after it, activities are sorted from the newest.
+func TaskStateAt(current TaskState, activities []Activity, at time.Time) TaskState {
+	state := make(TaskState, len(current))
+	for k, v := range current {
+		state[k] = v
+	}
+
+	for _, a := range activities {
+		if !a.CreatedAt.After(at) {
+			break
+		}
+
+		state.Revert(a)
+	}
+
+	return state
+}
+
+// DiffTaskStates returns changed attributes sorted by name, custom fields are compared one by one.
+func DiffTaskStates(from, to TaskState) []TaskStateChange {
+	changes := []TaskStateChange{}
+
+	for _, k := range lo.Uniq(append(lo.Keys(from), lo.Keys(to)...)) {
+		if k == "fields" {
+			continue
+		}
+
+		if !reflect.DeepEqual(from[k], to[k]) {
+			changes = append(changes, TaskStateChange{Field: k, Old: from[k], New: to[k]})
+		}
+	}
+
+	fromFields, _ := from["fields"].(map[string]interface{})
+	toFields, _ := to["fields"].(map[string]interface{})
+
+	for _, k := range lo.Uniq(append(lo.Keys(fromFields), lo.Keys(toFields)...)) {
+		if !reflect.DeepEqual(fromFields[k], toFields[k]) {
+			changes = append(changes, TaskStateChange{Field: "fields." + k, Old: fromFields[k], New: toFields[k]})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return changes
+}
+
+// activityEmails takes emails of users stored in team activities.
+func activityEmails(v interface{}) []interface{} {
+	users, _ := v.([]interface{})
+	emails := []interface{}{}
+
+	for _, u := range users {
+		if user, ok := u.(map[string]interface{}); ok {
+			if email, ok := user["email"].(string); ok && email != "" {
+				emails = append(emails, email)
+			}
+		}
+	}
+
+	return emails
+}
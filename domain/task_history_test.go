@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTaskStateAt(t *testing.T) {
+	now := time.Now()
+
+	current, err := NewTaskState(Task{
+		Name:        "Новое",
+		Status:      StatusDone,
+		ImplementBy: "b@b.ru",
+		CoWorkersBy: []string{"c@c.ru"},
+		Fields:      map[string]interface{}{"sum": 150},
+	})
+	if err != nil {
+		t.Fatalf("NewTaskState() error = %v", err)
+	}
+
+	activities := []Activity{
+		{Type: int(ActivityTaskField), CreatedAt: now.Add(-1 * time.Hour), Meta: map[string]interface{}{"name": "name", "old": "Старое", "new": "Новое"}},
+		{Type: int(ActivityTaskStatus), CreatedAt: now.Add(-2 * time.Hour), Meta: map[string]interface{}{"old": float64(StatusInWork), "new": float64(StatusDone)}},
+		{Type: int(ActivityTaskTeamArray), CreatedAt: now.Add(-3 * time.Hour), Meta: map[string]interface{}{
+			"name":   "co_workers_by",
+			"add":    []interface{}{map[string]interface{}{"email": "c@c.ru"}},
+			"remove": []interface{}{map[string]interface{}{"email": "d@d.ru"}},
+		}},
+		{Type: int(ActivityTaskTeamArray), CreatedAt: now.Add(-4 * time.Hour), Meta: map[string]interface{}{
+			"name": "implement_by",
+			"add":  []interface{}{map[string]interface{}{"email": "b@b.ru"}},
+		}},
+		{Type: int(ActivityTaskField), CreatedAt: now.Add(-5 * time.Hour), Meta: map[string]interface{}{"name": "fields", "old": map[string]interface{}{"sum": float64(100)}, "new": map[string]interface{}{"sum": float64(150)}}},
+	}
+
+	tests := []struct {
+		name  string
+		at    time.Time
+		field string
+		want  interface{}
+	}{
+		{name: "current", at: now, field: "name", want: "Новое"},
+		{name: "field", at: now.Add(-90 * time.Minute), field: "name", want: "Старое"},
+		{name: "status", at: now.Add(-150 * time.Minute), field: "status", want: float64(StatusInWork)},
+		{name: "team array", at: now.Add(-210 * time.Minute), field: "co_workers_by", want: []interface{}{"d@d.ru"}},
+		{name: "team single", at: now.Add(-270 * time.Minute), field: "implement_by", want: ""},
+		{name: "custom fields", at: now.Add(-6 * time.Hour), field: "fields", want: map[string]interface{}{"sum": float64(100)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TaskStateAt(current, activities, tt.at)[tt.field]
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TaskStateAt()[%s] = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+
+	changes := DiffTaskStates(TaskStateAt(current, activities, now.Add(-6*time.Hour)), current)
+	fields := []string{}
+	for _, c := range changes {
+		fields = append(fields, c.Field)
+	}
+
+	want := []string{"co_workers_by", "fields.sum", "implement_by", "name", "status"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("DiffTaskStates() = %v, want %v", fields, want)
+	}
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "github.com/samber/lo"
+
+// TeamPeople returns all_people of the task for the team with the changes applied,
+// nil keeps the current value of the role.
+func (t *Task) TeamPeople(implementBy, responsibleBy, managedBy *string, coworkersBy, watchedBy *[]string) []string {
+	implement, responsible, managed := t.ImplementBy, t.ResponsibleBy, t.ManagedBy
+	coworkers, watchers := t.CoWorkersBy, t.WatchBy
+
+	if implementBy != nil {
+		implement = *implementBy
+	}
+	if responsibleBy != nil {
+		responsible = *responsibleBy
+	}
+	if managedBy != nil {
+		managed = *managedBy
+	}
+	if coworkersBy != nil {
+		coworkers = *coworkersBy
+	}
+	if watchedBy != nil {
+		watchers = *watchedBy
+	}
+
+	people := append([]string{}, t.CreatedBy, implement, responsible, managed)
+	people = append(people, coworkers...)
+	people = append(people, watchers...)
+
+	return lo.WithoutEmpty(lo.Uniq(people))
+}
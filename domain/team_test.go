@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTeamPeople(t *testing.T) {
+	task := Task{
+		CreatedBy:     "a@a.ru",
+		ImplementBy:   "b@a.ru",
+		ResponsibleBy: "c@a.ru",
+		ManagedBy:     "a@a.ru",
+		CoWorkersBy:   []string{"d@a.ru"},
+		WatchBy:       []string{"e@a.ru"},
+	}
+
+	str := func(s string) *string { return &s }
+	list := func(s ...string) *[]string { return &s }
+
+	tests := []struct {
+		name        string
+		implement   *string
+		responsible *string
+		managed     *string
+		coworkers   *[]string
+		watchers    *[]string
+		want        []string
+	}{
+		{
+			name: "no changes",
+			want: []string{"a@a.ru", "b@a.ru", "c@a.ru", "d@a.ru", "e@a.ru"},
+		},
+		{
+			name:      "one role keeps the rest",
+			implement: str("f@a.ru"),
+			want:      []string{"a@a.ru", "f@a.ru", "c@a.ru", "d@a.ru", "e@a.ru"},
+		},
+		{
+			name:        "duplicates and empty",
+			responsible: str(""),
+			coworkers:   list("b@a.ru", "b@a.ru", ""),
+			watchers:    list(),
+			want:        []string{"a@a.ru", "b@a.ru"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := task.TeamPeople(tt.implement, tt.responsible, tt.managed, tt.coworkers, tt.watchers)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TeamPeople() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MaxTimelineTasks - max tasks shown on the timeline and shifted with the subtree.
+const MaxTimelineTasks = 1000
+
+var ErrTaskFinishBeforeStart = errors.New("срок задачи не может быть раньше даты начала")
+
+// Timeline - tasks of the project or epic with dependencies between them.
+type Timeline struct {
+	Tasks []Task
+	Links []TaskLink
+}
+
+// NewTimeline keeps blocks links with both sides on the timeline.
+func NewTimeline(tasks []Task, links []TaskLink) Timeline {
+	uuids := lo.SliceToMap(tasks, func(t Task) (uuid.UUID, bool) {
+		return t.UUID, true
+	})
+
+	return Timeline{
+		Tasks: tasks,
+		Links: lo.Filter(links, func(l TaskLink, _ int) bool {
+			return l.Type == TaskLinkBlocks && uuids[l.FromUUID] && uuids[l.ToUUID]
+		}),
+	}
+}
+
+// CheckDates - the deadline can not be before the start.
+func (t Task) CheckDates() error {
+	if t.StartAt != nil && t.FinishTo != nil && t.FinishTo.Before(*t.StartAt) {
+		return ErrTaskFinishBeforeStart
+	}
+
+	return nil
+}
+
+// ParentUUID returns the parent from the path, nil for root tasks.
+func (t Task) ParentUUID() *uuid.UUID {
+	if len(t.Path) < 2 {
+		return nil
+	}
+
+	uid, err := uuid.Parse(t.Path[len(t.Path)-2])
+	if err != nil {
+		return nil
+	}
+
+	return &uid
+}
+
+// Shift moves the start and the deadline of the task, returns false if the task has no dates.
+func (t *Task) Shift(d time.Duration) bool {
+	if t.StartAt == nil && t.FinishTo == nil {
+		return false
+	}
+
+	if t.StartAt != nil {
+		t.StartAt = lo.ToPtr(t.StartAt.Add(d))
+	}
+
+	if t.FinishTo != nil {
+		t.FinishTo = lo.ToPtr(t.FinishTo.Add(d))
+	}
+
+	return true
+}
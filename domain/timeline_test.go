@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func TestTaskDates(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		task    Task
+		shift   time.Duration
+		shifted bool
+		wantErr error
+	}{
+		{name: "no dates", task: Task{}, shift: time.Hour},
+		{name: "finish only", task: Task{FinishTo: lo.ToPtr(now)}, shift: -time.Hour, shifted: true},
+		{name: "start and finish", task: Task{StartAt: lo.ToPtr(now), FinishTo: lo.ToPtr(now.Add(time.Hour))}, shift: 24 * time.Hour, shifted: true},
+		{name: "finish before start", task: Task{StartAt: lo.ToPtr(now), FinishTo: lo.ToPtr(now.Add(-time.Hour))}, shift: time.Hour, shifted: true, wantErr: ErrTaskFinishBeforeStart},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.task.CheckDates(); err != tt.wantErr {
+				t.Fatalf("CheckDates() error = %v, want %v", err, tt.wantErr)
+			}
+
+			before := tt.task
+			task := tt.task
+
+			if got := task.Shift(tt.shift); got != tt.shifted {
+				t.Fatalf("Shift() = %v, want %v", got, tt.shifted)
+			}
+
+			if before.StartAt != nil && !task.StartAt.Equal(before.StartAt.Add(tt.shift)) {
+				t.Errorf("StartAt = %v, want %v", task.StartAt, before.StartAt.Add(tt.shift))
+			}
+
+			if before.FinishTo != nil && !task.FinishTo.Equal(before.FinishTo.Add(tt.shift)) {
+				t.Errorf("FinishTo = %v, want %v", task.FinishTo, before.FinishTo.Add(tt.shift))
+			}
+		})
+	}
+}
+
+func TestNewTimeline(t *testing.T) {
+	epic, child, other := uuid.New(), uuid.New(), uuid.New()
+
+	tasks := []Task{
+		{UUID: epic, Path: []string{epic.String()}},
+		{UUID: child, Path: []string{epic.String(), child.String()}},
+	}
+
+	timeline := NewTimeline(tasks, []TaskLink{
+		{FromUUID: epic, ToUUID: child, Type: TaskLinkBlocks},
+		{FromUUID: epic, ToUUID: child, Type: TaskLinkRelatesTo},
+		{FromUUID: child, ToUUID: other, Type: TaskLinkBlocks},
+	})
+
+	if len(timeline.Links) != 1 || timeline.Links[0].ToUUID != child {
+		t.Errorf("Links = %v, want one blocks link to the child", timeline.Links)
+	}
+
+	if tasks[0].ParentUUID() != nil {
+		t.Errorf("ParentUUID() of the root = %v, want nil", tasks[0].ParentUUID())
+	}
+
+	if p := tasks[1].ParentUUID(); p == nil || *p != epic {
+		t.Errorf("ParentUUID() = %v, want %v", p, epic)
+	}
+}
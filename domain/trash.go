@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TrashTask    = "task"
+	TrashComment = "comment"
+	TrashFile    = "file"
+)
+
+var ErrTrashInvalidType = errors.New("неизвестный тип элемента корзины")
+
+func GetTrashTypes() []string {
+	return []string{TrashTask, TrashComment, TrashFile}
+}
+
+// TrashItem - deleted task, comment or file which can be restored till it is purged.
+// Subtasks, comments and files deleted with the task are not listed, they are restored with it.
+type TrashItem struct {
+	Type      string
+	UUID      uuid.UUID
+	Name      string
+	TaskUUID  uuid.UUID
+	DeletedAt time.Time
+}
+
+// PurgeAt - time after which the item is removed permanently.
+func (t TrashItem) PurgeAt(retentionDays int) time.Time {
+	return t.DeletedAt.AddDate(0, 0, retentionDays)
+}
+
+// TrashPurgeBefore - items deleted before the time are removed permanently.
+func TrashPurgeBefore(now time.Time, retentionDays int) time.Time {
+	return now.AddDate(0, 0, -retentionDays)
+}
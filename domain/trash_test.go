@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTrashItemPurgeAt(t *testing.T) {
+	deletedAt := time.Date(2026, 1, 30, 10, 0, 0, 0, time.UTC)
+	item := TrashItem{DeletedAt: deletedAt}
+
+	tests := []struct {
+		name string
+		days int
+		want time.Time
+	}{
+		{"month", 30, time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{"week", 7, time.Date(2026, 2, 6, 10, 0, 0, 0, time.UTC)},
+		{"zero", 0, deletedAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := item.PurgeAt(tt.days)
+			if !got.Equal(tt.want) {
+				t.Errorf("PurgeAt() = %v, want %v", got, tt.want)
+			}
+
+			if !TrashPurgeBefore(got, tt.days).Equal(deletedAt) {
+				t.Errorf("TrashPurgeBefore() = %v, want %v", TrashPurgeBefore(got, tt.days), deletedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+var ErrTaskViewForbidden = errors.New("нет доступа к представлению")
+
+// TaskViewFilter - saved part of the task search, same meaning as in the task search.
+type TaskViewFilter struct {
+	Status       *int              `json:"status,omitempty"`
+	IsMy         *bool             `json:"is_my,omitempty"`
+	IsEpic       *bool             `json:"is_epic,omitempty"`
+	Participated []string          `json:"participated,omitempty"`
+	Tags         []string          `json:"tags,omitempty"`
+	Path         *string           `json:"path,omitempty"`
+	Name         *string           `json:"name,omitempty"`
+	Search       *string           `json:"search,omitempty"`
+	Fields       map[string]string `json:"fields,omitempty"`
+	FieldFilter  *FieldFilter      `json:"field_filter,omitempty"`
+}
+
+// TaskView - named task search stored by user, shared view is visible for the whole project.
+type TaskView struct {
+	UUID           uuid.UUID
+	FederationUUID uuid.UUID
+	ProjectUUID    uuid.UUID
+	CreatedByUUID  uuid.UUID
+
+	Name     string `validate:"lte=100,gte=1"  ru:"название"`
+	IsShared bool
+
+	Filter  TaskViewFilter
+	Order   *string
+	By      *string `validate:"omitempty,oneof=asc desc"  ru:"направление сортировки"`
+	Columns []string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewTaskView(federationUUID, projectUUID, createdByUUID uuid.UUID, name string, isShared bool, filter TaskViewFilter, order, by *string, columns []string) (v TaskView, err error) {
+	v = TaskView{
+		UUID:           uuid.New(),
+		FederationUUID: federationUUID,
+		ProjectUUID:    projectUUID,
+		CreatedByUUID:  createdByUUID,
+		Name:           name,
+		IsShared:       isShared,
+		Filter:         filter,
+		Order:          order,
+		By:             by,
+		Columns:        columns,
+		CreatedAt:      time.Now(),
+	}
+
+	return v, v.Validate()
+}
+
+func (v *TaskView) Validate() error {
+	errs, ok := helpers.ValidationStruct(v)
+	if !ok {
+		return errors.New(helpers.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+func (v *TaskView) CanRead(userUUID uuid.UUID) bool {
+	return v.IsShared || v.CreatedByUUID == userUUID
+}
+
+func (v *TaskView) CanEdit(userUUID uuid.UUID) bool {
+	return v.CreatedByUUID == userUUID
+}
+
+// ColumnsOrDefault returns view columns, project fields order is used when view has no columns.
+func (v *TaskView) ColumnsOrDefault(fieldsSort []string) []string {
+	if len(v.Columns) > 0 {
+		return v.Columns
+	}
+
+	return fieldsSort
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"sort"
+
+	"github.com/samber/lo"
+)
+
+// Workload - open tasks of the user by roles, a task with several roles of the user is counted once in Total.
+type Workload struct {
+	Email string
+
+	Implementer int
+	Responsible int
+	CoWorker    int
+	Manager     int
+
+	Total int
+	// Overdue - open tasks with FinishTo in the past
+	Overdue int
+	// Priority - summed priority of open tasks
+	Priority int
+}
+
+// CompleteWorkload adds people without open tasks, so idle members of a group are in the report too.
+// Result is sorted by total tasks, then by email.
+func CompleteWorkload(items []Workload, emails []string) []Workload {
+	result := append([]Workload{}, items...)
+
+	seen := lo.SliceToMap(items, func(w Workload) (string, bool) {
+		return w.Email, true
+	})
+
+	for _, email := range lo.WithoutEmpty(lo.Uniq(emails)) {
+		if !seen[email] {
+			result = append(result, Workload{Email: email})
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Total != result[j].Total {
+			return result[i].Total > result[j].Total
+		}
+
+		return result[i].Email < result[j].Email
+	})
+
+	return result
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompleteWorkload(t *testing.T) {
+	items := []Workload{
+		{Email: "b@a.ru", Implementer: 1, Total: 1},
+		{Email: "c@a.ru", Implementer: 2, Total: 3, Overdue: 1, Priority: 5},
+	}
+
+	tests := []struct {
+		name   string
+		items  []Workload
+		emails []string
+		want   []Workload
+	}{
+		{
+			name:  "no filter",
+			items: items,
+			want: []Workload{
+				{Email: "c@a.ru", Implementer: 2, Total: 3, Overdue: 1, Priority: 5},
+				{Email: "b@a.ru", Implementer: 1, Total: 1},
+			},
+		},
+		{
+			name:   "idle members are added",
+			items:  items,
+			emails: []string{"d@a.ru", "b@a.ru", "a@a.ru", "a@a.ru", ""},
+			want: []Workload{
+				{Email: "c@a.ru", Implementer: 2, Total: 3, Overdue: 1, Priority: 5},
+				{Email: "b@a.ru", Implementer: 1, Total: 1},
+				{Email: "a@a.ru"},
+				{Email: "d@a.ru"},
+			},
+		},
+		{
+			name:   "nobody has tasks",
+			emails: []string{"a@a.ru"},
+			want:   []Workload{{Email: "a@a.ru"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CompleteWorkload(tt.items, tt.emails)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CompleteWorkload() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+var (
+	ErrWorklogInFuture     = errors.New("время начала работы не может быть в будущем")
+	ErrWorklogTimerStopped = errors.New("таймер уже остановлен")
+)
+
+// Worklog - time spent by user on a task. Running worklog is a timer, its duration is set on stop.
+type Worklog struct {
+	UUID           uuid.UUID
+	TaskUUID       uuid.UUID
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+	UserUUID       uuid.UUID
+
+	StartedAt time.Time
+	// Duration - seconds
+	Duration  int    `validate:"gte=0,lte=86400"  ru:"длительность"`
+	Comment   string `validate:"lte=1000"  ru:"комментарий"`
+	IsRunning bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewWorklog(task Task, userUUID uuid.UUID, startedAt time.Time, duration int, comment string) (wl Worklog, err error) {
+	wl = newWorklog(task, userUUID, startedAt, comment)
+	wl.Duration = duration
+
+	if startedAt.After(time.Now()) {
+		return wl, ErrWorklogInFuture
+	}
+
+	if duration <= 0 {
+		return wl, errors.New("длительность должна быть больше нуля")
+	}
+
+	return wl, wl.validate()
+}
+
+func NewTimer(task Task, userUUID uuid.UUID) (wl Worklog, err error) {
+	wl = newWorklog(task, userUUID, time.Now(), "")
+	wl.IsRunning = true
+
+	return wl, nil
+}
+
+// Stop finishes the timer, duration is at least one second.
+func (wl *Worklog) Stop(now time.Time, comment string) error {
+	if !wl.IsRunning {
+		return ErrWorklogTimerStopped
+	}
+
+	wl.IsRunning = false
+	wl.Duration = max(int(now.Sub(wl.StartedAt).Seconds()), 1)
+
+	if comment != "" {
+		wl.Comment = comment
+	}
+
+	return wl.validate()
+}
+
+// Elapsed returns seconds spent, for running timer - till now.
+func (wl *Worklog) Elapsed(now time.Time) int {
+	if wl.IsRunning {
+		return max(int(now.Sub(wl.StartedAt).Seconds()), 0)
+	}
+
+	return wl.Duration
+}
+
+func (wl *Worklog) validate() error {
+	errs, ok := helpers.ValidationStruct(wl)
+	if !ok {
+		return errors.New(helpers.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+func newWorklog(task Task, userUUID uuid.UUID, startedAt time.Time, comment string) Worklog {
+	return Worklog{
+		UUID:           uuid.New(),
+		TaskUUID:       task.UUID,
+		FederationUUID: task.FederationUUID,
+		CompanyUUID:    task.CompanyUUID,
+		ProjectUUID:    task.ProjectUUID,
+		UserUUID:       userUUID,
+		StartedAt:      startedAt,
+		Comment:        comment,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// WorklogReportItem - seconds spent by user in project.
+type WorklogReportItem struct {
+	UserUUID    uuid.UUID
+	ProjectUUID uuid.UUID
+	Duration    int64
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestWorklogTimer(t *testing.T) {
+	task := Task{UUID: uuid.New(), ProjectUUID: uuid.New()}
+
+	wl, err := NewTimer(task, uuid.New())
+	if err != nil {
+		t.Fatalf("NewTimer error: %v", err)
+	}
+
+	err = wl.Stop(wl.StartedAt.Add(90*time.Minute), "done")
+	if err != nil {
+		t.Fatalf("Stop error: %v", err)
+	}
+
+	if wl.IsRunning || wl.Duration != 5400 || wl.Comment != "done" {
+		t.Errorf("Stop = %v/%v/%v, want false/5400/done", wl.IsRunning, wl.Duration, wl.Comment)
+	}
+
+	if err := wl.Stop(time.Now(), ""); err != ErrWorklogTimerStopped {
+		t.Errorf("second Stop error = %v, want %v", err, ErrWorklogTimerStopped)
+	}
+}
+
+func TestNewWorklogInvalid(t *testing.T) {
+	task := Task{UUID: uuid.New()}
+
+	tests := []struct {
+		name      string
+		startedAt time.Time
+		duration  int
+	}{
+		{name: "future", startedAt: time.Now().Add(time.Hour), duration: 60},
+		{name: "zero duration", startedAt: time.Now(), duration: 0},
+		{name: "more than a day", startedAt: time.Now(), duration: 86401},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewWorklog(task, uuid.New(), tt.startedAt, tt.duration, ""); err == nil {
+				t.Errorf("NewWorklog(%v) expected error", tt.name)
+			}
+		})
+	}
+}
@@ -54,12 +54,40 @@ type ActivityTaskWasDeletedDTO struct {
 	Name string `json:"name"`
 }
 
+type ActivityTaskWasRestoredDTO struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
 type ActivityTaskFileWasDeletedDTO struct {
 	Name string `json:"name"`
 	Ext  string `json:"ext"`
 	Size int64  `json:"size"`
 }
 
+type ActivityTaskLinkDTO struct {
+	Type      string    `json:"type"`
+	Direction string    `json:"direction"`
+	TaskUUID  uuid.UUID `json:"task_uuid"`
+	TaskID    int       `json:"task_id"`
+	TaskName  string    `json:"task_name"`
+}
+
+type ActivityTaskMergeDTO struct {
+	Direction string    `json:"direction"`
+	TaskUUID  uuid.UUID `json:"task_uuid"`
+	TaskID    int       `json:"task_id"`
+	TaskName  string    `json:"task_name"`
+	Conflicts []string  `json:"conflicts"`
+}
+
+type ActivityTaskChecklistDTO struct {
+	Action   string     `json:"action"`
+	ItemUUID uuid.UUID  `json:"item_uuid"`
+	Text     string     `json:"text"`
+	TaskUUID *uuid.UUID `json:"task_uuid,omitempty"`
+}
+
 func NewActivityDTO(dm domain.Activity, user UserDTO) *ActivityDTO {
 	var status map[string]interface{}
 
@@ -135,6 +163,60 @@ func NewActivityDTO(dm domain.Activity, user UserDTO) *ActivityDTO {
 		}
 	}
 
+	if dm.Type == int(domain.ActivityTaskWasRestored) {
+		var p ActivityTaskWasRestoredDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
+	if dm.Type == int(domain.ActivityTaskWasMerged) {
+		var p ActivityTaskMergeDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
+	if dm.Type == int(domain.ActivityTaskChecklist) {
+		var p ActivityTaskChecklistDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
 	if dm.Type == int(domain.ActivityTaskFileWasDeleted) {
 		var p ActivityTaskFileWasDeletedDTO
 		metaBytes, err := json.Marshal(dm.Meta)
@@ -153,6 +235,24 @@ func NewActivityDTO(dm domain.Activity, user UserDTO) *ActivityDTO {
 		}
 	}
 
+	if dm.Type == int(domain.ActivityTaskLinkAdded) || dm.Type == int(domain.ActivityTaskLinkRemoved) {
+		var p ActivityTaskLinkDTO
+		metaBytes, err := json.Marshal(dm.Meta)
+		if err != nil {
+			logrus.Error("cannot marshal meta")
+		} else {
+			err = json.Unmarshal(metaBytes, &p)
+			if err != nil {
+				logrus.Error("cannot unmarshal meta")
+			} else {
+				status, err = helpers.StructToMap(&p)
+				if err != nil {
+					logrus.Error("cannot convert struct to map")
+				}
+			}
+		}
+	}
+
 	return &ActivityDTO{
 		UUID:      dm.UUID,
 		CreatedBy: user,
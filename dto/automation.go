@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type AutomationRuleDTO struct {
+	UUID        uuid.UUID                    `json:"uuid"`
+	ProjectUUID uuid.UUID                    `json:"project_uuid"`
+	Name        string                       `json:"name"`
+	Enabled     bool                         `json:"enabled"`
+	Trigger     domain.AutomationTrigger     `json:"trigger"`
+	Conditions  []domain.AutomationCondition `json:"conditions"`
+	Actions     []domain.AutomationAction    `json:"actions"`
+	CreatedBy   string                       `json:"created_by"`
+	CreatedAt   time.Time                    `json:"created_at"`
+	UpdatedAt   time.Time                    `json:"updated_at"`
+}
+
+type AutomationRunDTO struct {
+	UUID      uuid.UUID `json:"uuid"`
+	TaskUUID  uuid.UUID `json:"task_uuid"`
+	Trigger   string    `json:"trigger"`
+	Depth     int       `json:"depth"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error"`
+	Actions   int       `json:"actions"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewAutomationRuleDTO(dm domain.AutomationRule) AutomationRuleDTO {
+	conditions := dm.Conditions
+	if conditions == nil {
+		conditions = []domain.AutomationCondition{}
+	}
+
+	return AutomationRuleDTO{
+		UUID:        dm.UUID,
+		ProjectUUID: dm.ProjectUUID,
+		Name:        dm.Name,
+		Enabled:     dm.Enabled,
+		Trigger:     dm.Trigger,
+		Conditions:  conditions,
+		Actions:     dm.Actions,
+		CreatedBy:   dm.CreatedBy,
+		CreatedAt:   dm.CreatedAt,
+		UpdatedAt:   dm.UpdatedAt,
+	}
+}
+
+func NewAutomationRunDTO(dm domain.AutomationRun) AutomationRunDTO {
+	return AutomationRunDTO{
+		UUID:      dm.UUID,
+		TaskUUID:  dm.TaskUUID,
+		Trigger:   dm.Trigger,
+		Depth:     dm.Depth,
+		Status:    dm.Status,
+		Error:     dm.Error,
+		Actions:   dm.Actions,
+		CreatedAt: dm.CreatedAt,
+	}
+}
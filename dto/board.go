@@ -0,0 +1,8 @@
+package dto
+
+type BoardColumnDTO struct {
+	Status ProjectStatusDTOs `json:"status"`
+	Total  int64             `json:"total"`
+	Count  int               `json:"count"`
+	Items  []TaskDTOs        `json:"items"`
+}
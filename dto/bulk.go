@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// TaskBulkDTO - change applied by the bulk operation, only fields of the operation are used.
+type TaskBulkDTO struct {
+	Status  *int
+	Comment string
+
+	ImplementBy   *string
+	ResponsibleBy *string
+	ManagedBy     *string
+	CoworkersBy   *[]string
+	WatchedBy     *[]string
+
+	Tags []string
+
+	ProjectUUID *uuid.UUID
+}
+
+func (d *TaskBulkDTO) Validate(operation string) error {
+	switch operation {
+	case domain.BulkSetStatus:
+		if d.Status == nil {
+			return errors.New("status не может быть пустым")
+		}
+	case domain.BulkSetTeam:
+		if d.ImplementBy == nil && d.ResponsibleBy == nil && d.ManagedBy == nil && d.CoworkersBy == nil && d.WatchedBy == nil {
+			return errors.New("не указан состав команды")
+		}
+	case domain.BulkAddTags, domain.BulkRemoveTags:
+		if len(d.Tags) == 0 {
+			return errors.New("tags не может быть пустым")
+		}
+	case domain.BulkMoveProject:
+		if d.ProjectUUID == nil {
+			return errors.New("project_uuid не может быть пустым")
+		}
+
+		if d.Status == nil {
+			return errors.New("status не может быть пустым")
+		}
+	}
+
+	return nil
+}
+
+type BulkResultDTO struct {
+	TaskUUID uuid.UUID `json:"task_uuid"`
+	Ok       bool      `json:"ok"`
+	Error    string    `json:"error,omitempty"`
+}
+
+func NewBulkResultDTO(dm domain.BulkResult) BulkResultDTO {
+	return BulkResultDTO{
+		TaskUUID: dm.TaskUUID,
+		Ok:       dm.Ok(),
+		Error:    dm.Error,
+	}
+}
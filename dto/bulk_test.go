@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+func TestTaskBulkDTOValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation string
+		change    TaskBulkDTO
+		wantErr   bool
+	}{
+		{name: "status", operation: domain.BulkSetStatus, change: TaskBulkDTO{Status: lo.ToPtr(domain.StatusDone)}},
+		{name: "status is missing", operation: domain.BulkSetStatus, wantErr: true},
+		{name: "one role of the team", operation: domain.BulkSetTeam, change: TaskBulkDTO{WatchedBy: &[]string{}}},
+		{name: "team is missing", operation: domain.BulkSetTeam, change: TaskBulkDTO{Tags: []string{"a"}}, wantErr: true},
+		{name: "add tags", operation: domain.BulkAddTags, change: TaskBulkDTO{Tags: []string{"a"}}},
+		{name: "remove no tags", operation: domain.BulkRemoveTags, change: TaskBulkDTO{Tags: []string{}}, wantErr: true},
+		{
+			name:      "move with status",
+			operation: domain.BulkMoveProject,
+			change:    TaskBulkDTO{ProjectUUID: lo.ToPtr(uuid.New()), Status: lo.ToPtr(domain.StatusNew)},
+		},
+		{name: "move without project", operation: domain.BulkMoveProject, change: TaskBulkDTO{Status: lo.ToPtr(domain.StatusNew)}, wantErr: true},
+		{name: "move without status", operation: domain.BulkMoveProject, change: TaskBulkDTO{ProjectUUID: lo.ToPtr(uuid.New())}, wantErr: true},
+		{name: "delete needs nothing", operation: domain.BulkDelete},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.change.Validate(tt.operation); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type ChecklistItemDTO struct {
+	UUID       uuid.UUID  `json:"uuid"`
+	TaskUUID   uuid.UUID  `json:"task_uuid"`
+	Text       string     `json:"text"`
+	Done       bool       `json:"done"`
+	DoneAt     *time.Time `json:"done_at,omitempty"`
+	DoneBy     string     `json:"done_by"`
+	AssignedTo string     `json:"assigned_to"`
+	DueAt      *time.Time `json:"due_at,omitempty"`
+	CreatedBy  string     `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func NewChecklistItemDTO(dm domain.ChecklistItem) ChecklistItemDTO {
+	return ChecklistItemDTO{
+		UUID:       dm.UUID,
+		TaskUUID:   dm.TaskUUID,
+		Text:       dm.Text,
+		Done:       dm.Done,
+		DoneAt:     dm.DoneAt,
+		DoneBy:     dm.DoneBy,
+		AssignedTo: dm.AssignedTo,
+		DueAt:      dm.DueAt,
+		CreatedBy:  dm.CreatedBy,
+		CreatedAt:  dm.CreatedAt,
+	}
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+type BurndownPointDTO struct {
+	Date      string  `json:"date"`
+	Remaining float64 `json:"remaining"`
+	Ideal     float64 `json:"ideal"`
+}
+
+type BurnupPointDTO struct {
+	Date      string  `json:"date"`
+	Total     float64 `json:"total"`
+	Completed float64 `json:"completed"`
+}
+
+func NewBurndownPointDTO(dm domain.BurnPoint) BurndownPointDTO {
+	return BurndownPointDTO{
+		Date:      dm.Date.Format(time.DateOnly),
+		Remaining: dm.Remaining,
+		Ideal:     dm.Ideal,
+	}
+}
+
+func NewBurnupPointDTO(dm domain.BurnPoint) BurnupPointDTO {
+	return BurnupPointDTO{
+		Date:      dm.Date.Format(time.DateOnly),
+		Total:     dm.Total,
+		Completed: dm.Completed,
+	}
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type TaskExportDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	Format      string    `json:"format"`
+	Status      string    `json:"status"`
+	Count       int       `json:"count"`
+	Error       string    `json:"error,omitempty"`
+	URL         string    `json:"url,omitempty"`
+
+	CreatedAt  time.Time  `json:"created_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
+
+func NewTaskExportDTO(dm domain.TaskExport, url string) TaskExportDTO {
+	return TaskExportDTO{
+		UUID:        dm.UUID,
+		ProjectUUID: dm.ProjectUUID,
+		Format:      dm.Format,
+		Status:      dm.Status,
+		Count:       dm.Count,
+		Error:       dm.Error,
+		URL:         url,
+		CreatedAt:   dm.CreatedAt,
+		FinishedAt:  dm.FinishedAt,
+	}
+}
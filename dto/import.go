@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+const importPreviewRows = 5
+
+type ImportRowErrorDTO struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+type TaskImportDTO struct {
+	UUID        uuid.UUID      `json:"uuid"`
+	ProjectUUID uuid.UUID      `json:"project_uuid"`
+	FileName    string         `json:"file_name"`
+	Header      []string       `json:"header"`
+	Preview     [][]string     `json:"preview"`
+	Total       int            `json:"total"`
+	Mapping     map[int]string `json:"mapping"`
+
+	Status  string              `json:"status"`
+	Errors  []ImportRowErrorDTO `json:"errors"`
+	Created int                 `json:"created"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewTaskImportDTO(dm domain.TaskImport) TaskImportDTO {
+	return TaskImportDTO{
+		UUID:        dm.UUID,
+		ProjectUUID: dm.ProjectUUID,
+		FileName:    dm.FileName,
+		Header:      dm.Header,
+		Preview:     lo.Slice(dm.Rows, 0, importPreviewRows),
+		Total:       len(dm.Rows),
+		Mapping:     dm.Mapping,
+		Status:      dm.Status,
+		Errors: lo.Map(dm.Errors, func(item domain.ImportRowError, _ int) ImportRowErrorDTO {
+			return ImportRowErrorDTO{Row: item.Row, Error: item.Error}
+		}),
+		Created:   dm.Created,
+		CreatedAt: dm.CreatedAt,
+	}
+}
+
+// TaskImportProgressDTO - progress event of the running import.
+type TaskImportProgressDTO struct {
+	Status  string `json:"status"`
+	Total   int    `json:"total"`
+	Created int    `json:"created"`
+	Skipped int    `json:"skipped"`
+	Error   string `json:"error,omitempty"`
+}
@@ -1,5 +1,11 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type NotificationDTO struct {
 	UUID     string `json:"uuid"`
 	Type     string `json:"type"`
@@ -39,3 +45,48 @@ type NotificationReminderDTO struct {
 	Opened bool    `json:"opened"`
 	Group  int     `json:"group"`
 }
+
+// NotificationBulkStateDTO - tasks of the person changed by one bulk operation.
+type NotificationBulkStateDTO struct {
+	Operation string      `json:"operation"`
+	CreatedBy string      `json:"created_by"`
+	Tasks     []uuid.UUID `json:"tasks"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+type NotificationBulkDTO struct {
+	UUID string `json:"uuid"`
+	Type string `json:"type"`
+
+	Operation string                    `json:"operation"`
+	CreatedBy string                    `json:"created_by"`
+	Tasks     []NotificationBulkTaskDTO `json:"tasks"`
+	Count     int                       `json:"count"`
+
+	Score float64 `json:"score"`
+	Star  bool    `json:"star"`
+}
+
+type NotificationBulkTaskDTO struct {
+	UUID uuid.UUID `json:"uuid"`
+	Name string    `json:"name"`
+}
+
+// NotificationDeadlineStateDTO - the task is due soon or overdue.
+type NotificationDeadlineStateDTO struct {
+	Kind      string    `json:"kind"`
+	FinishTo  time.Time `json:"finish_to"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type NotificationDeadlineDTO struct {
+	UUID string `json:"uuid"`
+	Type string `json:"type"`
+	Name string `json:"type_name"`
+
+	Kind     string    `json:"kind"`
+	FinishTo time.Time `json:"finish_to"`
+
+	Score float64 `json:"score"`
+	Star  bool    `json:"star"`
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type ProjectDTO struct {
@@ -28,7 +29,8 @@ type ProjectDTO struct {
 
 	Users []ProjectUserDto `json:"users"`
 
-	AllowSort []string `json:"allow_sort"`
+	AllowSort  []string `json:"allow_sort"`
+	FieldsSort []string `json:"fields_sort,omitempty"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
@@ -63,6 +65,11 @@ type ProjectOptionsDTO struct {
 	RequireDoneComment        *bool   `json:"require_done_comment"`
 	StatusEnable              *bool   `json:"status_enable"`
 	Color                     *string `json:"color"`
+
+	Escalations *[]domain.EscalationLevel `json:"escalations,omitempty"`
+	FieldRules  *[]domain.FieldRule       `json:"field_rules,omitempty"`
+
+	EstimateUnit *string `json:"estimate_unit,omitempty"`
 }
 
 type ProjectDTOs struct {
@@ -99,6 +106,7 @@ type CompanyFieldDTO struct {
 	Hash        string    `json:"hash"`
 	DataType    int       `json:"data_type"`
 	DataDesc    string    `json:"data_desc"`
+	Formula     string    `json:"formula,omitempty"`
 
 	ProjectsUUID      []uuid.UUID `json:"project_uuids"`
 	TasksTotal        int         `json:"tasks_total"`
@@ -116,6 +124,7 @@ type ProjectFieldDTO struct {
 	DataDesc           string    `json:"data_desc"`
 	RequiredOnStatuses []int     `json:"required_on_statuses"`
 	Style              string    `json:"style"`
+	Formula            string    `json:"formula,omitempty"`
 
 	ProjectUUID uuid.UUID `json:"project_uuid"`
 }
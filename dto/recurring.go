@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type RecurringTaskDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	Schedule    string    `json:"schedule"`
+
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	Fields        map[string]interface{} `json:"fields"`
+	Tags          []string               `json:"tags"`
+	Priority      int                    `json:"priority"`
+	Path          []string               `json:"path"`
+	ImplementBy   string                 `json:"implement_by"`
+	ResponsibleBy string                 `json:"responsible_by"`
+	ManagedBy     string                 `json:"managed_by"`
+	CoWorkersBy   []string               `json:"coworkers_by"`
+	Icon          string                 `json:"icon"`
+	FinishIn      *int                   `json:"finish_in,omitempty"`
+
+	IsPaused  bool       `json:"is_paused"`
+	NextRunAt *time.Time `json:"next_run_at"`
+	LastRunAt *time.Time `json:"last_run_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	CreatedBy *UserDTO `json:"created_by,omitempty"`
+}
+
+func NewRecurringTaskDTO(dm domain.RecurringTask, dict IDict) RecurringTaskDTO {
+	bp := dm.Blueprint
+
+	createdBy, _ := dict.FindUserByUUID(dm.CreatedByUUID)
+
+	return RecurringTaskDTO{
+		UUID:        dm.UUID,
+		ProjectUUID: dm.ProjectUUID,
+		Schedule:    dm.Schedule,
+
+		Name:          bp.Name,
+		Description:   bp.Description,
+		Fields:        bp.Fields,
+		Tags:          bp.Tags,
+		Priority:      bp.Priority,
+		Path:          bp.Path,
+		ImplementBy:   bp.ImplementBy,
+		ResponsibleBy: bp.ResponsibleBy,
+		ManagedBy:     bp.ManagedBy,
+		CoWorkersBy:   bp.CoWorkersBy,
+		Icon:          bp.Icon,
+		FinishIn:      bp.FinishIn,
+
+		IsPaused:  dm.IsPaused,
+		NextRunAt: dm.NextRunAt,
+		LastRunAt: dm.LastRunAt,
+
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+
+		CreatedBy: createdBy,
+	}
+}
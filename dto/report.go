@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"math"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+// DurationStatsDTO - seconds.
+type DurationStatsDTO struct {
+	Count int   `json:"count"`
+	Sum   int64 `json:"sum"`
+	Avg   int64 `json:"avg"`
+	P50   int64 `json:"p50"`
+	P85   int64 `json:"p85"`
+	P95   int64 `json:"p95"`
+}
+
+type StatusTimeDTO struct {
+	Status int              `json:"status"`
+	Name   string           `json:"name"`
+	Time   DurationStatsDTO `json:"time"`
+}
+
+type CycleTimeGroupDTO struct {
+	Key      string           `json:"key"`
+	Tasks    int              `json:"tasks"`
+	Lead     DurationStatsDTO `json:"lead"`
+	Cycle    DurationStatsDTO `json:"cycle"`
+	Statuses []StatusTimeDTO  `json:"statuses"`
+}
+
+type CycleTimeReportDTO struct {
+	GroupBy string              `json:"group_by"`
+	Total   CycleTimeGroupDTO   `json:"total"`
+	Groups  []CycleTimeGroupDTO `json:"groups"`
+}
+
+func NewDurationStatsDTO(dm domain.DurationStats) DurationStatsDTO {
+	return DurationStatsDTO{
+		Count: dm.Count,
+		Sum:   int64(math.Round(dm.Sum)),
+		Avg:   int64(math.Round(dm.Avg)),
+		P50:   int64(math.Round(dm.P50)),
+		P85:   int64(math.Round(dm.P85)),
+		P95:   int64(math.Round(dm.P95)),
+	}
+}
+
+// NewCycleTimeReportDTO - names are project statuses by number.
+func NewCycleTimeReportDTO(dm domain.CycleTimeReport, names map[int]string) CycleTimeReportDTO {
+	group := func(g domain.CycleTimeGroup, _ int) CycleTimeGroupDTO {
+		return CycleTimeGroupDTO{
+			Key:   g.Key,
+			Tasks: g.Tasks,
+			Lead:  NewDurationStatsDTO(g.Lead),
+			Cycle: NewDurationStatsDTO(g.Cycle),
+			Statuses: lo.Map(g.Statuses, func(s domain.StatusTimeStats, _ int) StatusTimeDTO {
+				return StatusTimeDTO{
+					Status: s.Status,
+					Name:   names[s.Status],
+					Time:   NewDurationStatsDTO(s.Time),
+				}
+			}),
+		}
+	}
+
+	return CycleTimeReportDTO{
+		GroupBy: dm.GroupBy,
+		Total:   group(dm.Total, 0),
+		Groups:  lo.Map(dm.Groups, group),
+	}
+}
+
+type WorkloadDTO struct {
+	Email string   `json:"email"`
+	User  *UserDTO `json:"user,omitempty"`
+
+	Implementer int `json:"implementer"`
+	Responsible int `json:"responsible"`
+	CoWorker    int `json:"co_worker"`
+	Manager     int `json:"manager"`
+
+	Total    int `json:"total"`
+	Overdue  int `json:"overdue"`
+	Priority int `json:"priority"`
+}
+
+func NewWorkloadDTO(dm domain.Workload, user *UserDTO) WorkloadDTO {
+	return WorkloadDTO{
+		Email:       dm.Email,
+		User:        user,
+		Implementer: dm.Implementer,
+		Responsible: dm.Responsible,
+		CoWorker:    dm.CoWorker,
+		Manager:     dm.Manager,
+		Total:       dm.Total,
+		Overdue:     dm.Overdue,
+		Priority:    dm.Priority,
+	}
+}
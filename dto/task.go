@@ -61,9 +61,13 @@ type TaskDTO struct {
 	CreatedAt  time.Time  `json:"created_at"`
 	FinishedAt *time.Time `json:"finished_at"`
 	FinishedBy *UserDTO   `json:"finished_by,omitempty"`
+	StartAt    *time.Time `json:"start_at"`
 	FinishTo   *time.Time `json:"finish_to"`
 	Duration   int        `json:"duration"`
 
+	Estimate      *float64 `json:"estimate"`
+	EstimateTotal float64  `json:"estimate_total"`
+
 	UpdatedAt  time.Time  `json:"updated_at"`
 	ActivityAt time.Time  `json:"activity_at"`
 	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
@@ -90,6 +94,54 @@ type TaskDTO struct {
 	Views     int         `json:"views"`
 
 	Activities Pagination[ActivityDTO] `json:"activities"`
+
+	Links []TaskLinkDTO `json:"links"`
+}
+
+type TaskLinkDTO struct {
+	UUID      uuid.UUID    `json:"uuid"`
+	Type      string       `json:"type"`
+	Direction string       `json:"direction"`
+	Task      TaskLinkTask `json:"task"`
+	CreatedBy *UserDTO     `json:"created_by,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type TaskLinkTask struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Status      StatusDTO `json:"status"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+}
+
+func NewTaskLinkDTO(taskUUID uuid.UUID, dm domain.TaskLink, dict IDict) TaskLinkDTO {
+	d := TaskLinkDTO{
+		UUID:      dm.UUID,
+		Type:      dm.Type,
+		Direction: dm.Direction(taskUUID),
+		Task: TaskLinkTask{
+			UUID: dm.Other(taskUUID),
+		},
+		CreatedAt: dm.CreatedAt,
+	}
+
+	if dm.Task != nil {
+		d.Task.ID = dm.Task.ID
+		d.Task.Name = dm.Task.Name
+		d.Task.ProjectUUID = dm.Task.ProjectUUID
+		d.Task.Status = StatusDTO{
+			Code: dm.Task.Status,
+			Name: domain.GetTaskStatuses()[dm.Task.Status],
+		}
+	}
+
+	createdBy, f := dict.FindUser(dm.CreatedBy)
+	if f {
+		d.CreatedBy = createdBy
+	}
+
+	return d
 }
 
 type Pagination[T any] struct {
@@ -125,14 +177,32 @@ type TaskDTOs struct {
 	FinishedAt *time.Time `json:"finished_at,omitempty" xlsx:"G" ru:"Завершено"`
 	FinishedBy *UserDTO   `json:"finished_by,omitempty"`
 
+	StartAt  *time.Time `json:"start_at,omitempty"`
 	FinishTo *time.Time `json:"finish_to,omitempty"`
 	Duration int        `json:"duration"`
 
+	Estimate      *float64 `json:"estimate,omitempty"`
+	EstimateTotal float64  `json:"estimate_total"`
+
 	UpdatedAt  time.Time  `json:"updated_at" xlsx:"H" ru:"Обновлено"`
 	ActivityAt time.Time  `json:"activity_at" xlsx:"I" ru:"Активность"`
 	DeletedAt  *time.Time `json:"deleted_at,omitempty" xlsx:"J" ru:"Удалено"`
 
 	ChildrensTotal int `json:"childrens_total"  xlsx:"J" ru:"Потомков"`
+
+	BoardRank string `json:"board_rank,omitempty"`
+
+	// Checklist - progress of the checklist, "3/7"
+	Checklist string `json:"checklist,omitempty"`
+
+	Search *TaskSearchHitDTO `json:"search,omitempty"`
+}
+
+type TaskSearchHitDTO struct {
+	Rank        float64 `json:"rank"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Comment     string  `json:"comment,omitempty"`
 }
 
 type TaskFieldDTO struct {
@@ -322,12 +392,16 @@ func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File,
 
 		CommentsTotal:  dm.CommentsTotal,
 		ChildrensTotal: dm.ChildrensTotal,
+		Duration:       dm.Duration,
+		Estimate:       dm.Estimate,
+		EstimateTotal:  dm.EstimateTotal,
 		ChildrensUUID:  dm.ChildrensUUID,
 
 		LinkedFieldsData: linkedFieldsData,
 
 		Stops: dm.Stops,
 
+		StartAt:    dm.StartAt,
 		FinishTo:   dm.FinishTo,
 		FinishedAt: dm.FinishedAt,
 		FinishedBy: helpers.Empty(*finishedBy, fb),
@@ -348,6 +422,23 @@ func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File,
 			Total: dm.ActivitiesTotal,
 			Count: int64(len(dm.Activities)),
 		},
+
+		Links: lo.Map(dm.Links, func(link domain.TaskLink, _ int) TaskLinkDTO {
+			return NewTaskLinkDTO(dm.UUID, link, dict)
+		}),
+	}
+}
+
+func newTaskSearchHitDTO(dm *domain.TaskSearchHit) *TaskSearchHitDTO {
+	if dm == nil {
+		return nil
+	}
+
+	return &TaskSearchHitDTO{
+		Rank:        dm.Rank,
+		Name:        dm.Name,
+		Description: dm.Description,
+		Comment:     dm.Comment,
 	}
 }
 
@@ -388,13 +479,23 @@ func NewTaskDTOs(dm domain.Task, dict IDict) TaskDTOs {
 		},
 
 		ChildrensTotal: dm.ChildrensTotal,
+		Duration:       dm.Duration,
+		Estimate:       dm.Estimate,
+		EstimateTotal:  dm.EstimateTotal,
 		FinishedAt:     dm.FinishedAt,
+		StartAt:        dm.StartAt,
 		FinishTo:       dm.FinishTo,
 
 		CreatedAt:  dm.CreatedAt,
 		ActivityAt: dm.ActivityAt,
 		UpdatedAt:  dm.UpdatedAt,
 		DeletedAt:  dm.DeletedAt,
+
+		BoardRank: dm.BoardRank,
+
+		Checklist: domain.ChecklistProgress(dm.ChecklistDone, dm.ChecklistTotal),
+
+		Search: newTaskSearchHitDTO(dm.Search),
 	}
 }
 
@@ -426,6 +527,22 @@ func NewFilterDTO(filter *string) (dtos []FilterDTO, err error) {
 	return dtos, err
 }
 
+// NewFieldFilter parses json of the custom fields filter: condition {"field", "op", "value"} or group {"and"|"or": [...]}.
+func NewFieldFilter(filter *string) (*domain.FieldFilter, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	f := domain.FieldFilter{}
+
+	err := json.Unmarshal([]byte(*filter), &f)
+	if err != nil {
+		return nil, errors.New("некорректный json фильтра по полям")
+	}
+
+	return &f, nil
+}
+
 type TaskSearchDTO struct {
 	MyEmail *string `json:"my_email"`
 
@@ -440,11 +557,18 @@ type TaskSearchDTO struct {
 	Participated   *[]string `json:"participated"`
 	Tags           *[]string `json:"tags"`
 	Path           *string   `json:"path"`
+	Search         *string   `json:"search"`
 
-	Fields []FilterDTO `json:"fields"`
+	Fields      []FilterDTO         `json:"fields"`
+	FieldFilter *domain.FieldFilter `json:"field_filter"`
 
 	Order *string `json:"order"`
 	By    *string `json:"by"`
+
+	// Cursor - token of the next page, used instead of offset
+	Cursor *string `json:"cursor"`
+	// Total - exact, estimated or none
+	Total *string `json:"total"`
 }
 
 func (d *TaskSearchDTO) Validate() error {
@@ -456,5 +580,9 @@ func (d *TaskSearchDTO) Validate() error {
 		return errors.New("project_uuid не может быть пустым")
 	}
 
+	if d.Total != nil && !lo.Contains(domain.GetTotalModes(), *d.Total) {
+		return errors.New("total может быть exact, estimated или none")
+	}
+
 	return nil
 }
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type TimelineTaskDTO struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Status      int        `json:"status"`
+	IsEpic      bool       `json:"is_epic"`
+	ParentUUID  *uuid.UUID `json:"parent_uuid"`
+	ImplementBy string     `json:"implement_by"`
+	StartAt     *time.Time `json:"start_at"`
+	FinishTo    *time.Time `json:"finish_to"`
+	FinishedAt  *time.Time `json:"finished_at"`
+}
+
+type TimelineLinkDTO struct {
+	UUID     uuid.UUID `json:"uuid"`
+	FromUUID uuid.UUID `json:"from_uuid"`
+	ToUUID   uuid.UUID `json:"to_uuid"`
+	Type     string    `json:"type"`
+}
+
+type TimelineDTO struct {
+	Items []TimelineTaskDTO `json:"items"`
+	Links []TimelineLinkDTO `json:"links"`
+}
+
+func NewTimelineTaskDTO(dm domain.Task) TimelineTaskDTO {
+	return TimelineTaskDTO{
+		UUID:        dm.UUID,
+		ID:          dm.ID,
+		Name:        dm.Name,
+		Status:      dm.Status,
+		IsEpic:      dm.IsEpic,
+		ParentUUID:  dm.ParentUUID(),
+		ImplementBy: dm.ImplementBy,
+		StartAt:     dm.StartAt,
+		FinishTo:    dm.FinishTo,
+		FinishedAt:  dm.FinishedAt,
+	}
+}
+
+func NewTimelineDTO(dm domain.Timeline) TimelineDTO {
+	return TimelineDTO{
+		Items: lo.Map(dm.Tasks, func(t domain.Task, _ int) TimelineTaskDTO {
+			return NewTimelineTaskDTO(t)
+		}),
+		Links: lo.Map(dm.Links, func(l domain.TaskLink, _ int) TimelineLinkDTO {
+			return TimelineLinkDTO{
+				UUID:     l.UUID,
+				FromUUID: l.FromUUID,
+				ToUUID:   l.ToUUID,
+				Type:     l.Type,
+			}
+		}),
+	}
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type TrashItemDTO struct {
+	Type      string    `json:"type"`
+	UUID      uuid.UUID `json:"uuid"`
+	Name      string    `json:"name"`
+	TaskUUID  uuid.UUID `json:"task_uuid"`
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
+
+func NewTrashItemDTO(dm domain.TrashItem, retentionDays int) TrashItemDTO {
+	return TrashItemDTO{
+		Type:      dm.Type,
+		UUID:      dm.UUID,
+		Name:      dm.Name,
+		TaskUUID:  dm.TaskUUID,
+		DeletedAt: dm.DeletedAt,
+		PurgeAt:   dm.PurgeAt(retentionDays),
+	}
+}
@@ -1,9 +1,12 @@
 package dto
 
 import (
+	"strings"
+
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
 )
 
 type IStorage interface {
@@ -93,3 +96,13 @@ func NewUserShotDto(dm domain.User, s IStorage) UserShortDTO {
 		PhotoSmallURL: photoSmallURL,
 	}
 }
+
+// FIO returns "Lname Name Pname", email when the name is not filled.
+func (u UserDTO) FIO() string {
+	fio := strings.Join(lo.WithoutEmpty([]string{u.Lname, u.Name, u.Pname}), " ")
+	if fio == "" {
+		return u.Email
+	}
+
+	return fio
+}
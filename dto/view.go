@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type TaskViewDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	Name        string    `json:"name"`
+	IsShared    bool      `json:"is_shared"`
+
+	Filter  domain.TaskViewFilter `json:"filter"`
+	Order   *string               `json:"order,omitempty"`
+	By      *string               `json:"by,omitempty"`
+	Columns []string              `json:"columns"`
+
+	CreatedBy *UserDTO  `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewTaskViewDTO(dm domain.TaskView, dict IDict) TaskViewDTO {
+	fieldsSort := []string{}
+
+	project, f := dict.FindProject(dm.ProjectUUID)
+	if f {
+		fieldsSort = project.FieldsSort
+	}
+
+	d := TaskViewDTO{
+		UUID:        dm.UUID,
+		ProjectUUID: dm.ProjectUUID,
+		Name:        dm.Name,
+		IsShared:    dm.IsShared,
+		Filter:      dm.Filter,
+		Order:       dm.Order,
+		By:          dm.By,
+		Columns:     dm.ColumnsOrDefault(fieldsSort),
+		CreatedAt:   dm.CreatedAt,
+		UpdatedAt:   dm.UpdatedAt,
+	}
+
+	createdBy, f := dict.FindUserByUUID(dm.CreatedByUUID)
+	if f {
+		d.CreatedBy = createdBy
+	}
+
+	return d
+}
+
+// NewTaskSearchDTOFromView builds task search from the saved view, is_my is resolved for given user.
+func NewTaskSearchDTOFromView(dm domain.TaskView, myEmail string, offset, limit *int) TaskSearchDTO {
+	f := dm.Filter
+
+	filter := TaskSearchDTO{
+		MyEmail: &myEmail,
+
+		Name:           f.Name,
+		Search:         f.Search,
+		Offset:         offset,
+		Limit:          limit,
+		IsMy:           f.IsMy,
+		IsEpic:         f.IsEpic,
+		Status:         f.Status,
+		FederationUUID: dm.FederationUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		Path:           f.Path,
+		FieldFilter:    f.FieldFilter,
+
+		Order: dm.Order,
+		By:    dm.By,
+	}
+
+	if len(f.Participated) > 0 {
+		filter.Participated = &f.Participated
+	}
+
+	if len(f.Tags) > 0 {
+		filter.Tags = &f.Tags
+	}
+
+	names := make([]string, 0, len(f.Fields))
+	for name := range f.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		filter.Fields = append(filter.Fields, FilterDTO{
+			Name:     name,
+			Operator: "=",
+			Value:    f.Fields[name],
+		})
+	}
+
+	return filter
+}
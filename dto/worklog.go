@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type WorklogDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	TaskUUID    uuid.UUID `json:"task_uuid"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	User        *UserDTO  `json:"user,omitempty"`
+
+	StartedAt time.Time `json:"started_at"`
+	Duration  int       `json:"duration"`
+	Comment   string    `json:"comment"`
+	IsRunning bool      `json:"is_running"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WorklogReportItemDTO struct {
+	User    *UserDTO    `json:"user,omitempty"`
+	Project ProjectDTOs `json:"project"`
+	Seconds int64       `json:"seconds"`
+	Hours   float64     `json:"hours"`
+}
+
+func NewWorklogDTO(dm domain.Worklog, dict IDict) WorklogDTO {
+	d := WorklogDTO{
+		UUID:        dm.UUID,
+		TaskUUID:    dm.TaskUUID,
+		ProjectUUID: dm.ProjectUUID,
+		StartedAt:   dm.StartedAt,
+		Duration:    dm.Elapsed(time.Now()),
+		Comment:     dm.Comment,
+		IsRunning:   dm.IsRunning,
+		CreatedAt:   dm.CreatedAt,
+	}
+
+	user, f := dict.FindUserByUUID(dm.UserUUID)
+	if f {
+		d.User = user
+	}
+
+	return d
+}
+
+func NewWorklogReportItemDTO(dm domain.WorklogReportItem, dict IDict) WorklogReportItemDTO {
+	d := WorklogReportItemDTO{
+		Seconds: dm.Duration,
+		Hours:   math.Round(float64(dm.Duration)/36) / 100,
+	}
+
+	user, f := dict.FindUserByUUID(dm.UserUUID)
+	if f {
+		d.User = user
+	}
+
+	project, _ := dict.FindProject(dm.ProjectUUID)
+	d.Project = NewProjectDTOs(project)
+
+	return d
+}
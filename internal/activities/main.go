@@ -1,6 +1,8 @@
 package activities
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/dictionary"
@@ -42,18 +44,34 @@ func (s *Service) GetTaskActivities(taskUID uuid.UUID, limit, offset int) ([]dom
 	}
 
 	return lo.Map(orms, func(orm Activity, _ int) domain.Activity {
-		return domain.Activity{
-			UUID:        orm.UUID,
-			EntityUUID:  orm.EntityUUID,
-			EntityType:  orm.EntityType,
-			Description: orm.Description,
-			CreatedBy: domain.User{
-				UUID:  orm.CreatedByUUID,
-				Email: orm.CreatedBy,
-			},
-			CreatedAt: orm.CreatedAt,
-			Meta:      orm.Meta,
-			Type:      int(orm.Type),
-		}
+		return toDomain(orm)
 	}), total, nil
 }
+
+// GetTaskActivitiesSince returns activities of the task made after the moment, the newest first.
+func (s *Service) GetTaskActivitiesSince(taskUID uuid.UUID, since time.Time) ([]domain.Activity, error) {
+	orms, err := s.repo.GetTaskActivitiesSince(taskUID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(orms, func(orm Activity, _ int) domain.Activity {
+		return toDomain(orm)
+	}), nil
+}
+
+func toDomain(orm Activity) domain.Activity {
+	return domain.Activity{
+		UUID:        orm.UUID,
+		EntityUUID:  orm.EntityUUID,
+		EntityType:  orm.EntityType,
+		Description: orm.Description,
+		CreatedBy: domain.User{
+			UUID:  orm.CreatedByUUID,
+			Email: orm.CreatedBy,
+		},
+		CreatedAt: orm.CreatedAt,
+		Meta:      orm.Meta,
+		Type:      int(orm.Type),
+	}
+}
@@ -1,6 +1,8 @@
 package activities
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/pkg/postgres"
 )
@@ -36,3 +38,16 @@ func (r *Repository) GetTaskActivities(taskUID uuid.UUID, limit, offset int) (or
 
 	return orms, total, err
 }
+
+// GetTaskActivitiesSince returns all activities of the task made after the moment, the newest first.
+func (r *Repository) GetTaskActivitiesSince(taskUID uuid.UUID, since time.Time) (orms []Activity, err error) {
+	err = r.gorm.DB.
+		Where("entity_uuid = ?", taskUID).
+		Where("entity_type = ?", "task").
+		Where("created_at > ?", since).
+		Order("created_at DESC").
+		Find(&orms).
+		Error
+
+	return orms, err
+}
@@ -189,6 +189,104 @@ func (s *Service) TaskWasDeleted(creator domain.Creator, taskUID uuid.UUID, name
 	return act, nil
 }
 
+// TaskWasRestored - task, its comment or file was restored from the trash.
+func (s *Service) TaskWasRestored(creator domain.Creator, taskUUID uuid.UUID, item domain.TrashItem) (*Activity, error) {
+	ActivityMeta := dto.ActivityTaskWasRestoredDTO{
+		Type: item.Type,
+		Name: item.Name,
+	}
+
+	mp, err := helpers.StructToMap(ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	act := &Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    taskUUID,
+		EntityType:    "task",
+		Description:   fmt.Sprint(domain.ActivityTaskWasRestored),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          domain.ActivityTaskWasRestored,
+		Meta:          mp,
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
+
+// TaskWasMerged - direction is "into" for the source task and "from" for the target one.
+func (s *Service) TaskWasMerged(creator domain.Creator, taskUUID uuid.UUID, direction string, other domain.Task, conflicts []string) (*Activity, error) {
+	ActivityMeta := dto.ActivityTaskMergeDTO{
+		Direction: direction,
+		TaskUUID:  other.UUID,
+		TaskID:    other.ID,
+		TaskName:  other.Name,
+		Conflicts: conflicts,
+	}
+
+	mp, err := helpers.StructToMap(ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	act := &Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    taskUUID,
+		EntityType:    "task",
+		Description:   fmt.Sprint(domain.ActivityTaskWasMerged),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          domain.ActivityTaskWasMerged,
+		Meta:          mp,
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
+
+// TaskChecklistWasChanged - taskUUID is set for the item converted to the subtask.
+func (s *Service) TaskChecklistWasChanged(creator domain.Creator, item domain.ChecklistItem, action string, taskUUID *uuid.UUID) (*Activity, error) {
+	ActivityMeta := dto.ActivityTaskChecklistDTO{
+		Action:   action,
+		ItemUUID: item.UUID,
+		Text:     item.Text,
+		TaskUUID: taskUUID,
+	}
+
+	mp, err := helpers.StructToMap(ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	act := &Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    item.TaskUUID,
+		EntityType:    "task",
+		Description:   fmt.Sprint(domain.ActivityTaskChecklist),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          domain.ActivityTaskChecklist,
+		Meta:          mp,
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
+
 func (s *Service) TaskFileWasDeleted(creator domain.Creator, taskUUID uuid.UUID, file domain.File) (*Activity, error) {
 	ActivityMeta := dto.ActivityTaskFileWasDeletedDTO{
 		Name: file.Name,
@@ -219,3 +317,44 @@ func (s *Service) TaskFileWasDeleted(creator domain.Creator, taskUUID uuid.UUID,
 
 	return act, nil
 }
+
+func (s *Service) TaskLinkWasAdded(creator domain.Creator, taskUUID uuid.UUID, link domain.TaskLink, other domain.Task) (*Activity, error) {
+	return s.taskLinkActivity(creator, taskUUID, link, other, domain.ActivityTaskLinkAdded)
+}
+
+func (s *Service) TaskLinkWasRemoved(creator domain.Creator, taskUUID uuid.UUID, link domain.TaskLink, other domain.Task) (*Activity, error) {
+	return s.taskLinkActivity(creator, taskUUID, link, other, domain.ActivityTaskLinkRemoved)
+}
+
+func (s *Service) taskLinkActivity(creator domain.Creator, taskUUID uuid.UUID, link domain.TaskLink, other domain.Task, activityType domain.ActivityType) (*Activity, error) {
+	ActivityMeta := dto.ActivityTaskLinkDTO{
+		Type:      link.Type,
+		Direction: link.Direction(taskUUID),
+		TaskUUID:  other.UUID,
+		TaskID:    other.ID,
+		TaskName:  other.Name,
+	}
+
+	mp, err := helpers.StructToMap(ActivityMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	act := &Activity{
+		UUID:          uuid.New(),
+		EntityUUID:    taskUUID,
+		EntityType:    "task",
+		Description:   fmt.Sprint(activityType),
+		CreatedByUUID: creator.UUID,
+		CreatedBy:     creator.Email,
+		Type:          activityType,
+		Meta:          mp,
+	}
+
+	err = s.CreateActivity(act)
+	if err != nil {
+		return nil, err
+	}
+
+	return act, nil
+}
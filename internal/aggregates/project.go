@@ -82,6 +82,7 @@ func (s *Service) GetProject(_ context.Context, uid uuid.UUID) (dto.ProjectDTO,
 				RequiredOnStatuses: item.RequiredOnStatuses,
 				Style:              item.Style,
 				DataDesc:           item.FieldTypeDesc(),
+				Formula:            item.Formula,
 			}
 		}),
 
@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/agents"
 	"github.com/krisch/crm-backend/internal/aggregates"
+	"github.com/krisch/crm-backend/internal/automations"
 	"github.com/krisch/crm-backend/internal/cache"
 	"github.com/krisch/crm-backend/internal/catalogs"
 	"github.com/krisch/crm-backend/internal/comments"
@@ -15,20 +17,29 @@ import (
 	"github.com/krisch/crm-backend/internal/configs"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/escalations"
+	"github.com/krisch/crm-backend/internal/estimates"
+	"github.com/krisch/crm-backend/internal/exports"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/imports"
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/legalentities"
 	"github.com/krisch/crm-backend/internal/logs"
 	"github.com/krisch/crm-backend/internal/notifications"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/krisch/crm-backend/internal/recurring"
 	"github.com/krisch/crm-backend/internal/reminders"
+	"github.com/krisch/crm-backend/internal/reports"
 	"github.com/krisch/crm-backend/internal/s3"
 	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/internal/trash"
+	"github.com/krisch/crm-backend/internal/views"
+	"github.com/krisch/crm-backend/internal/worklog"
 	"github.com/krisch/crm-backend/pkg/redis"
 	"github.com/sirupsen/logrus"
 )
@@ -63,6 +74,16 @@ type App struct {
 	AgentsService        *agents.Service
 	PermissionsService   *permissions.Service
 	LegalEntitiesService *legalentities.Service
+	RecurringService     *recurring.Service
+	WorklogService       *worklog.Service
+	ViewsService         *views.Service
+	ImportsService       *imports.Service
+	ExportsService       *exports.Service
+	EscalationsService   *escalations.Service
+	TrashService         *trash.Service
+	AutomationsService   *automations.Service
+	EstimatesService     *estimates.Service
+	ReportsService       *reports.Service
 
 	MetricsCounters *helpers.MetricsCounters
 }
@@ -119,6 +140,142 @@ func (a *App) SyncDictionariesByHook() {
 	}()
 }
 
+func (a *App) MaterializeRecurringTasksByTimeout() {
+	syncTime := time.Second * time.Duration(a.Options.RECURRING_TASKS_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(syncTime)
+				a.MaterializeRecurringTasksByTimeout()
+			}
+		}()
+
+		for {
+			created, err := a.RecurringService.Materialize(time.Now())
+			if err != nil {
+				logrus.Error(err)
+			}
+
+			if created > 0 {
+				logrus.WithField("created", created).Info("recurring tasks materialized")
+			}
+
+			time.Sleep(syncTime)
+		}
+	}()
+}
+
+func (a *App) EscalateDeadlinesByTimeout() {
+	syncTime := time.Second * time.Duration(a.Options.DEADLINE_ESCALATION_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(syncTime)
+				a.EscalateDeadlinesByTimeout()
+			}
+		}()
+
+		for {
+			sent, err := a.EscalationsService.Escalate(context.Background(), time.Now(), syncTime)
+			if err != nil {
+				logrus.Error(err)
+			}
+
+			if sent > 0 {
+				logrus.WithField("sent", sent).Info("deadline escalations sent")
+			}
+
+			time.Sleep(syncTime)
+		}
+	}()
+}
+
+func (a *App) PurgeTrashByTimeout() {
+	syncTime := time.Second * time.Duration(a.Options.TRASH_PURGE_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(syncTime)
+				a.PurgeTrashByTimeout()
+			}
+		}()
+
+		for {
+			purged, err := a.TrashService.Purge(context.Background(), time.Now(), a.Options.TRASH_RETENTION_DAYS, syncTime)
+			if err != nil {
+				logrus.Error(err)
+			}
+
+			if purged > 0 {
+				logrus.WithField("purged", purged).Info("trash purged")
+			}
+
+			time.Sleep(syncTime)
+		}
+	}()
+}
+
+func (a *App) RunDueAutomationsByTimeout() {
+	syncTime := time.Second * time.Duration(a.Options.AUTOMATION_DUE_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(syncTime)
+				a.RunDueAutomationsByTimeout()
+			}
+		}()
+
+		for {
+			total, err := a.AutomationsService.DuePassed(context.Background(), time.Now(), syncTime)
+			if err != nil {
+				logrus.Error(err)
+			}
+
+			if total > 0 {
+				logrus.WithField("total", total).Info("due automations processed")
+			}
+
+			time.Sleep(syncTime)
+		}
+	}()
+}
+
+// SnapshotEstimatesByTimeout saves estimated work of projects, the snapshot of the day is overwritten until the day ends.
+func (a *App) SnapshotEstimatesByTimeout() {
+	syncTime := time.Second * time.Duration(a.Options.ESTIMATE_SNAPSHOT_INTERVAL)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(syncTime)
+				a.SnapshotEstimatesByTimeout()
+			}
+		}()
+
+		for {
+			total, err := a.EstimatesService.Snapshot(time.Now())
+			if err != nil {
+				logrus.Error(err)
+			}
+
+			if total > 0 {
+				logrus.WithField("total", total).Info("project snapshots saved")
+			}
+
+			time.Sleep(syncTime)
+		}
+	}()
+}
+
 func (a *App) RedisSubscribe(ctx context.Context, rds *redis.RDS, ch string) {
 	pubsub := rds.Subscribe(ctx, ch)
 	go func() {
@@ -156,6 +313,11 @@ func (a *App) Work(ctx context.Context, rds *redis.RDS) {
 	a.RedisSubscribe(ctx, rds, "update")
 	a.SyncDictionariesByTimeout()
 	a.SyncDictionariesByHook()
+	a.MaterializeRecurringTasksByTimeout()
+	a.EscalateDeadlinesByTimeout()
+	a.PurgeTrashByTimeout()
+	a.RunDueAutomationsByTimeout()
+	a.SnapshotEstimatesByTimeout()
 }
 
 func (a *App) Subscribe(_ context.Context) {
@@ -168,9 +330,33 @@ func (a *App) Subscribe(_ context.Context) {
 	a.TaskService.OnOpenTask(func(uid uuid.UUID, email string) error {
 		logrus.Info("task was open")
 		err := a.NotificationsService.RemoveNotification(email, "task", uid)
+		if err != nil {
+			return err
+		}
+
+		return a.NotificationsService.RemoveNotification(email, "deadline", uid)
+	})
+
+	a.TaskService.OnBulkDone(func(op domain.BulkOperation, createdBy string, people map[string][]uuid.UUID) error {
+		logrus.Info("bulk operation done: ", op.UUID)
+		err := a.NotificationsService.CreateBulkState(op.UUID, op.Operation, createdBy, people)
 		return err
 	})
 
+	a.TaskService.OnTaskEvent(func(evs []domain.TaskEvent) {
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					logrus.Errorf("exception: %s", string(debug.Stack()))
+				}
+			}()
+
+			for _, ev := range evs {
+				a.AutomationsService.Handle(ev)
+			}
+		}()
+	})
+
 	a.RemindersService.OnReminderWasUpdatedOrCreated(func(uid, taskUUID uuid.UUID, people []string) error {
 		logrus.Info("reminder updated or created: ", uid)
 		err := a.NotificationsService.CreateTaskState(taskUUID, people)
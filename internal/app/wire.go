@@ -8,6 +8,7 @@ import (
 	"github.com/krisch/crm-backend/internal/activities"
 	"github.com/krisch/crm-backend/internal/agents"
 	"github.com/krisch/crm-backend/internal/aggregates"
+	"github.com/krisch/crm-backend/internal/automations"
 	"github.com/krisch/crm-backend/internal/cache"
 	"github.com/krisch/crm-backend/internal/catalogs"
 	"github.com/krisch/crm-backend/internal/comments"
@@ -15,20 +16,29 @@ import (
 	"github.com/krisch/crm-backend/internal/configs"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/escalations"
+	"github.com/krisch/crm-backend/internal/estimates"
+	"github.com/krisch/crm-backend/internal/exports"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/imports"
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/legalentities"
 	"github.com/krisch/crm-backend/internal/logs"
 	"github.com/krisch/crm-backend/internal/notifications"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/krisch/crm-backend/internal/recurring"
 	"github.com/krisch/crm-backend/internal/reminders"
+	"github.com/krisch/crm-backend/internal/reports"
 	"github.com/krisch/crm-backend/internal/s3"
 	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/internal/trash"
+	"github.com/krisch/crm-backend/internal/views"
+	"github.com/krisch/crm-backend/internal/worklog"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
 )
@@ -131,6 +141,25 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 
 		catalogs.NewRepository,
 		catalogs.New,
+		recurring.NewRepository,
+		recurring.New,
+		worklog.NewRepository,
+		worklog.New,
+		views.NewRepository,
+		views.New,
+		imports.NewRepository,
+		imports.New,
+		exports.NewRepository,
+		exports.New,
+		escalations.New,
+		trash.NewRepository,
+		trash.New,
+		automations.NewRepository,
+		automations.New,
+		estimates.NewRepository,
+		estimates.New,
+		reports.NewRepository,
+		reports.New,
 
 		// Подключаем репозиторий и сервис для legalentities
 		legalentities.NewRepository,
@@ -174,6 +203,16 @@ func NewApp(
 	smsService *sms.Service,
 	agentsService *agents.Service,
 	permissionsService *permissions.Service,
+	recurringService *recurring.Service,
+	worklogService *worklog.Service,
+	viewsService *views.Service,
+	importsService *imports.Service,
+	exportsService *exports.Service,
+	escalationsService *escalations.Service,
+	trashService *trash.Service,
+	automationsService *automations.Service,
+	estimatesService *estimates.Service,
+	reportsService *reports.Service,
 ) *App {
 	w := &App{
 		Env:  conf.ENV,
@@ -219,6 +258,16 @@ func NewApp(
 	w.AgentsService = agentsService
 	w.PermissionsService = permissionsService
 	w.LegalEntitiesService = legalEntitiesService
+	w.RecurringService = recurringService
+	w.WorklogService = worklogService
+	w.ViewsService = viewsService
+	w.ImportsService = importsService
+	w.ExportsService = exportsService
+	w.EscalationsService = escalationsService
+	w.TrashService = trashService
+	w.AutomationsService = automationsService
+	w.EstimatesService = estimatesService
+	w.ReportsService = reportsService
 
 	return w
 }
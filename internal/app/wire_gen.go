@@ -10,6 +10,7 @@ import (
 	"github.com/krisch/crm-backend/internal/activities"
 	"github.com/krisch/crm-backend/internal/agents"
 	"github.com/krisch/crm-backend/internal/aggregates"
+	"github.com/krisch/crm-backend/internal/automations"
 	"github.com/krisch/crm-backend/internal/cache"
 	"github.com/krisch/crm-backend/internal/catalogs"
 	"github.com/krisch/crm-backend/internal/comments"
@@ -17,20 +18,29 @@ import (
 	"github.com/krisch/crm-backend/internal/configs"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/escalations"
+	"github.com/krisch/crm-backend/internal/estimates"
+	"github.com/krisch/crm-backend/internal/exports"
 	"github.com/krisch/crm-backend/internal/federation"
 	"github.com/krisch/crm-backend/internal/gates"
 	"github.com/krisch/crm-backend/internal/health"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/imports"
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/legalentities"
 	"github.com/krisch/crm-backend/internal/logs"
 	"github.com/krisch/crm-backend/internal/notifications"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/krisch/crm-backend/internal/recurring"
 	"github.com/krisch/crm-backend/internal/reminders"
+	"github.com/krisch/crm-backend/internal/reports"
 	"github.com/krisch/crm-backend/internal/s3"
 	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/internal/trash"
+	"github.com/krisch/crm-backend/internal/views"
+	"github.com/krisch/crm-backend/internal/worklog"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
 )
@@ -95,7 +105,26 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	agentsService := agents.New(agentsRepository)
 	permissionsRepository := permissions.NewRepository(gdb, rds)
 	permissionsService := permissions.New(permissionsRepository)
-	app := NewApp(name, configsConfigs, gdb, rds, service, notificationsService, iLogService, profileService, iEmailsService, federationService, legalentitiesService, taskService, commentsService, dictionaryService, s3Service, servicePrivate, gatesService, cacheService, metricsCounters, remindersService, catalogsService, aggregatesService, companyService, smsService, agentsService, permissionsService)
+	recurringRepository := recurring.NewRepository(gdb)
+	recurringService := recurring.New(recurringRepository, dictionaryService, taskService)
+	worklogRepository := worklog.NewRepository(gdb)
+	worklogService := worklog.New(worklogRepository, taskService)
+	viewsRepository := views.NewRepository(gdb)
+	viewsService := views.New(viewsRepository)
+	importsRepository := imports.NewRepository(gdb)
+	importsService := imports.New(importsRepository, taskService, dictionaryService)
+	exportsRepository := exports.NewRepository(gdb)
+	exportsService := exports.New(exportsRepository, taskService, dictionaryService, servicePrivate)
+	escalationsService := escalations.New(taskService, dictionaryService, notificationsService, rds)
+	trashRepository := trash.NewRepository(gdb)
+	trashService := trash.New(trashRepository, taskService, activitiesService, servicePrivate, rds)
+	automationsRepository := automations.NewRepository(gdb)
+	automationsService := automations.New(automationsRepository, taskService, dictionaryService, remindersService, companyService, smsService, iEmailsService, rds)
+	estimatesRepository := estimates.NewRepository(gdb)
+	estimatesService := estimates.New(estimatesRepository)
+	reportsRepository := reports.NewRepository(gdb)
+	reportsService := reports.New(reportsRepository, federationService)
+	app := NewApp(name, configsConfigs, gdb, rds, service, notificationsService, iLogService, profileService, iEmailsService, federationService, legalentitiesService, taskService, commentsService, dictionaryService, s3Service, servicePrivate, gatesService, cacheService, metricsCounters, remindersService, catalogsService, aggregatesService, companyService, smsService, agentsService, permissionsService, recurringService, worklogService, viewsService, importsService, exportsService, escalationsService, trashService, automationsService, estimatesService, reportsService)
 	return app, nil
 }
 
@@ -158,6 +187,16 @@ func NewApp(
 	smsService *sms.Service,
 	agentsService *agents.Service,
 	permissionsService *permissions.Service,
+	recurringService *recurring.Service,
+	worklogService *worklog.Service,
+	viewsService *views.Service,
+	importsService *imports.Service,
+	exportsService *exports.Service,
+	escalationsService *escalations.Service,
+	trashService *trash.Service,
+	automationsService *automations.Service,
+	estimatesService *estimates.Service,
+	reportsService *reports.Service,
 ) *App {
 	w := &App{
 		Env:  conf.ENV,
@@ -201,6 +240,16 @@ func NewApp(
 	w.AgentsService = agentsService
 	w.PermissionsService = permissionsService
 	w.LegalEntitiesService = legalEntitiesService
+	w.RecurringService = recurringService
+	w.WorklogService = worklogService
+	w.ViewsService = viewsService
+	w.ImportsService = importsService
+	w.ExportsService = exportsService
+	w.EscalationsService = escalationsService
+	w.TrashService = trashService
+	w.AutomationsService = automationsService
+	w.EstimatesService = estimatesService
+	w.ReportsService = reportsService
 
 	return w
 }
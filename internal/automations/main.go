@@ -0,0 +1,427 @@
+package automations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/company"
+	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/reminders"
+	"github.com/krisch/crm-backend/internal/sms"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const lockKey = "automations:lock"
+
+// dueTTL - how long the processed deadline is remembered, seconds.
+const dueTTL = 2 * int(domain.AutomationDueLookback/time.Second)
+
+const reminderType = "automation"
+
+type Service struct {
+	repo *Repository
+	ts   *task.Service
+	dict *dictionary.Service
+	rs   *reminders.Service
+	cs   *company.Service
+	sms  *sms.Service
+	es   emails.IEmailsService
+	rds  *redis.RDS
+}
+
+func New(repo *Repository, ts *task.Service, dict *dictionary.Service, rs *reminders.Service, cs *company.Service, smsService *sms.Service, es emails.IEmailsService, rds *redis.RDS) *Service {
+	return &Service{
+		repo: repo,
+		ts:   ts,
+		dict: dict,
+		rs:   rs,
+		cs:   cs,
+		sms:  smsService,
+		es:   es,
+		rds:  rds,
+	}
+}
+
+func (s *Service) GetByProject(projectUUID uuid.UUID) ([]domain.AutomationRule, error) {
+	return s.repo.GetByProject(projectUUID)
+}
+
+func (s *Service) GetRuns(projectUUID, uid uuid.UUID) ([]domain.AutomationRun, error) {
+	_, err := s.get(projectUUID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetRuns(uid)
+}
+
+func (s *Service) Create(rule domain.AutomationRule) error {
+	err := s.validate(rule)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Create(rule)
+}
+
+// Put replaces the definition of the rule.
+func (s *Service) Put(projectUUID, uid uuid.UUID, name string, enabled bool, trigger domain.AutomationTrigger, conditions []domain.AutomationCondition, actions []domain.AutomationAction) (rule domain.AutomationRule, err error) {
+	rule, err = s.get(projectUUID, uid)
+	if err != nil {
+		return rule, err
+	}
+
+	rule.Name = strings.TrimSpace(name)
+	rule.Enabled = enabled
+	rule.Trigger = trigger
+	rule.Conditions = conditions
+	rule.Actions = actions
+
+	err = rule.Validate()
+	if err != nil {
+		return rule, err
+	}
+
+	err = s.validate(rule)
+	if err != nil {
+		return rule, err
+	}
+
+	return rule, s.repo.Update(rule)
+}
+
+func (s *Service) Delete(projectUUID, uid uuid.UUID) error {
+	_, err := s.get(projectUUID, uid)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(uid)
+}
+
+func (s *Service) get(projectUUID, uid uuid.UUID) (rule domain.AutomationRule, err error) {
+	rule, err = s.repo.Get(uid)
+	if err != nil {
+		return rule, err
+	}
+
+	if rule.ProjectUUID != projectUUID {
+		return rule, dto.NotFoundErr("правило автоматизации не найдено")
+	}
+
+	return rule, nil
+}
+
+// validate checks fields, statuses and users of the rule against the project.
+func (s *Service) validate(rule domain.AutomationRule) error {
+	project, ok := s.dict.FindProject(rule.ProjectUUID)
+	if !ok {
+		return dto.NotFoundErr("проект не найден")
+	}
+
+	if rule.Trigger.Status != nil && project.Statuses != nil {
+		_, ok := lo.Find(*project.Statuses, func(st dto.ProjectStatusDTO) bool {
+			return st.Number == *rule.Trigger.Status
+		})
+		if !ok {
+			return fmt.Errorf("статус %d не найден в проекте", *rule.Trigger.Status)
+		}
+	}
+
+	fields, _ := s.dict.FindProjectFields(rule.ProjectUUID)
+	types := lo.SliceToMap(fields, func(f dto.ProjectFieldDTO) (string, domain.FieldDataType) {
+		return f.Hash, domain.FieldDataType(f.DataType)
+	})
+
+	for _, hash := range rule.Fields() {
+		if _, ok := types[hash]; !ok {
+			return fmt.Errorf("поле %s не найдено в проекте", hash)
+		}
+	}
+
+	for _, a := range rule.Actions {
+		if a.Type == domain.AutomationSetField && types[a.Field] == domain.Formula {
+			return fmt.Errorf("поле %s вычисляется по формуле", a.Field)
+		}
+
+		// the user is set by email, not copied from the task role
+		if a.Type == domain.AutomationSetTeam && a.TeamUser(domain.Task{}) == a.User {
+			if _, ok := s.dict.FindUser(a.User); !ok {
+				return fmt.Errorf("пользователь %s не найден", a.User)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Handle runs matching rules of the project for the event. Changes made by rules are passed
+// to the rules again with the chain, so the rule already in the chain or too long chain is stopped.
+func (s *Service) Handle(ev domain.TaskEvent) {
+	// changes of the rules come with the chain from the rule itself
+	if ev.Actor == domain.AutomationActor.Email && ev.Depth == 0 {
+		return
+	}
+
+	rules, err := s.repo.GetEnabled(ev.ProjectUUID, ev.Trigger)
+	if err != nil {
+		logrus.WithField("task", ev.TaskUUID).Error("automation rules error: ", err)
+		return
+	}
+
+	for _, rule := range rules {
+		if rule.Matches(ev) {
+			s.run(rule, ev)
+		}
+	}
+}
+
+func (s *Service) run(rule domain.AutomationRule, ev domain.TaskEvent) {
+	run := domain.AutomationRun{
+		UUID:      uuid.New(),
+		RuleUUID:  rule.UUID,
+		TaskUUID:  ev.TaskUUID,
+		Trigger:   ev.Trigger,
+		Depth:     ev.Depth,
+		Status:    domain.AutomationRunDone,
+		CreatedAt: time.Now(),
+	}
+
+	t, err := s.ts.GetTask(context.Background(), ev.TaskUUID, []string{})
+	if err != nil {
+		logrus.WithField("task", ev.TaskUUID).Error("automation task error: ", err)
+		return
+	}
+
+	if !rule.Check(t) {
+		return
+	}
+
+	next := []domain.TaskEvent{}
+	actions := rule.Actions
+
+	if ev.Stopped(rule.UUID) {
+		run.Status = domain.AutomationRunStopped
+		run.Error = "правило уже выполнено в цепочке автоматизаций"
+		actions = nil
+	}
+
+	for _, a := range actions {
+		events, err := s.apply(rule, ev, &t, a)
+		if err != nil {
+			run.Status = domain.AutomationRunFailed
+			run.Error = fmt.Sprintf("%s: %s", a.Type, err)
+
+			break
+		}
+
+		run.Actions++
+		next = append(next, events...)
+	}
+
+	err = s.repo.CreateRun(run)
+	if err != nil {
+		logrus.WithField("rule", rule.UUID).Error("automation run error: ", err)
+	}
+
+	for _, n := range next {
+		s.Handle(n)
+	}
+}
+
+// apply makes the change of the action, it returns events caused by the change.
+func (s *Service) apply(rule domain.AutomationRule, ev domain.TaskEvent, t *domain.Task, a domain.AutomationAction) ([]domain.TaskEvent, error) {
+	crtr := domain.AutomationActor
+
+	switch a.Type {
+	case domain.AutomationSetField:
+		changed := domain.ChangedFields(t.Fields, map[string]interface{}{a.Field: a.Value})
+
+		if t.Fields == nil {
+			t.Fields = map[string]interface{}{}
+		}
+
+		t.RawFields = map[string]interface{}{a.Field: a.Value}
+		defer func() { t.RawFields = nil }()
+
+		err := s.ts.UpdateTask(context.Background(), crtr, *t, []string{"fields"})
+		if err != nil || len(changed) == 0 {
+			return nil, err
+		}
+
+		return []domain.TaskEvent{ev.Next(rule.UUID, domain.AutomationFieldChanged, t.UUID, changed)}, nil
+	case domain.AutomationAddTag:
+		if lo.Contains(t.Tags, a.Tag) {
+			return nil, nil
+		}
+
+		t.Tags = domain.AddTags(t.Tags, []string{a.Tag})
+
+		return nil, s.ts.UpdateTask(context.Background(), crtr, *t, []string{"tags"})
+	case domain.AutomationSetTeam:
+		return nil, s.setTeam(t, a)
+	case domain.AutomationCreateSubtask:
+		subtask, err := domain.NewTask(domain.AutomationText(a.Name, *t), t.FederationUUID, t.CompanyUUID, t.ProjectUUID, crtr.Email,
+			nil, []string{}, "", append([]string{}, t.Path...), []string{}, "", "", t.Priority, nil, "", "", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.ts.CreateTask(subtask)
+		if err != nil {
+			return nil, err
+		}
+
+		return []domain.TaskEvent{ev.Next(rule.UUID, domain.AutomationTaskCreated, subtask.UUID, nil)}, nil
+	}
+
+	user, err := s.recipient(*t, a.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	text := domain.AutomationText(a.Text, *t)
+
+	switch a.Type {
+	case domain.AutomationSendSms:
+		return nil, s.sendSms(*t, user, text)
+	case domain.AutomationSendEmail:
+		subject := fmt.Sprintf("Задача #%d %s", t.ID, t.Name)
+		return nil, s.es.SendEmail([]string{user.Email}, emails.NewTextMessage(subject, text))
+	case domain.AutomationCreateReminder:
+		at := time.Now().Add(time.Duration(a.Offset) * time.Minute)
+
+		return nil, s.rs.Create(domain.Reminder{
+			UUID:          uuid.New(),
+			TaskUUID:      t.UUID,
+			CreatedBy:     crtr.Email,
+			CreatedByUUID: crtr.UUID,
+			Description:   text,
+			Type:          reminderType,
+			DateFrom:      &at,
+			DateTo:        &at,
+			UserUUID:      &user.UUID,
+		})
+	}
+
+	return nil, fmt.Errorf("неизвестное действие: %s", a.Type)
+}
+
+func (s *Service) setTeam(t *domain.Task, a domain.AutomationAction) error {
+	email := a.TeamUser(*t)
+	if email == "" {
+		return errors.New("пользователь не найден")
+	}
+
+	var implementedBy, responsibleBy, managedBy *string
+
+	switch a.Role {
+	case domain.AutomationImplementBy:
+		implementedBy, t.ImplementBy = &email, email
+	case domain.AutomationResponsibleBy:
+		responsibleBy, t.ResponsibleBy = &email, email
+	case domain.AutomationManagedBy:
+		managedBy, t.ManagedBy = &email, email
+	}
+
+	err := s.ts.PatchTeam(context.Background(), domain.AutomationActor, t.UUID, implementedBy, responsibleBy, nil, nil, managedBy)
+	if err != nil {
+		return err
+	}
+
+	// next actions of the rule notify the team with the new member
+	t.People = t.TeamPeople(nil, nil, nil, nil, nil)
+
+	return nil
+}
+
+func (s *Service) recipient(t domain.Task, role string) (*dto.UserDTO, error) {
+	email := t.TeamMember(role)
+	if email == "" {
+		return nil, fmt.Errorf("у задачи не указан %s", role)
+	}
+
+	user, ok := s.dict.FindUser(email)
+	if !ok {
+		return nil, fmt.Errorf("пользователь %s не найден", email)
+	}
+
+	return user, nil
+}
+
+func (s *Service) sendSms(t domain.Task, user *dto.UserDTO, text string) error {
+	if user.Phone == 0 {
+		return fmt.Errorf("у пользователя %s не указан телефон", user.Email)
+	}
+
+	cmpny, ok := s.dict.FindCompany(t.CompanyUUID)
+	if !ok {
+		return errors.New("компания не найдена")
+	}
+
+	smsOptions, err := s.cs.GetSmsOptions(cmpny.UUID)
+	if err != nil {
+		return err
+	}
+
+	msg := sms.NewCompanySms(fmt.Sprint(user.Phone), text, smsOptions.From, domain.AutomationActor.UUID, domain.AutomationActor.Email, cmpny)
+
+	_, err = s.sms.SmsSend(smsOptions.API, msg)
+	if err != nil {
+		return err
+	}
+
+	return s.sms.StoreSms(msg)
+}
+
+// DuePassed runs due_passed rules for tasks whose deadline has passed. The tick runs on one instance only:
+// it is guarded by redis lock for the interval, and every deadline of the task is processed once.
+func (s *Service) DuePassed(ctx context.Context, now time.Time, interval time.Duration) (total int, err error) {
+	locked, err := s.rds.SetNX(ctx, lockKey, now.Format(time.RFC3339), int(interval/time.Second))
+	if err != nil || !locked {
+		return total, err
+	}
+
+	projects, err := s.repo.HasEnabled(domain.AutomationDuePassed)
+	if err != nil || len(projects) == 0 {
+		return total, err
+	}
+
+	err = s.ts.EachDueTask(now.Add(-domain.AutomationDueLookback), now, func(t domain.Task) error {
+		if !lo.Contains(projects, t.ProjectUUID) {
+			return nil
+		}
+
+		// deadline is a part of the key, so the moved deadline is processed again
+		key := fmt.Sprintf("automations:due:%s:%d", t.UUID, t.FinishTo.Unix())
+
+		first, err := s.rds.SetNX(ctx, key, "1", dueTTL)
+		if err != nil {
+			logrus.WithField("task", t.UUID).Error("automation due error: ", err)
+			return nil
+		}
+
+		if first {
+			s.Handle(domain.TaskEvent{
+				Trigger:     domain.AutomationDuePassed,
+				TaskUUID:    t.UUID,
+				ProjectUUID: t.ProjectUUID,
+			})
+			total++
+		}
+
+		return nil
+	})
+
+	return total, err
+}
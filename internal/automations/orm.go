@@ -0,0 +1,41 @@
+package automations
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type AutomationRule struct {
+	UUID           uuid.UUID `gorm:"<-:create;type:uuid;primary_key"`
+	FederationUUID uuid.UUID `gorm:"<-:create;type:uuid"`
+	CompanyUUID    uuid.UUID `gorm:"<-:create;type:uuid"`
+	ProjectUUID    uuid.UUID `gorm:"<-:create;type:uuid"`
+
+	Name          string         `gorm:"type:varchar(100)"`
+	Enabled       bool           `gorm:"type:boolean"`
+	Trigger       string         `gorm:"type:varchar(50)"`
+	TriggerParams datatypes.JSON `gorm:"type:jsonb"`
+	Conditions    datatypes.JSON `gorm:"type:jsonb"`
+	Actions       datatypes.JSON `gorm:"type:jsonb"`
+
+	CreatedBy string    `gorm:"<-:create;type:varchar(100)"`
+	CreatedAt time.Time `gorm:"<-:create;type:timestamptz"`
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+type AutomationRun struct {
+	UUID     uuid.UUID `gorm:"<-:create;type:uuid;primary_key"`
+	RuleUUID uuid.UUID `gorm:"<-:create;type:uuid"`
+	TaskUUID uuid.UUID `gorm:"<-:create;type:uuid"`
+
+	Trigger string `gorm:"type:varchar(50)"`
+	Depth   int    `gorm:"type:int"`
+	Status  string `gorm:"type:varchar(20)"`
+	Error   string `gorm:"type:text"`
+	Actions int    `gorm:"type:int"`
+
+	CreatedAt time.Time `gorm:"<-:create;type:timestamptz"`
+}
@@ -0,0 +1,255 @@
+package automations
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+const runsLimit = 100
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Create(dm domain.AutomationRule) error {
+	orm, err := toORM(dm)
+	if err != nil {
+		return err
+	}
+
+	return r.gorm.DB.Create(&orm).Error
+}
+
+func (r *Repository) Update(dm domain.AutomationRule) error {
+	orm, err := toORM(dm)
+	if err != nil {
+		return err
+	}
+
+	res := r.gorm.DB.
+		Model(&AutomationRule{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at IS NULL").
+		Updates(map[string]interface{}{
+			"name":           orm.Name,
+			"enabled":        orm.Enabled,
+			"trigger":        orm.Trigger,
+			"trigger_params": orm.TriggerParams,
+			"conditions":     orm.Conditions,
+			"actions":        orm.Actions,
+			"updated_at":     "now()",
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("правило автоматизации не найдено")
+	}
+
+	return nil
+}
+
+func (r *Repository) Get(uid uuid.UUID) (dm domain.AutomationRule, err error) {
+	orm := AutomationRule{}
+
+	res := r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at IS NULL").
+		Find(&orm)
+
+	if res.Error != nil {
+		return dm, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dm, dto.NotFoundErr("правило автоматизации не найдено")
+	}
+
+	return toDomain(orm)
+}
+
+func (r *Repository) GetByProject(projectUUID uuid.UUID) (dms []domain.AutomationRule, err error) {
+	return r.find(r.gorm.DB.Where("project_uuid = ?", projectUUID))
+}
+
+// GetEnabled returns enabled rules of the project for the trigger.
+func (r *Repository) GetEnabled(projectUUID uuid.UUID, trigger string) (dms []domain.AutomationRule, err error) {
+	return r.find(r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Where("trigger = ?", trigger).
+		Where("enabled = true"))
+}
+
+// HasEnabled returns projects which have enabled rules for the trigger.
+func (r *Repository) HasEnabled(trigger string) (projects []uuid.UUID, err error) {
+	err = r.gorm.DB.
+		Model(&AutomationRule{}).
+		Distinct("project_uuid").
+		Where("trigger = ?", trigger).
+		Where("enabled = true").
+		Where("deleted_at IS NULL").
+		Pluck("project_uuid", &projects).
+		Error
+
+	return projects, err
+}
+
+func (r *Repository) Delete(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&AutomationRule{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at IS NULL").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("правило автоматизации не найдено")
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateRun(dm domain.AutomationRun) error {
+	orm := &AutomationRun{
+		UUID:      dm.UUID,
+		RuleUUID:  dm.RuleUUID,
+		TaskUUID:  dm.TaskUUID,
+		Trigger:   dm.Trigger,
+		Depth:     dm.Depth,
+		Status:    dm.Status,
+		Error:     dm.Error,
+		Actions:   dm.Actions,
+		CreatedAt: dm.CreatedAt,
+	}
+
+	return r.gorm.DB.Create(orm).Error
+}
+
+// GetRuns returns the last runs of the rule.
+func (r *Repository) GetRuns(ruleUUID uuid.UUID) (dms []domain.AutomationRun, err error) {
+	orm := []AutomationRun{}
+
+	err = r.gorm.DB.
+		Where("rule_uuid = ?", ruleUUID).
+		Order("created_at DESC").
+		Limit(runsLimit).
+		Find(&orm).
+		Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	return lo.Map(orm, func(item AutomationRun, _ int) domain.AutomationRun {
+		return domain.AutomationRun{
+			UUID:      item.UUID,
+			RuleUUID:  item.RuleUUID,
+			TaskUUID:  item.TaskUUID,
+			Trigger:   item.Trigger,
+			Depth:     item.Depth,
+			Status:    item.Status,
+			Error:     item.Error,
+			Actions:   item.Actions,
+			CreatedAt: item.CreatedAt,
+		}
+	}), nil
+}
+
+func (r *Repository) find(q *gorm.DB) (dms []domain.AutomationRule, err error) {
+	orm := []AutomationRule{}
+
+	err = q.
+		Where("deleted_at IS NULL").
+		Order("created_at").
+		Find(&orm).
+		Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	for _, item := range orm {
+		dm, err := toDomain(item)
+		if err != nil {
+			return dms, err
+		}
+
+		dms = append(dms, dm)
+	}
+
+	return dms, nil
+}
+
+func toORM(dm domain.AutomationRule) (orm AutomationRule, err error) {
+	orm = AutomationRule{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		Name:           dm.Name,
+		Enabled:        dm.Enabled,
+		Trigger:        dm.Trigger.Type,
+		CreatedBy:      dm.CreatedBy,
+		CreatedAt:      dm.CreatedAt,
+		UpdatedAt:      dm.UpdatedAt,
+	}
+
+	orm.TriggerParams, err = json.Marshal(dm.Trigger)
+	if err != nil {
+		return orm, err
+	}
+
+	orm.Conditions, err = json.Marshal(lo.Ternary(dm.Conditions == nil, []domain.AutomationCondition{}, dm.Conditions))
+	if err != nil {
+		return orm, err
+	}
+
+	orm.Actions, err = json.Marshal(dm.Actions)
+
+	return orm, err
+}
+
+func toDomain(orm AutomationRule) (dm domain.AutomationRule, err error) {
+	dm = domain.AutomationRule{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+		ProjectUUID:    orm.ProjectUUID,
+		Name:           orm.Name,
+		Enabled:        orm.Enabled,
+		CreatedBy:      orm.CreatedBy,
+		CreatedAt:      orm.CreatedAt,
+		UpdatedAt:      orm.UpdatedAt,
+	}
+
+	err = json.Unmarshal(orm.TriggerParams, &dm.Trigger)
+	if err != nil {
+		return dm, err
+	}
+
+	err = json.Unmarshal(orm.Conditions, &dm.Conditions)
+	if err != nil {
+		return dm, err
+	}
+
+	err = json.Unmarshal(orm.Actions, &dm.Actions)
+
+	return dm, err
+}
@@ -210,8 +210,9 @@ func (s *Service) DeleteComment(ctx context.Context, taskUUID, commentUID uuid.U
 		return err
 	}
 
+	// files stay in the trash with the comment
 	for _, file := range files {
-		err = s.storage.Delete(file.UUID)
+		err = s.storage.Trash(file.UUID)
 		if err != nil {
 			return err
 		}
@@ -232,7 +233,7 @@ func (s *Service) DeleteCommentFile(commentUID, fileUID uuid.UUID) (err error) {
 		return dto.NotFoundErr("файл не найден")
 	}
 
-	err = s.storage.Delete(file.UUID)
+	err = s.storage.Trash(file.UUID)
 
 	return err
 }
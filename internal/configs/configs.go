@@ -69,6 +69,17 @@ type Configs struct {
 	MIGRATE_FOLDER string `env:"MIGRATE_FOLDER" envDefault:"./migrations"`
 	RATE_LIMITER   int    `env:"RATE_LIMITER" envDefault:"20"`
 
+	RECURRING_TASKS_INTERVAL int `env:"RECURRING_TASKS_INTERVAL" envDefault:"60"`
+
+	DEADLINE_ESCALATION_INTERVAL int `env:"DEADLINE_ESCALATION_INTERVAL" envDefault:"60"`
+
+	TRASH_RETENTION_DAYS int `env:"TRASH_RETENTION_DAYS" envDefault:"30"`
+	TRASH_PURGE_INTERVAL int `env:"TRASH_PURGE_INTERVAL" envDefault:"3600"`
+
+	AUTOMATION_DUE_INTERVAL int `env:"AUTOMATION_DUE_INTERVAL" envDefault:"60"`
+
+	ESTIMATE_SNAPSHOT_INTERVAL int `env:"ESTIMATE_SNAPSHOT_INTERVAL" envDefault:"3600"`
+
 	// Sentry
 	SENTRY_DSN    string `env:"SENTRY_DSN" secured:"true"`
 	SENTRY_ENABLE bool   `env:"SENTRY_ENABLE" envDefault:"false"`
@@ -318,6 +318,14 @@ func (s *Service) SyncProjects() error {
 				continue
 			}
 
+			fieldsSort := []string{}
+			if i.FieldsSort != "" {
+				err = json.Unmarshal([]byte(i.FieldsSort), &fieldsSort)
+				if err != nil {
+					logrus.Error("Error on unmarshal fields sort: ", err)
+				}
+			}
+
 			s.projectsByUUID[i.UUID] = dto.ProjectDTO{
 				UUID:           i.UUID,
 				Name:           i.Name,
@@ -325,6 +333,7 @@ func (s *Service) SyncProjects() error {
 				FederationUUID: i.FederationUUID,
 				StatusGraph:    &graph,
 				Options:        &options,
+				FieldsSort:     fieldsSort,
 			}
 		}
 
@@ -91,6 +91,7 @@ type Project struct {
 
 	StatusGraph string `gorm:"type:jsonb;not null"`
 	Options     string `gorm:"type:jsonb;not null"`
+	FieldsSort  string `gorm:"type:jsonb;not null"`
 
 	DeletedAt *time.Time `gorm:"type:timestamptz;"`
 }
@@ -84,3 +84,11 @@ func parseTemplate(name, templateString string, data interface{}) (string, error
 
 	return buf.String(), nil
 }
+
+// NewTextMessage - plain message with the given subject and body.
+func NewTextMessage(subject, body string) IMessage {
+	return Message{
+		subject: subject,
+		body:    body,
+	}
+}
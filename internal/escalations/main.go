@@ -0,0 +1,95 @@
+package escalations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/notifications"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/sirupsen/logrus"
+)
+
+const lockKey = "escalations:lock"
+
+// sentTTL - how long the sent level is remembered, seconds. It is longer than any level can be reached.
+const sentTTL = 2 * (domain.EscalationMaxOffset*60 + int(domain.EscalationLookback/time.Second))
+
+type Service struct {
+	ts   *task.Service
+	dict *dictionary.Service
+	ns   *notifications.Service
+	rds  *redis.RDS
+}
+
+func New(ts *task.Service, dict *dictionary.Service, ns *notifications.Service, rds *redis.RDS) *Service {
+	return &Service{
+		ts:   ts,
+		dict: dict,
+		ns:   ns,
+		rds:  rds,
+	}
+}
+
+// Escalate notifies task people about reached deadline levels. The tick runs on one instance only:
+// it is guarded by redis lock for the interval, and every level is sent once for the task deadline.
+func (s *Service) Escalate(ctx context.Context, now time.Time, interval time.Duration) (sent int, err error) {
+	locked, err := s.rds.SetNX(ctx, lockKey, now.Format(time.RFC3339), int(interval/time.Second))
+	if err != nil || !locked {
+		return sent, err
+	}
+
+	maxOffset := time.Duration(domain.EscalationMaxOffset) * time.Minute
+
+	err = s.ts.EachDueTask(now.Add(-maxOffset-domain.EscalationLookback), now.Add(maxOffset), func(t domain.Task) error {
+		for _, level := range s.levels(t.ProjectUUID) {
+			if !level.Reached(*t.FinishTo, now) {
+				continue
+			}
+
+			ok, err := s.escalate(ctx, t, level)
+			if err != nil {
+				logrus.WithField("task", t.UUID).Error("task escalation error: ", err)
+				continue
+			}
+
+			if ok {
+				sent++
+			}
+		}
+
+		return nil
+	})
+
+	return sent, err
+}
+
+func (s *Service) escalate(ctx context.Context, t domain.Task, level domain.EscalationLevel) (bool, error) {
+	people := level.People(t)
+	if len(people) == 0 {
+		return false, nil
+	}
+
+	// deadline is a part of the key, so the moved deadline is escalated again
+	key := fmt.Sprintf("escalations:%s:%d:%d", t.UUID, level.Offset, t.FinishTo.Unix())
+
+	first, err := s.rds.SetNX(ctx, key, "1", sentTTL)
+	if err != nil || !first {
+		return false, err
+	}
+
+	return true, s.ns.CreateDeadlineState(t.UUID, level.Kind(), *t.FinishTo, people)
+}
+
+func (s *Service) levels(projectUUID uuid.UUID) []domain.EscalationLevel {
+	project, ok := s.dict.FindProject(projectUUID)
+	if ok && project.Options != nil && project.Options.Escalations != nil {
+		return *project.Options.Escalations
+	}
+
+	return domain.DefaultEscalationLevels()
+}
@@ -0,0 +1,49 @@
+package estimates
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type Service struct {
+	repo *Repository
+}
+
+func New(repo *Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+// Snapshot saves estimated work of projects for the day of the moment, returns the number of projects.
+func (s *Service) Snapshot(now time.Time) (int64, error) {
+	return s.repo.Snapshot(now.UTC())
+}
+
+// GetSeries returns work of the project by days, it is the base of burndown and burnup charts.
+func (s *Service) GetSeries(projectUUID uuid.UUID, from, to time.Time) (points []domain.BurnPoint, err error) {
+	from, to = from.UTC(), to.UTC()
+
+	if to.Before(from) {
+		return points, errors.New("начало периода должно быть раньше конца")
+	}
+
+	if to.Sub(from) > domain.MaxBurnDays*24*time.Hour {
+		return points, errors.New("период не может быть больше года")
+	}
+
+	snapshots, err := s.repo.GetSnapshots(projectUUID, from, to)
+	if err != nil {
+		return points, err
+	}
+
+	tasks, err := s.repo.GetEstimatedTasks(projectUUID)
+	if err != nil {
+		return points, err
+	}
+
+	return domain.BurnSeries(from, to, snapshots, tasks), nil
+}
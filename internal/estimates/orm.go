@@ -0,0 +1,35 @@
+package estimates
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type ProjectSnapshot struct {
+	UUID        uuid.UUID `gorm:"<-:create;type:uuid;primary_key"`
+	ProjectUUID uuid.UUID `gorm:"<-:create;type:uuid"`
+	Date        time.Time `gorm:"type:date"`
+
+	Total     float64 `gorm:"type:numeric"`
+	Completed float64 `gorm:"type:numeric"`
+	Remaining float64 `gorm:"type:numeric"`
+
+	TasksTotal int `gorm:"type:int"`
+	TasksDone  int `gorm:"type:int"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz"`
+}
+
+// estimatedTask - the task with estimate and status changes, stops are stored by the task package without json tags.
+type estimatedTask struct {
+	UUID     uuid.UUID
+	Estimate float64
+	Stops    datatypes.JSON
+}
+
+type stop struct {
+	CreatedAt time.Time
+	StatusID  int
+}
@@ -0,0 +1,118 @@
+package estimates
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/samber/lo"
+)
+
+// snapshotSQL saves estimated work of all projects for the day, the snapshot of the day is overwritten.
+const snapshotSQL = `
+	INSERT INTO project_snapshots (project_uuid, date, total, completed, remaining, tasks_total, tasks_done)
+	SELECT project_uuid, @date::date,
+		COALESCE(SUM(estimate) FILTER (WHERE status <> @cancel), 0),
+		COALESCE(SUM(estimate) FILTER (WHERE status = @done), 0),
+		COALESCE(SUM(estimate) FILTER (WHERE status NOT IN (@done, @cancel)), 0),
+		COUNT(*) FILTER (WHERE status <> @cancel),
+		COUNT(*) FILTER (WHERE status = @done)
+	FROM tasks
+	WHERE deleted_at IS NULL AND estimate IS NOT NULL
+	GROUP BY project_uuid
+	ON CONFLICT (project_uuid, date) DO UPDATE SET
+		total = EXCLUDED.total,
+		completed = EXCLUDED.completed,
+		remaining = EXCLUDED.remaining,
+		tasks_total = EXCLUDED.tasks_total,
+		tasks_done = EXCLUDED.tasks_done,
+		created_at = now()`
+
+// snapshotsSQL - snapshots of the period and the last one before it.
+const snapshotsSQL = `
+	SELECT * FROM project_snapshots
+	WHERE project_uuid = @project
+	  AND date <= @to::date
+	  AND date >= COALESCE((SELECT max(date) FROM project_snapshots WHERE project_uuid = @project AND date <= @from::date), @from::date)
+	ORDER BY date`
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Snapshot(day time.Time) (total int64, err error) {
+	res := r.gorm.DB.Exec(snapshotSQL, map[string]interface{}{
+		"date":   day.Format(time.DateOnly),
+		"done":   domain.StatusDone,
+		"cancel": domain.StatusCancel,
+	})
+
+	return res.RowsAffected, res.Error
+}
+
+func (r *Repository) GetSnapshots(projectUUID uuid.UUID, from, to time.Time) (dms []domain.ProjectSnapshot, err error) {
+	orms := []ProjectSnapshot{}
+
+	err = r.gorm.DB.Raw(snapshotsSQL, map[string]interface{}{
+		"project": projectUUID,
+		"from":    from.Format(time.DateOnly),
+		"to":      to.Format(time.DateOnly),
+	}).Scan(&orms).Error
+
+	return lo.Map(orms, func(orm ProjectSnapshot, _ int) domain.ProjectSnapshot {
+		return domain.ProjectSnapshot{
+			ProjectUUID: orm.ProjectUUID,
+			Date:        orm.Date,
+			Total:       orm.Total,
+			Completed:   orm.Completed,
+			Remaining:   orm.Remaining,
+			TasksTotal:  orm.TasksTotal,
+			TasksDone:   orm.TasksDone,
+			CreatedAt:   orm.CreatedAt,
+		}
+	}), err
+}
+
+// GetEstimatedTasks returns estimated tasks of the project with status changes.
+func (r *Repository) GetEstimatedTasks(projectUUID uuid.UUID) (dms []domain.Task, err error) {
+	rows := []estimatedTask{}
+
+	err = r.gorm.DB.
+		Table("tasks").
+		Select("uuid, estimate, stops").
+		Where("project_uuid = ?", projectUUID).
+		Where("estimate IS NOT NULL").
+		Where("deleted_at IS NULL").
+		Scan(&rows).
+		Error
+	if err != nil {
+		return dms, err
+	}
+
+	for _, row := range rows {
+		stops := []stop{}
+
+		err = json.Unmarshal(row.Stops, &stops)
+		if err != nil {
+			return dms, err
+		}
+
+		dms = append(dms, domain.Task{
+			UUID:     row.UUID,
+			Estimate: lo.ToPtr(row.Estimate),
+			Stops: lo.Map(stops, func(s stop, _ int) domain.Stop {
+				return domain.Stop{CreatedAt: s.CreatedAt, StatusID: s.StatusID}
+			}),
+		})
+	}
+
+	return dms, nil
+}
@@ -0,0 +1,123 @@
+package exports
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+const timeLayout = "2006-01-02 15:04"
+
+type column struct {
+	title string
+	value func(t domain.Task) interface{}
+}
+
+// columns returns task attributes and project fields, people are shown by FIO and statuses by project names.
+func (s *Service) columns(project dto.ProjectDTO) []column {
+	statuses := make(map[int]string)
+	if project.Statuses != nil {
+		for _, st := range *project.Statuses {
+			statuses[st.Number] = st.Name
+		}
+	}
+
+	status := func(t domain.Task) interface{} {
+		if name, ok := statuses[t.Status]; ok {
+			return name
+		}
+
+		return strconv.Itoa(t.Status)
+	}
+
+	columns := []column{
+		{"№", func(t domain.Task) interface{} { return t.ID }},
+		{"Название", func(t domain.Task) interface{} { return t.Name }},
+		{"Статус", status},
+		{"Приоритет", func(t domain.Task) interface{} { return t.Priority }},
+		{"Теги", func(t domain.Task) interface{} { return strings.Join(t.Tags, ", ") }},
+		{"Автор", func(t domain.Task) interface{} { return s.fio(t.CreatedBy) }},
+		{"Исполнитель", func(t domain.Task) interface{} { return s.fio(t.ImplementBy) }},
+		{"Ответственный", func(t domain.Task) interface{} { return s.fio(t.ResponsibleBy) }},
+		{"Менеджер", func(t domain.Task) interface{} { return s.fio(t.ManagedBy) }},
+		{"Соисполнители", func(t domain.Task) interface{} { return s.fios(t.CoWorkersBy) }},
+		{"Наблюдатели", func(t domain.Task) interface{} { return s.fios(t.WatchBy) }},
+		{"Срок", func(t domain.Task) interface{} { return formatTime(t.FinishTo) }},
+		{"Создана", func(t domain.Task) interface{} { return formatTime(&t.CreatedAt) }},
+		{"Завершена", func(t domain.Task) interface{} { return formatTime(t.FinishedAt) }},
+		{"Завершил", func(t domain.Task) interface{} { return s.fio(t.FinishedBy) }},
+		{"Затрачено, ч", func(t domain.Task) interface{} { return float64(t.Duration) / 3600 }},
+		{"Описание", func(t domain.Task) interface{} { return t.Description }},
+	}
+
+	fields, _ := s.dict.FindProjectFields(project.UUID)
+	for _, f := range fields {
+		field := f
+
+		columns = append(columns, column{field.Name, func(t domain.Task) interface{} {
+			v, ok := t.Fields[field.Hash]
+			if !ok || v == nil {
+				return nil
+			}
+
+			return s.fieldValue(domain.FieldDataType(field.DataType), v)
+		}})
+	}
+
+	return columns
+}
+
+func (s *Service) fieldValue(dataType domain.FieldDataType, v interface{}) interface{} {
+	items, isList := v.([]interface{})
+	if !isList {
+		if strs, ok := v.([]string); ok {
+			items, isList = lo.ToAnySlice(strs), true
+		}
+	}
+
+	if !isList {
+		return v
+	}
+
+	values := lo.Map(items, func(item interface{}, _ int) string {
+		return fmt.Sprintf("%v", item)
+	})
+
+	if dataType == domain.People {
+		return s.fios(values)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func (s *Service) fio(email string) string {
+	if email == "" {
+		return ""
+	}
+
+	user, ok := s.dict.FindUser(email)
+	if !ok {
+		return email
+	}
+
+	return user.FIO()
+}
+
+func (s *Service) fios(emails []string) string {
+	return strings.Join(lo.Map(emails, func(email string, _ int) string {
+		return s.fio(email)
+	}), ", ")
+}
+
+func formatTime(t *time.Time) interface{} {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+
+	return t.Format(timeLayout)
+}
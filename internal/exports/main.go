@@ -0,0 +1,132 @@
+package exports
+
+import (
+	"io"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	repo    *Repository
+	ts      *task.Service
+	dict    *dictionary.Service
+	storage *s3.ServicePrivate
+}
+
+func New(repo *Repository, ts *task.Service, dict *dictionary.Service, storage *s3.ServicePrivate) *Service {
+	return &Service{
+		repo:    repo,
+		ts:      ts,
+		dict:    dict,
+		storage: storage,
+	}
+}
+
+// Write streams tasks found by the filter to w, tasks are read from the database one by one.
+func (s *Service) Write(project dto.ProjectDTO, filter dto.TaskSearchDTO, format string, w io.Writer) (count int, err error) {
+	rw, err := newRowWriter(format, w)
+	if err != nil {
+		return count, err
+	}
+
+	columns := s.columns(project)
+
+	err = rw.Header(lo.Map(columns, func(c column, _ int) string { return c.title }))
+	if err != nil {
+		return count, err
+	}
+
+	err = s.ts.EachTask(filter, func(t domain.Task) error {
+		count++
+
+		return rw.Row(lo.Map(columns, func(c column, _ int) interface{} { return c.value(t) }))
+	})
+	if err != nil {
+		return count, err
+	}
+
+	return count, rw.Close()
+}
+
+// Start runs export in background, the file is stored in private s3.
+func (s *Service) Start(project dto.ProjectDTO, filter dto.TaskSearchDTO, format string, userUUID uuid.UUID) (e domain.TaskExport, err error) {
+	e, err = domain.NewTaskExport(project.FederationUUID, project.UUID, userUUID, format)
+	if err != nil {
+		return e, err
+	}
+
+	err = s.repo.Create(e)
+	if err != nil {
+		return e, err
+	}
+
+	go s.run(e, project, filter)
+
+	return e, nil
+}
+
+// Get returns export and link to the file when it is ready.
+func (s *Service) Get(uid, userUUID uuid.UUID) (e domain.TaskExport, url string, err error) {
+	e, err = s.repo.Get(uid)
+	if err != nil {
+		return e, url, err
+	}
+
+	if e.CreatedByUUID != userUUID {
+		return e, url, domain.ErrExportForbidden
+	}
+
+	if e.FileUUID != nil {
+		url, err = s.storage.PresignedURLFromFile(*e.FileUUID)
+	}
+
+	return e, url, err
+}
+
+func (s *Service) run(e domain.TaskExport, project dto.ProjectDTO, filter dto.TaskSearchDTO) {
+	fileUUID, count, err := s.store(e, project, filter)
+	if err != nil {
+		logrus.WithField("uuid", e.UUID).Error("task export error: ", err)
+	}
+
+	e.Finish(fileUUID, count, err)
+
+	err = s.repo.Finish(e)
+	if err != nil {
+		logrus.WithField("uuid", e.UUID).Error("task export finish error: ", err)
+	}
+}
+
+func (s *Service) store(e domain.TaskExport, project dto.ProjectDTO, filter dto.TaskSearchDTO) (fileUUID *uuid.UUID, count int, err error) {
+	f, err := os.CreateTemp("", "export-*."+e.Format)
+	if err != nil {
+		return nil, count, err
+	}
+	defer os.Remove(f.Name())
+
+	count, err = s.Write(project, filter, e.Format, f)
+	if err != nil {
+		f.Close()
+		return nil, count, err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return nil, count, err
+	}
+
+	file, err := s.storage.UploadExportFile(e.FederationUUID, e.UUID, e.FileName(), f.Name(), e.CreatedByUUID)
+	if err != nil {
+		return nil, count, err
+	}
+
+	return &file.UUID, count, nil
+}
@@ -0,0 +1,23 @@
+package exports
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TaskExport struct {
+	UUID           uuid.UUID `gorm:"<-:create;type:uuid;primary_key"`
+	FederationUUID uuid.UUID `gorm:"<-:create;type:uuid"`
+	ProjectUUID    uuid.UUID `gorm:"<-:create;type:uuid"`
+	CreatedByUUID  uuid.UUID `gorm:"<-:create;type:uuid"`
+
+	Format   string     `gorm:"<-:create;type:varchar(10)"`
+	Status   string     `gorm:"type:varchar(20)"`
+	FileUUID *uuid.UUID `gorm:"type:uuid"`
+	Count    int        `gorm:"type:integer"`
+	Error    string     `gorm:"type:text"`
+
+	CreatedAt  time.Time  `gorm:"<-:create;type:timestamptz"`
+	FinishedAt *time.Time `gorm:"type:timestamptz"`
+}
@@ -0,0 +1,86 @@
+package exports
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/pkg/postgres"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Create(dm domain.TaskExport) error {
+	orm := toORM(dm)
+
+	return r.gorm.DB.Create(&orm).Error
+}
+
+func (r *Repository) Get(uid uuid.UUID) (dm domain.TaskExport, err error) {
+	orm := TaskExport{}
+
+	res := r.gorm.DB.Where("uuid = ?", uid).Find(&orm)
+
+	if res.Error != nil {
+		return dm, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dm, dto.NotFoundErr("выгрузка не найдена")
+	}
+
+	return toDomain(orm), nil
+}
+
+func (r *Repository) Finish(dm domain.TaskExport) error {
+	return r.gorm.DB.
+		Model(&TaskExport{}).
+		Where("uuid = ?", dm.UUID).
+		Updates(map[string]interface{}{
+			"status":      dm.Status,
+			"file_uuid":   dm.FileUUID,
+			"count":       dm.Count,
+			"error":       dm.Error,
+			"finished_at": dm.FinishedAt,
+		}).
+		Error
+}
+
+func toORM(dm domain.TaskExport) TaskExport {
+	return TaskExport{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		CreatedByUUID:  dm.CreatedByUUID,
+		Format:         dm.Format,
+		Status:         dm.Status,
+		FileUUID:       dm.FileUUID,
+		Count:          dm.Count,
+		Error:          dm.Error,
+		CreatedAt:      dm.CreatedAt,
+		FinishedAt:     dm.FinishedAt,
+	}
+}
+
+func toDomain(orm TaskExport) domain.TaskExport {
+	return domain.TaskExport{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		ProjectUUID:    orm.ProjectUUID,
+		CreatedByUUID:  orm.CreatedByUUID,
+		Format:         orm.Format,
+		Status:         orm.Status,
+		FileUUID:       orm.FileUUID,
+		Count:          orm.Count,
+		Error:          orm.Error,
+		CreatedAt:      orm.CreatedAt,
+		FinishedAt:     orm.FinishedAt,
+	}
+}
@@ -0,0 +1,151 @@
+package exports
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/xuri/excelize/v2"
+)
+
+type rowWriter interface {
+	Header(titles []string) error
+	Row(values []interface{}) error
+	Close() error
+}
+
+func newRowWriter(format string, w io.Writer) (rowWriter, error) {
+	switch format {
+	case domain.ExportCSV:
+		return newCSVWriter(w)
+	case domain.ExportXLSX:
+		return newXLSXWriter(w)
+	case domain.ExportNDJSON:
+		return &ndjsonWriter{enc: json.NewEncoder(w)}, nil
+	}
+
+	return nil, domain.ErrExportInvalidFormat
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	// BOM, so excel opens utf-8 file with cyrillic
+	_, err := io.WriteString(w, "\ufeff")
+	if err != nil {
+		return nil, err
+	}
+
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+func (c *csvWriter) Header(titles []string) error {
+	return c.w.Write(titles)
+}
+
+func (c *csvWriter) Row(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		record[i] = cellString(v)
+	}
+
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+
+	return c.w.Error()
+}
+
+// xlsxWriter uses excelize stream writer, rows are kept on disk till the file is written.
+type xlsxWriter struct {
+	out io.Writer
+	f   *excelize.File
+	sw  *excelize.StreamWriter
+	row int
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	f := excelize.NewFile()
+
+	sw, err := f.NewStreamWriter("Sheet1")
+	if err != nil {
+		return nil, err
+	}
+
+	return &xlsxWriter{out: w, f: f, sw: sw}, nil
+}
+
+func (x *xlsxWriter) Header(titles []string) error {
+	values := make([]interface{}, len(titles))
+	for i, t := range titles {
+		values[i] = t
+	}
+
+	return x.Row(values)
+}
+
+func (x *xlsxWriter) Row(values []interface{}) error {
+	x.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+
+	return x.sw.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.f.Close()
+
+	err := x.sw.Flush()
+	if err != nil {
+		return err
+	}
+
+	return x.f.Write(x.out)
+}
+
+type ndjsonWriter struct {
+	enc    *json.Encoder
+	titles []string
+}
+
+func (n *ndjsonWriter) Header(titles []string) error {
+	n.titles = titles
+
+	return nil
+}
+
+func (n *ndjsonWriter) Row(values []interface{}) error {
+	item := make(map[string]interface{}, len(values))
+	for i, v := range values {
+		item[n.titles[i]] = v
+	}
+
+	return n.enc.Encode(item)
+}
+
+func (n *ndjsonWriter) Close() error {
+	return nil
+}
+
+func cellString(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,107 @@
+package exports
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/xuri/excelize/v2"
+)
+
+func write(t *testing.T, format string) *bytes.Buffer {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+
+	w, err := newRowWriter(format, buf)
+	if err != nil {
+		t.Fatalf("newRowWriter(%v) error = %v", format, err)
+	}
+
+	err = w.Header([]string{"№", "Название", "Срок", "Затрачено, ч"})
+	if err != nil {
+		t.Fatalf("Header() error = %v", err)
+	}
+
+	err = w.Row([]interface{}{7, "Отчет, \"итоги\"", nil, 1.5})
+	if err != nil {
+		t.Fatalf("Row() error = %v", err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	return buf
+}
+
+func TestRowWriter(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{
+			format: domain.ExportCSV,
+			want:   "\ufeff№,Название,Срок,\"Затрачено, ч\"\n7,\"Отчет, \"\"итоги\"\"\",,1.5\n",
+		},
+		{
+			format: domain.ExportNDJSON,
+			want:   `{"Затрачено, ч":1.5,"Название":"Отчет, \"итоги\"","Срок":null,"№":7}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := write(t, tt.format).String(); got != tt.want {
+				t.Errorf("writer = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run(domain.ExportXLSX, func(t *testing.T) {
+		f, err := excelize.OpenReader(write(t, domain.ExportXLSX))
+		if err != nil {
+			t.Fatalf("OpenReader() error = %v", err)
+		}
+		defer f.Close()
+
+		rows, err := f.GetRows("Sheet1")
+		if err != nil || len(rows) != 2 {
+			t.Fatalf("GetRows() = %v, %v, want 2 rows", rows, err)
+		}
+
+		if rows[0][1] != "Название" || rows[1][0] != "7" || rows[1][1] != "Отчет, \"итоги\"" || rows[1][3] != "1.5" {
+			t.Errorf("GetRows() = %v", rows)
+		}
+	})
+
+	if _, err := newRowWriter("pdf", &bytes.Buffer{}); err != domain.ErrExportInvalidFormat {
+		t.Errorf("newRowWriter(pdf) error = %v, want %v", err, domain.ErrExportInvalidFormat)
+	}
+}
+
+func TestCellFormat(t *testing.T) {
+	at := time.Date(2026, 10, 17, 9, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{name: "empty", v: nil, want: ""},
+		{name: "float without zeros", v: 0.25, want: "0.25"},
+		{name: "int", v: 12, want: "12"},
+		{name: "time", v: formatTime(&at), want: "2026-10-17 09:05"},
+		{name: "zero time", v: formatTime(&time.Time{}), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cellString(tt.v); got != tt.want {
+				t.Errorf("cellString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
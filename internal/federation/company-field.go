@@ -1,6 +1,8 @@
 package federation
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
@@ -8,6 +10,11 @@ import (
 )
 
 func (s *Service) CreateCompanyField(cf *domain.CompanyField) (items dto.CompanyFieldDTO, err error) {
+	err = s.checkFormula(cf)
+	if err != nil {
+		return items, err
+	}
+
 	orm, err := s.repo.CreateCompanyField(cf)
 	if err != nil {
 		return items, err
@@ -20,9 +27,35 @@ func (s *Service) CreateCompanyField(cf *domain.CompanyField) (items dto.Company
 		DataType:    orm.DataType,
 		Hash:        orm.Hash,
 		Icon:        orm.Icon,
+		Formula:     orm.Formula,
 	}, err
 }
 
+// checkFormula type-checks the formula against other fields of the company, only formula fields have it.
+func (s *Service) checkFormula(cf *domain.CompanyField) error {
+	if cf.DataType != domain.Formula {
+		if cf.Formula != "" {
+			return errors.New("формула задается только для поля с типом formula")
+		}
+
+		return nil
+	}
+
+	fields, err := s.repo.GetCompanyFields(cf.CompanyUUID)
+	if err != nil {
+		return err
+	}
+
+	types := make(map[string]domain.FieldDataType, len(fields))
+	for _, f := range fields {
+		types[f.Hash] = f.DataType
+	}
+
+	_, err = domain.ParseFormula(cf.Formula, types)
+
+	return err
+}
+
 func (s *Service) PutCompanyField(pf *domain.CompanyField) error {
 	return s.repo.PutCompanyField(pf)
 }
@@ -45,6 +78,7 @@ func (s *Service) GetProjectFields(uid uuid.UUID) (items []domain.CompanyField,
 			CompanyUUID:        item.CompanyUUID,
 			RequiredOnStatuses: item.RequiredOnStatuses,
 			Style:              item.Style,
+			Formula:            item.Formula,
 		}
 	})
 
@@ -94,6 +94,7 @@ type CompanyFields struct {
 	Icon        string    `gorm:"type:varchar(50);not null;"`
 	DataType    int       `gorm:"type:int;not null;default:0"`
 	CompanyUUID uuid.UUID `gorm:"type:uuid;not null"`
+	Formula     string    `gorm:"type:text;not null;default:''"`
 
 	ProjectUUID JSONArray `gorm:"->;type:jsonb;default:'[]';not null;column:project_uuids"`
 
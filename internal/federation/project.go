@@ -244,6 +244,18 @@ func (s *Service) ChangeProjectStatus(uid uuid.UUID, sg *domain.StatusGraph) (mp
 		return make(map[string][]string), err
 	}
 
+	statuses, err := s.GetProjectStatuses(uid)
+	if err != nil {
+		return mp, err
+	}
+
+	issues := sg.Analyze(lo.Map(statuses, func(st domain.ProjectStatus, _ int) int {
+		return st.Number
+	}))
+	if len(issues) > 0 {
+		return mp, domain.StatusGraphError{Issues: issues}
+	}
+
 	err = s.repo.ChangeProjectField(uid, "status_graph", sg.Graph)
 
 	return sg.Graph, err
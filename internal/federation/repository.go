@@ -283,6 +283,7 @@ func (r *Repository) CreateCompanyField(cf *domain.CompanyField) (orm CompanyFie
 			DataType:    int(cf.DataType),
 			Hash:        helpers.IntToLetters(company.FieldLastName + 1),
 			CompanyUUID: cf.CompanyUUID,
+			Formula:     cf.Formula,
 		}
 
 		err = tx.Create(&orm).Error
@@ -331,7 +332,7 @@ func (r *Repository) GetProjectFields(projectUUID uuid.UUID) (orm []CompanyField
 	orm = []CompanyFields{}
 
 	r.gorm.DB.Model(&orm).
-		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, pf.style, pf.required_on_statuses").
+		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, company_fields.formula, pf.style, pf.required_on_statuses").
 		Joins("left join project_fields pf on pf.company_field_uuid = company_fields.uuid").
 		Where("pf.project_uuid = ?", projectUUID).
 		Where("company_fields.deleted_at is null").
@@ -350,7 +351,7 @@ func (r *Repository) GetCompanyFields(companyUUID uuid.UUID) (dmns []domain.Comp
 
 	// Company Fields
 	res := r.gorm.DB.Model(&orm).
-		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, COALESCE(json_agg(distinct pf.project_uuid) FILTER (WHERE pf.project_uuid IS NOT NULL), '[]' ) as project_uuids,"+
+		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, company_fields.formula, COALESCE(json_agg(distinct pf.project_uuid) FILTER (WHERE pf.project_uuid IS NOT NULL), '[]' ) as project_uuids,"+
 			"count(*) as tasks_total,"+
 			"count(*) FILTER (WHERE t.fields->>company_fields.hash is not null) as tasks_filled,"+
 			"count(*) FILTER (WHERE t.fields->>company_fields.hash is not null and t.finished_at is null) as tasks_active_filled",
@@ -360,7 +361,7 @@ func (r *Repository) GetCompanyFields(companyUUID uuid.UUID) (dmns []domain.Comp
 		Where("company_fields.company_uuid", companyUUID).
 		Joins("left join project_fields pf on pf.company_field_uuid = company_fields.uuid").
 		Joins("left join tasks t on t.project_uuid = pf.project_uuid ").
-		Group("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.hash, company_fields.data_type, pf.style, pf.required_on_statuses").
+		Group("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.hash, company_fields.data_type, company_fields.formula, pf.style, pf.required_on_statuses").
 		Find(&orm)
 	if res.Error != nil {
 		return dmns, res.Error
@@ -375,6 +376,7 @@ func (r *Repository) GetCompanyFields(companyUUID uuid.UUID) (dmns []domain.Comp
 			Icon:        item.Icon,
 			DataType:    domain.FieldDataType(item.DataType),
 			CompanyUUID: item.CompanyUUID,
+			Formula:     item.Formula,
 			ProjectUUID: lo.Map(item.ProjectUUID, func(uid any, index int) uuid.UUID {
 				return uuid.MustParse(uid.(string))
 			}),
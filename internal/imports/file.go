@@ -0,0 +1,60 @@
+package imports
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// readRecords reads rows of csv (comma or semicolon separated) or the first sheet of xlsx.
+func readRecords(fileName string, r io.Reader) (records [][]string, err error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		br := bufio.NewReader(r)
+
+		first, err := br.Peek(4096)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+			return records, err
+		}
+
+		line, _, _ := strings.Cut(string(first), "\n")
+
+		reader := csv.NewReader(br)
+		reader.FieldsPerRecord = -1
+		reader.LazyQuotes = true
+
+		if strings.Count(line, ";") > strings.Count(line, ",") {
+			reader.Comma = ';'
+		}
+
+		records, err = reader.ReadAll()
+		if err != nil {
+			return records, err
+		}
+	case ".xlsx":
+		f, err := excelize.OpenReader(r)
+		if err != nil {
+			return records, err
+		}
+		defer f.Close()
+
+		records, err = f.GetRows(f.GetSheetName(0))
+		if err != nil {
+			return records, err
+		}
+	default:
+		return records, errors.New("поддерживаются только файлы csv и xlsx")
+	}
+
+	// excel adds BOM to utf-8 csv
+	if len(records) > 0 && len(records[0]) > 0 {
+		records[0][0] = strings.TrimPrefix(records[0][0], "\ufeff")
+	}
+
+	return records, nil
+}
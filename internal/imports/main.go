@@ -0,0 +1,214 @@
+package imports
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const batchSize = 200
+
+type Service struct {
+	repo *Repository
+	ts   *task.Service
+	dict *dictionary.Service
+}
+
+func New(repo *Repository, ts *task.Service, dict *dictionary.Service) *Service {
+	return &Service{
+		repo: repo,
+		ts:   ts,
+		dict: dict,
+	}
+}
+
+// Upload parses csv or xlsx file and suggests mapping of columns by their titles.
+func (s *Service) Upload(crtr domain.Creator, project dto.ProjectDTO, fileName string, r io.Reader) (imp domain.TaskImport, err error) {
+	records, err := readRecords(fileName, r)
+	if err != nil {
+		return imp, err
+	}
+
+	imp, err = domain.NewTaskImport(crtr, project.FederationUUID, project.CompanyUUID, project.UUID, fileName, records)
+	if err != nil {
+		return imp, err
+	}
+
+	imp.Mapping = domain.SuggestImportMapping(imp.Header, s.fields(project.UUID))
+
+	return imp, s.repo.Create(imp)
+}
+
+func (s *Service) Get(uid, userUUID uuid.UUID) (imp domain.TaskImport, err error) {
+	imp, err = s.repo.Get(uid)
+	if err != nil {
+		return imp, err
+	}
+
+	if imp.CreatedByUUID != userUUID {
+		return imp, domain.ErrImportForbidden
+	}
+
+	return imp, nil
+}
+
+// Check is a dry run: every row is validated with the mapping, errors are stored by row.
+func (s *Service) Check(uid, userUUID uuid.UUID, mapping map[int]string) (imp domain.TaskImport, err error) {
+	imp, err = s.Get(uid, userUUID)
+	if err != nil {
+		return imp, err
+	}
+
+	if imp.Status != domain.ImportUploaded && imp.Status != domain.ImportChecked {
+		return imp, domain.ErrImportStarted
+	}
+
+	fields := s.fields(imp.ProjectUUID)
+
+	err = imp.ValidateMapping(mapping, fields)
+	if err != nil {
+		return imp, err
+	}
+
+	imp.Mapping = mapping
+	imp.Errors = []domain.ImportRowError{}
+
+	for i := range imp.Rows {
+		_, err := s.rowTask(imp, i, fields)
+		if err != nil {
+			imp.Errors = append(imp.Errors, domain.ImportRowError{Row: i + 2, Error: err.Error()})
+		}
+	}
+
+	imp.Status = domain.ImportChecked
+
+	return imp, s.repo.Update(imp)
+}
+
+// Start locks checked import for the run, so it can not be committed twice.
+func (s *Service) Start(uid, userUUID uuid.UUID) (imp domain.TaskImport, err error) {
+	imp, err = s.Get(uid, userUUID)
+	if err != nil {
+		return imp, err
+	}
+
+	ok, err := s.repo.SetStatus(uid, domain.ImportChecked, domain.ImportRunning)
+	if err != nil {
+		return imp, err
+	}
+
+	if !ok {
+		return imp, lo.Ternary(imp.Status == domain.ImportUploaded, domain.ErrImportNotChecked, domain.ErrImportStarted)
+	}
+
+	imp.Status = domain.ImportRunning
+
+	return imp, nil
+}
+
+// Run creates tasks of valid rows in batches and reports progress after every batch, rows with errors are skipped.
+// Progress channel is closed when the import is finished.
+func (s *Service) Run(imp domain.TaskImport, progress chan<- dto.TaskImportProgressDTO) {
+	defer close(progress)
+
+	fields := s.fields(imp.ProjectUUID)
+
+	invalid := lo.SliceToMap(imp.Errors, func(item domain.ImportRowError) (int, bool) {
+		return item.Row - 2, true
+	})
+
+	state := dto.TaskImportProgressDTO{
+		Status: domain.ImportRunning,
+		Total:  len(imp.Rows),
+	}
+
+	for _, chunk := range lo.Chunk(lo.Range(len(imp.Rows)), batchSize) {
+		batch := []domain.Task{}
+
+		for _, i := range chunk {
+			if invalid[i] {
+				state.Skipped++
+				continue
+			}
+
+			t, err := s.rowTask(imp, i, fields)
+			if err != nil {
+				imp.Errors = append(imp.Errors, domain.ImportRowError{Row: i + 2, Error: err.Error()})
+				state.Skipped++
+				continue
+			}
+
+			batch = append(batch, t)
+		}
+
+		if len(batch) > 0 {
+			err := s.ts.CreateTaskBatch(imp.CreatedBy, batch)
+			if err != nil {
+				logrus.WithField("uuid", imp.UUID).Error("task import error: ", err)
+
+				state.Status = domain.ImportFailed
+				state.Error = err.Error()
+
+				s.finish(imp, state)
+				progress <- state
+
+				return
+			}
+		}
+
+		state.Created += len(batch)
+		progress <- state
+	}
+
+	state.Status = domain.ImportDone
+
+	s.finish(imp, state)
+	progress <- state
+}
+
+func (s *Service) finish(imp domain.TaskImport, state dto.TaskImportProgressDTO) {
+	imp.Status = state.Status
+	imp.Created = state.Created
+
+	err := s.repo.Update(imp)
+	if err != nil {
+		logrus.WithField("uuid", imp.UUID).Error("task import update error: ", err)
+	}
+}
+
+// rowTask builds the task and validates it like a task created by hand.
+func (s *Service) rowTask(imp domain.TaskImport, i int, fields []domain.ImportField) (t domain.Task, err error) {
+	t, err = imp.RowTask(i, fields)
+	if err != nil {
+		return t, err
+	}
+
+	for _, email := range t.People {
+		if _, ok := s.dict.FindUser(email); !ok {
+			return t, fmt.Errorf("пользователь не найден: %s", email)
+		}
+	}
+
+	t.Fields, err = s.ts.FilterTaskFields(t)
+
+	return t, err
+}
+
+func (s *Service) fields(projectUUID uuid.UUID) []domain.ImportField {
+	fields, _ := s.dict.FindProjectFields(projectUUID)
+
+	return lo.Map(fields, func(f dto.ProjectFieldDTO, _ int) domain.ImportField {
+		return domain.ImportField{
+			Hash:     f.Hash,
+			Name:     f.Name,
+			DataType: domain.FieldDataType(f.DataType),
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package imports
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type TaskImport struct {
+	UUID           uuid.UUID `gorm:"<-:create;type:uuid;primary_key"`
+	FederationUUID uuid.UUID `gorm:"<-:create;type:uuid"`
+	CompanyUUID    uuid.UUID `gorm:"<-:create;type:uuid"`
+	ProjectUUID    uuid.UUID `gorm:"<-:create;type:uuid"`
+	CreatedBy      string    `gorm:"<-:create;type:varchar(100)"`
+	CreatedByUUID  uuid.UUID `gorm:"<-:create;type:uuid"`
+
+	FileName string         `gorm:"<-:create;type:varchar(255)"`
+	Header   datatypes.JSON `gorm:"<-:create;type:jsonb"`
+	Rows     datatypes.JSON `gorm:"<-:create;type:jsonb"`
+	Mapping  datatypes.JSON `gorm:"type:jsonb"`
+	Status   string         `gorm:"type:varchar(20)"`
+	Errors   datatypes.JSON `gorm:"type:jsonb"`
+	Created  int            `gorm:"type:integer"`
+
+	CreatedAt time.Time `gorm:"<-:create;type:timestamptz"`
+	UpdatedAt time.Time
+}
@@ -0,0 +1,149 @@
+package imports
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/sirupsen/logrus"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Create(dm domain.TaskImport) error {
+	orm, err := toORM(dm)
+	if err != nil {
+		return err
+	}
+
+	return r.gorm.DB.Create(&orm).Error
+}
+
+func (r *Repository) Get(uid uuid.UUID) (dm domain.TaskImport, err error) {
+	orm := TaskImport{}
+
+	res := r.gorm.DB.Where("uuid = ?", uid).Find(&orm)
+
+	if res.Error != nil {
+		return dm, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dm, dto.NotFoundErr("импорт не найден")
+	}
+
+	return toDomain(orm), nil
+}
+
+// Update stores mapping, status and results of the import.
+func (r *Repository) Update(dm domain.TaskImport) error {
+	orm, err := toORM(dm)
+	if err != nil {
+		return err
+	}
+
+	return r.gorm.DB.
+		Model(&TaskImport{}).
+		Where("uuid = ?", dm.UUID).
+		Updates(map[string]interface{}{
+			"mapping":    orm.Mapping,
+			"status":     orm.Status,
+			"errors":     orm.Errors,
+			"created":    orm.Created,
+			"updated_at": "now()",
+		}).
+		Error
+}
+
+// SetStatus changes status only from the expected one, false if import is in another status.
+func (r *Repository) SetStatus(uid uuid.UUID, from, to string) (bool, error) {
+	res := r.gorm.DB.
+		Model(&TaskImport{}).
+		Where("uuid = ?", uid).
+		Where("status = ?", from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": "now()",
+		})
+
+	return res.RowsAffected > 0, res.Error
+}
+
+func toORM(dm domain.TaskImport) (orm TaskImport, err error) {
+	header, err := json.Marshal(dm.Header)
+	if err != nil {
+		return orm, err
+	}
+
+	rows, err := json.Marshal(dm.Rows)
+	if err != nil {
+		return orm, err
+	}
+
+	mapping, err := json.Marshal(dm.Mapping)
+	if err != nil {
+		return orm, err
+	}
+
+	errs, err := json.Marshal(dm.Errors)
+	if err != nil {
+		return orm, err
+	}
+
+	return TaskImport{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		CreatedBy:      dm.CreatedBy,
+		CreatedByUUID:  dm.CreatedByUUID,
+		FileName:       dm.FileName,
+		Header:         header,
+		Rows:           rows,
+		Mapping:        mapping,
+		Status:         dm.Status,
+		Errors:         errs,
+		Created:        dm.Created,
+		CreatedAt:      dm.CreatedAt,
+	}, nil
+}
+
+func toDomain(orm TaskImport) domain.TaskImport {
+	dm := domain.TaskImport{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+		ProjectUUID:    orm.ProjectUUID,
+		CreatedBy:      orm.CreatedBy,
+		CreatedByUUID:  orm.CreatedByUUID,
+		FileName:       orm.FileName,
+		Status:         orm.Status,
+		Created:        orm.Created,
+		CreatedAt:      orm.CreatedAt,
+		UpdatedAt:      orm.UpdatedAt,
+	}
+
+	unmarshal := func(name string, src []byte, dst interface{}) {
+		err := json.Unmarshal(src, dst)
+		if err != nil {
+			logrus.WithField("uuid", orm.UUID).Errorf("task import %s unmarshal error: %s", name, err)
+		}
+	}
+
+	unmarshal("header", orm.Header, &dm.Header)
+	unmarshal("rows", orm.Rows, &dm.Rows)
+	unmarshal("mapping", orm.Mapping, &dm.Mapping)
+	unmarshal("errors", orm.Errors, &dm.Errors)
+
+	return dm
+}
@@ -0,0 +1,45 @@
+package notifications
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateBulkState stores one notification per person about all tasks changed by the bulk operation.
+func (s *Service) CreateBulkState(uid uuid.UUID, operation, createdBy string, people map[string][]uuid.UUID) error {
+	for p, tasks := range people {
+		if _, ok := s.dict.FindUser(p); !ok {
+			logrus.Errorf("user not found: %s", p)
+			continue
+		}
+
+		state := dto.NotificationBulkStateDTO{
+			Operation: operation,
+			CreatedBy: createdBy,
+			Tasks:     tasks,
+			UpdatedAt: time.Now(),
+		}
+
+		err := s.repo.StoreBulkState(p, "bulk:"+uid.String(), state)
+		if err != nil {
+			logrus.Error("StoreBulkState error: ", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) GetBulkState(email string, uid uuid.UUID) (state dto.NotificationBulkStateDTO, err error) {
+	js, err := s.repo.GetTaskStateNotification(email, "bulk:"+uid.String())
+	if err != nil {
+		return state, err
+	}
+
+	err = json.Unmarshal([]byte(js["state"]), &state)
+
+	return state, err
+}
@@ -0,0 +1,44 @@
+package notifications
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateDeadlineState stores notification about the task deadline, the next level replaces the previous one.
+func (s *Service) CreateDeadlineState(taskUUID uuid.UUID, kind string, finishTo time.Time, people []string) error {
+	state := dto.NotificationDeadlineStateDTO{
+		Kind:      kind,
+		FinishTo:  finishTo,
+		UpdatedAt: time.Now(),
+	}
+
+	for _, p := range people {
+		if _, ok := s.dict.FindUser(p); !ok {
+			logrus.Errorf("user not found: %s", p)
+			continue
+		}
+
+		err := s.repo.StoreDeadlineState(p, "deadline:"+taskUUID.String(), state)
+		if err != nil {
+			logrus.Error("StoreDeadlineState error: ", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) GetDeadlineState(email string, taskUUID uuid.UUID) (state dto.NotificationDeadlineStateDTO, err error) {
+	js, err := s.repo.GetTaskStateNotification(email, "deadline:"+taskUUID.String())
+	if err != nil {
+		return state, err
+	}
+
+	err = json.Unmarshal([]byte(js["state"]), &state)
+
+	return state, err
+}
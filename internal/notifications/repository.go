@@ -68,6 +68,44 @@ func (r *Repository) StoreTaskState(email, kindWithUUID string, state aggregates
 	return nil
 }
 
+// Bulk.
+func (r *Repository) StoreBulkState(email, kindWithUUID string, state dto.NotificationBulkStateDTO) error {
+	key := fmt.Sprintf("notifications:%s:%s", email, kindWithUUID)
+
+	js, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	err = r.rds.HSET(context.Background(), key, "state", js)
+	if err != nil {
+		return err
+	}
+
+	key = fmt.Sprintf("notifications:%s", email)
+
+	return r.rds.ZADD(context.Background(), key, kindWithUUID, state.UpdatedAt.UnixMicro())
+}
+
+// Deadline.
+func (r *Repository) StoreDeadlineState(email, kindWithUUID string, state dto.NotificationDeadlineStateDTO) error {
+	key := fmt.Sprintf("notifications:%s:%s", email, kindWithUUID)
+
+	js, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	err = r.rds.HSET(context.Background(), key, "state", js)
+	if err != nil {
+		return err
+	}
+
+	key = fmt.Sprintf("notifications:%s", email)
+
+	return r.rds.ZADD(context.Background(), key, kindWithUUID, state.UpdatedAt.UnixMicro())
+}
+
 func (r *Repository) ToggleStarNotification(email, kindWithUUID string, star bool) error {
 	key := fmt.Sprintf("notifications:%s:%s", email, kindWithUUID)
 
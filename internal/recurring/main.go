@@ -0,0 +1,183 @@
+package recurring
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/sirupsen/logrus"
+)
+
+// dueBatch - max templates materialised by one tick of the worker.
+const dueBatch = 100
+
+type Service struct {
+	repo *Repository
+	dict *dictionary.Service
+	ts   *task.Service
+}
+
+func New(repo *Repository, dict *dictionary.Service, ts *task.Service) *Service {
+	return &Service{
+		repo: repo,
+		dict: dict,
+		ts:   ts,
+	}
+}
+
+func (s *Service) Create(rt domain.RecurringTask) error {
+	return s.repo.Create(rt)
+}
+
+func (s *Service) Get(uid uuid.UUID) (domain.RecurringTask, error) {
+	rt, err := s.repo.Get(uid)
+	if err != nil {
+		return rt, err
+	}
+
+	s.fillCreatedBy(&rt)
+
+	return rt, nil
+}
+
+func (s *Service) GetByProject(projectUUID uuid.UUID) ([]domain.RecurringTask, error) {
+	dms, err := s.repo.GetByProject(projectUUID)
+	for i := range dms {
+		s.fillCreatedBy(&dms[i])
+	}
+
+	return dms, err
+}
+
+func (s *Service) GetByUser(userUUID uuid.UUID) ([]domain.RecurringTask, error) {
+	dms, err := s.repo.GetByUser(userUUID)
+	for i := range dms {
+		s.fillCreatedBy(&dms[i])
+	}
+
+	return dms, err
+}
+
+// Pause stops materialisation. On resume the next run is calculated from now, missed occurrences are skipped.
+func (s *Service) Pause(uid uuid.UUID, paused bool) error {
+	rt, err := s.repo.Get(uid)
+	if err != nil {
+		return err
+	}
+
+	nextRunAt := rt.NextRunAt
+	if !paused {
+		nextRunAt, err = rt.Next(time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.repo.ChangePaused(uid, paused, nextRunAt)
+}
+
+func (s *Service) Preview(uid uuid.UUID, n int) ([]time.Time, error) {
+	rt, err := s.repo.Get(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt.Upcoming(time.Now(), n)
+}
+
+func (s *Service) Delete(uid uuid.UUID) error {
+	return s.repo.Delete(uid)
+}
+
+// Materialize creates tasks for all due templates. Every occurrence is reserved
+// in template_occurrences before creation, so concurrent workers never create it twice.
+func (s *Service) Materialize(now time.Time) (created int, err error) {
+	dms, err := s.repo.GetDue(now, dueBatch)
+	if err != nil {
+		return created, err
+	}
+
+	for _, rt := range dms {
+		ok, err := s.materialize(rt, now)
+		if err != nil {
+			logrus.WithField("template", rt.UUID).Error("recurring task materialize error: ", err)
+			continue
+		}
+
+		if ok {
+			created++
+		}
+	}
+
+	return created, nil
+}
+
+func (s *Service) materialize(rt domain.RecurringTask, now time.Time) (created bool, err error) {
+	if rt.NextRunAt == nil {
+		return false, nil
+	}
+
+	occurrence := *rt.NextRunAt
+
+	reserved, err := s.repo.ReserveOccurrence(rt.UUID, occurrence)
+	if err != nil {
+		return false, err
+	}
+
+	if reserved {
+		taskUUID, err := s.createTask(rt, occurrence)
+		if err != nil {
+			if rerr := s.repo.ReleaseOccurrence(rt.UUID, occurrence); rerr != nil {
+				logrus.WithField("template", rt.UUID).Error("release occurrence error: ", rerr)
+			}
+
+			return false, err
+		}
+
+		err = s.repo.SetOccurrenceTask(rt.UUID, occurrence, taskUUID)
+		if err != nil {
+			logrus.WithField("template", rt.UUID).Error("set occurrence task error: ", err)
+		}
+	}
+
+	// missed occurrences (e.g. while service was down) are skipped
+	next, err := rt.Next(now)
+	if err != nil {
+		return reserved, err
+	}
+
+	_, err = s.repo.Advance(rt.UUID, occurrence, &occurrence, next)
+
+	return reserved, err
+}
+
+func (s *Service) createTask(rt domain.RecurringTask, occurrence time.Time) (uuid.UUID, error) {
+	creator, found := s.dict.FindUserByUUID(rt.CreatedByUUID)
+	if !found {
+		return uuid.Nil, fmt.Errorf("recurring task creator not found by uuid: %s", rt.CreatedByUUID)
+	}
+
+	rt.CreatedBy = creator.Email
+
+	t, err := rt.NewTask(occurrence)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	_, err = s.ts.CreateTask(t)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return t.UUID, nil
+}
+
+func (s *Service) fillCreatedBy(rt *domain.RecurringTask) {
+	creator, found := s.dict.FindUserByUUID(rt.CreatedByUUID)
+	if found {
+		rt.CreatedBy = creator.Email
+	}
+}
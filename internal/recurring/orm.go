@@ -0,0 +1,35 @@
+package recurring
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Template struct {
+	UUID           uuid.UUID `gorm:"<-:create;type:uuid;primary_key"`
+	CreatedBy      uuid.UUID `gorm:"<-:create;type:uuid"`
+	FederationUUID uuid.UUID `gorm:"<-:create;type:uuid"`
+	CompanyUUID    uuid.UUID `gorm:"<-:create;type:uuid"`
+	ProjectUUID    uuid.UUID `gorm:"<-:create;type:uuid"`
+	UserUUID       uuid.UUID `gorm:"<-:create;type:uuid"`
+	Type           string    `gorm:"<-:create;type:varchar(20)"`
+	Template       string    `gorm:"type:text"`
+
+	Schedule  string     `gorm:"type:varchar(255)"`
+	IsPaused  bool       `gorm:"type:boolean"`
+	NextRunAt *time.Time `gorm:"type:timestamptz"`
+	LastRunAt *time.Time `gorm:"type:timestamptz"`
+
+	CreatedAt time.Time `gorm:"<-:create;type:timestamptz"`
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+type TemplateOccurrence struct {
+	TemplateUUID uuid.UUID  `gorm:"<-:create;type:uuid;primary_key"`
+	OccurrenceAt time.Time  `gorm:"<-:create;type:timestamptz;primary_key"`
+	TaskUUID     *uuid.UUID `gorm:"type:uuid"`
+
+	CreatedAt time.Time `gorm:"->;type:timestamptz"`
+}
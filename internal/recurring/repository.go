@@ -0,0 +1,242 @@
+package recurring
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Create(dm domain.RecurringTask) error {
+	blueprint, err := json.Marshal(dm.Blueprint)
+	if err != nil {
+		return err
+	}
+
+	orm := &Template{
+		UUID:           dm.UUID,
+		CreatedBy:      dm.CreatedByUUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		UserUUID:       dm.CreatedByUUID,
+		Type:           domain.TemplateTypeRecurringTask,
+		Template:       string(blueprint),
+		Schedule:       dm.Schedule,
+		IsPaused:       dm.IsPaused,
+		NextRunAt:      dm.NextRunAt,
+		CreatedAt:      dm.CreatedAt,
+	}
+
+	return r.gorm.DB.Create(&orm).Error
+}
+
+func (r *Repository) Get(uid uuid.UUID) (dm domain.RecurringTask, err error) {
+	orm := Template{}
+
+	res := r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("type = ?", domain.TemplateTypeRecurringTask).
+		Where("deleted_at IS NULL").
+		Find(&orm)
+
+	if res.Error != nil {
+		return dm, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dm, dto.NotFoundErr("шаблон повторяющейся задачи не найден")
+	}
+
+	return toDomain(orm), nil
+}
+
+func (r *Repository) GetByProject(projectUUID uuid.UUID) (dms []domain.RecurringTask, err error) {
+	orm := []Template{}
+
+	err = r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Where("type = ?", domain.TemplateTypeRecurringTask).
+		Where("deleted_at IS NULL").
+		Order("created_at DESC").
+		Find(&orm).
+		Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	return lo.Map(orm, func(item Template, _ int) domain.RecurringTask {
+		return toDomain(item)
+	}), nil
+}
+
+func (r *Repository) GetByUser(userUUID uuid.UUID) (dms []domain.RecurringTask, err error) {
+	orm := []Template{}
+
+	err = r.gorm.DB.
+		Where("created_by = ?", userUUID).
+		Where("type = ?", domain.TemplateTypeRecurringTask).
+		Where("deleted_at IS NULL").
+		Order("created_at DESC").
+		Find(&orm).
+		Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	return lo.Map(orm, func(item Template, _ int) domain.RecurringTask {
+		return toDomain(item)
+	}), nil
+}
+
+// GetDue returns active templates which next run is before given time.
+func (r *Repository) GetDue(now time.Time, limit int) (dms []domain.RecurringTask, err error) {
+	orm := []Template{}
+
+	err = r.gorm.DB.
+		Where("type = ?", domain.TemplateTypeRecurringTask).
+		Where("deleted_at IS NULL").
+		Where("is_paused = false").
+		Where("next_run_at <= ?", now).
+		Order("next_run_at ASC").
+		Limit(limit).
+		Find(&orm).
+		Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	return lo.Map(orm, func(item Template, _ int) domain.RecurringTask {
+		return toDomain(item)
+	}), nil
+}
+
+func (r *Repository) ChangePaused(uid uuid.UUID, paused bool, nextRunAt *time.Time) error {
+	res := r.gorm.DB.
+		Model(&Template{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Updates(map[string]interface{}{
+			"is_paused":   paused,
+			"next_run_at": nextRunAt,
+			"updated_at":  "now()",
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("шаблон повторяющейся задачи не найден")
+	}
+
+	return nil
+}
+
+// Advance moves template to the next run. It is applied only if next run was not moved by another worker.
+func (r *Repository) Advance(uid uuid.UUID, prevRunAt time.Time, lastRunAt, nextRunAt *time.Time) (bool, error) {
+	res := r.gorm.DB.
+		Model(&Template{}).
+		Where("uuid = ?", uid).
+		Where("next_run_at = ?", prevRunAt).
+		Updates(map[string]interface{}{
+			"last_run_at": lastRunAt,
+			"next_run_at": nextRunAt,
+			"updated_at":  "now()",
+		})
+
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *Repository) Delete(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&Template{}).
+		Where("uuid = ?", uid).
+		Where("type = ?", domain.TemplateTypeRecurringTask).
+		Where("deleted_at IS NULL").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("шаблон повторяющейся задачи не найден")
+	}
+
+	return nil
+}
+
+// ReserveOccurrence returns false when the occurrence was already materialised.
+func (r *Repository) ReserveOccurrence(templateUUID uuid.UUID, at time.Time) (bool, error) {
+	orm := &TemplateOccurrence{
+		TemplateUUID: templateUUID,
+		OccurrenceAt: at,
+	}
+
+	res := r.gorm.DB.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(orm)
+
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *Repository) ReleaseOccurrence(templateUUID uuid.UUID, at time.Time) error {
+	return r.gorm.DB.
+		Where("template_uuid = ?", templateUUID).
+		Where("occurrence_at = ?", at).
+		Delete(&TemplateOccurrence{}).
+		Error
+}
+
+func (r *Repository) SetOccurrenceTask(templateUUID uuid.UUID, at time.Time, taskUUID uuid.UUID) error {
+	return r.gorm.DB.
+		Model(&TemplateOccurrence{}).
+		Where("template_uuid = ?", templateUUID).
+		Where("occurrence_at = ?", at).
+		Update("task_uuid", taskUUID).
+		Error
+}
+
+func toDomain(orm Template) domain.RecurringTask {
+	blueprint := domain.TaskBlueprint{}
+
+	err := json.Unmarshal([]byte(orm.Template), &blueprint)
+	if err != nil {
+		logrus.WithField("uuid", orm.UUID).Error("recurring template unmarshal error: ", err)
+	}
+
+	return domain.RecurringTask{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+		ProjectUUID:    orm.ProjectUUID,
+		CreatedByUUID:  orm.CreatedBy,
+		Schedule:       orm.Schedule,
+		Blueprint:      blueprint,
+		IsPaused:       orm.IsPaused,
+		NextRunAt:      orm.NextRunAt,
+		LastRunAt:      orm.LastRunAt,
+		CreatedAt:      orm.CreatedAt,
+		UpdatedAt:      orm.UpdatedAt,
+	}
+}
@@ -0,0 +1,100 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/federation"
+	"github.com/samber/lo"
+)
+
+type Service struct {
+	repo *Repository
+	fs   *federation.Service
+}
+
+func New(repo *Repository, fs *federation.Service) *Service {
+	return &Service{
+		repo: repo,
+		fs:   fs,
+	}
+}
+
+// CycleTime returns time in statuses, lead and cycle time of project tasks in the period, groupBy is optional.
+func (s *Service) CycleTime(projectUUID uuid.UUID, from, to time.Time, groupBy string) (report domain.CycleTimeReport, err error) {
+	err = checkPeriod(from, to)
+	if err != nil {
+		return report, err
+	}
+
+	builder, err := domain.NewCycleTimeReportBuilder(from, to, groupBy)
+	if err != nil {
+		return report, err
+	}
+
+	err = s.repo.EachFlowTask(projectUUID, from, to, func(t domain.Task) error {
+		builder.Add(t)
+		return nil
+	})
+	if err != nil {
+		return report, err
+	}
+
+	return builder.Report(), nil
+}
+
+// Workload returns open tasks of people in the federation or the company, the group of the company limits people.
+func (s *Service) Workload(ctx context.Context, federationUUID uuid.UUID, companyUUID, groupUUID *uuid.UUID) (dms []domain.Workload, err error) {
+	var emails []string
+
+	if groupUUID != nil {
+		if companyUUID == nil {
+			return dms, errors.New("для фильтра по группе нужно указать компанию")
+		}
+
+		groups, err := s.fs.GetCompanyGroups(ctx, *companyUUID)
+		if err != nil {
+			return dms, err
+		}
+
+		if !lo.ContainsBy(groups, func(g domain.Group) bool { return g.UUID == *groupUUID }) {
+			return dms, dto.NotFoundErr("группа не найдена")
+		}
+
+		users, err := s.fs.GetGroupUsers(ctx, *groupUUID)
+		if err != nil {
+			return dms, err
+		}
+
+		emails = lo.Map(users, func(u domain.User, _ int) string {
+			return u.Email
+		})
+
+		if len(emails) == 0 {
+			return []domain.Workload{}, nil
+		}
+	}
+
+	dms, err = s.repo.GetWorkload(federationUUID, companyUUID, emails, time.Now())
+	if err != nil {
+		return dms, err
+	}
+
+	return domain.CompleteWorkload(dms, emails), nil
+}
+
+func checkPeriod(from, to time.Time) error {
+	if to.Before(from) {
+		return errors.New("начало периода должно быть раньше конца")
+	}
+
+	if to.Sub(from) > domain.MaxReportDays*24*time.Hour {
+		return errors.New("период не может быть больше года")
+	}
+
+	return nil
+}
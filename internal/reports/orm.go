@@ -0,0 +1,24 @@
+package reports
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+)
+
+// flowTask - the task with status changes, stops are stored by the task package without json tags.
+type flowTask struct {
+	UUID        uuid.UUID
+	CreatedAt   time.Time
+	ImplementBy string
+	Priority    int
+	Tags        pq.StringArray `gorm:"type:text[]"`
+	Stops       datatypes.JSON
+}
+
+type stop struct {
+	CreatedAt time.Time
+	StatusID  int
+}
@@ -0,0 +1,106 @@
+package reports
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/samber/lo"
+)
+
+// workloadSQL counts open tasks of people by roles, all_people is unnested instead of a query per user.
+// The array is deduplicated first, so a repeated email does not count the task twice.
+const workloadSQL = `
+	SELECT p.email,
+		COUNT(*) FILTER (WHERE t.implement_by = p.email) AS implementer,
+		COUNT(*) FILTER (WHERE t.responsible_by = p.email) AS responsible,
+		COUNT(*) FILTER (WHERE p.email = ANY (t.co_workers_by)) AS co_worker,
+		COUNT(*) FILTER (WHERE t.managed_by = p.email) AS manager,
+		COUNT(*) AS total,
+		COUNT(*) FILTER (WHERE t.finish_to < @now) AS overdue,
+		COALESCE(SUM(t.priority), 0) AS priority
+	FROM tasks t
+	CROSS JOIN LATERAL (SELECT DISTINCT unnest(t.all_people)) AS p(email)
+	WHERE t.federation_uuid = @federation
+	  AND (@company::uuid IS NULL OR t.company_uuid = @company::uuid)
+	  AND (@all OR p.email IN @emails)
+	  AND t.status NOT IN @closed
+	  AND t.deleted_at IS NULL
+	  AND (t.implement_by = p.email OR t.responsible_by = p.email OR t.managed_by = p.email OR p.email = ANY (t.co_workers_by))
+	GROUP BY p.email
+	ORDER BY total DESC, p.email`
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+// EachFlowTask reads tasks of the project which were open in the period one by one.
+func (r *Repository) EachFlowTask(projectUUID uuid.UUID, from, to time.Time, fn func(domain.Task) error) error {
+	rows, err := r.gorm.DB.
+		Table("tasks").
+		Select("uuid, created_at, implement_by, priority, tags, stops").
+		Where("project_uuid = ?", projectUUID).
+		Where("created_at <= ?", to).
+		Where("finished_at IS NULL OR finished_at >= ?", from).
+		Where("deleted_at IS NULL").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		row := flowTask{}
+
+		err = r.gorm.DB.ScanRows(rows, &row)
+		if err != nil {
+			return err
+		}
+
+		stops := []stop{}
+
+		err = json.Unmarshal(row.Stops, &stops)
+		if err != nil {
+			return err
+		}
+
+		err = fn(domain.Task{
+			UUID:        row.UUID,
+			CreatedAt:   row.CreatedAt,
+			ImplementBy: row.ImplementBy,
+			Priority:    row.Priority,
+			Tags:        row.Tags,
+			Stops: lo.Map(stops, func(s stop, _ int) domain.Stop {
+				return domain.Stop{CreatedAt: s.CreatedAt, StatusID: s.StatusID}
+			}),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// GetWorkload returns workload of people in the federation, company is optional, emails are not filtered when nil.
+func (r *Repository) GetWorkload(federationUUID uuid.UUID, companyUUID *uuid.UUID, emails []string, now time.Time) (dms []domain.Workload, err error) {
+	err = r.gorm.DB.Raw(workloadSQL, map[string]interface{}{
+		"federation": federationUUID,
+		"company":    companyUUID,
+		"all":        emails == nil,
+		// IN with an empty list is a syntax error
+		"emails": append([]string{""}, emails...),
+		"closed": []int{domain.StatusDone, domain.StatusCancel},
+		"now":    now,
+	}).Scan(&dms).Error
+
+	return dms, err
+}
@@ -124,6 +124,81 @@ func (s3 *ServicePrivate) UploadTaskCommentFile(federatonUUID, taskUUID, comment
 	return s3.uploadFile(file, filePath)
 }
 
+func (s3 *ServicePrivate) UploadExportFile(federatonUUID, exportUUID uuid.UUID, fileName, filePath string, userUUID uuid.UUID) (file File, err error) {
+	ext := helpers.FileExt(filePath)
+	objectName := fmt.Sprintf("%s/export/%s%s", federatonUUID, exportUUID, ext)
+
+	fileDTO, err := NewFileDTO(fileName, filePath, objectName, userUUID)
+	if err != nil {
+		return file, err
+	}
+
+	file = File{
+		UUID: uuid.New(),
+
+		Type:     "export",
+		TypeUUID: exportUUID,
+
+		Name:       fileDTO.Name,
+		ObjectName: objectName,
+		Size:       fileDTO.Size,
+		Ext:        fileDTO.Ext,
+
+		MimeType:   fileDTO.ContentType,
+		BucketName: s3.bucketName,
+		Endpoint:   s3.endpoint,
+		CreatedBy:  userUUID,
+	}
+
+	return s3.uploadFile(file, filePath)
+}
+
+// CopyTaskFiles copies files of the task to another task, objects are copied inside s3 without download.
+func (s3 *ServicePrivate) CopyTaskFiles(federatonUUID, fromTaskUUID, toTaskUUID, userUUID uuid.UUID) (count int, err error) {
+	files, err := s3.repo.GetTaskFiles(fromTaskUUID)
+	if err != nil || len(files) == 0 {
+		return count, err
+	}
+
+	minioClient, err := minio.New(s3.endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
+		Secure: s3.useSSL,
+	})
+	if err != nil {
+		return count, fmt.Errorf("S3: %w", err)
+	}
+
+	ctx := context.Background()
+
+	for _, src := range files {
+		file := src
+		file.UUID = uuid.New()
+		file.TypeUUID = toTaskUUID
+		file.ObjectName = fmt.Sprintf("%s/task/%s/%s%s", federatonUUID, toTaskUUID, uuid.New().String(), helpers.FileExt(src.ObjectName))
+		file.BucketName = s3.bucketName
+		file.Endpoint = s3.endpoint
+		file.CreatedBy = userUUID
+		file.CreatedAt = time.Now()
+
+		_, err = minioClient.CopyObject(ctx,
+			minio.CopyDestOptions{Bucket: file.BucketName, Object: file.ObjectName},
+			minio.CopySrcOptions{Bucket: src.BucketName, Object: src.ObjectName},
+		)
+		if err != nil {
+			return count, fmt.Errorf("S3: %w", err)
+		}
+
+		err = s3.repo.Create(file)
+		if err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
 func (s3 *ServicePrivate) uploadFile(file File, filePath string) (File, error) {
 	err := s3.repo.Create(file)
 	if err != nil {
@@ -222,6 +297,11 @@ func (s3 *ServicePrivate) Delete(fileUUID uuid.UUID) error {
 	return err
 }
 
+// Trash marks the file as deleted, the object is kept in s3 till the trash is purged.
+func (s3 *ServicePrivate) Trash(fileUUID uuid.UUID) error {
+	return s3.repo.Delete(fileUUID)
+}
+
 func (s3 *ServicePrivate) Rename(fileUUID uuid.UUID, name string) error {
 	err := s3.repo.Rename(fileUUID, name)
 	if err != nil {
@@ -0,0 +1,150 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// GetBoard returns cards grouped by project statuses in the project statuses order,
+// every column is paginated separately. With status only one column is returned.
+func (s *Service) GetBoard(project dto.ProjectDTO, filter dto.TaskSearchDTO, status *int, offset, limit int) (columns []dto.BoardColumnDTO, err error) {
+	if project.Statuses == nil {
+		return columns, errors.New("projects statuses is nil")
+	}
+
+	columns = []dto.BoardColumnDTO{}
+
+	for _, st := range *project.Statuses {
+		if status != nil && *status != st.Number {
+			continue
+		}
+
+		filter.Status = lo.ToPtr(st.Number)
+
+		dms, total, err := s.repo.GetBoardColumn(filter, offset, limit)
+		if err != nil {
+			return columns, err
+		}
+
+		items := lo.Map(dms, func(dm domain.Task, _ int) dto.TaskDTOs {
+			return dto.NewTaskDTOs(dm, s.dict)
+		})
+
+		columns = append(columns, dto.BoardColumnDTO{
+			Status: st.ToDTOs(),
+			Total:  total,
+			Count:  len(items),
+			Items:  items,
+		})
+	}
+
+	return columns, nil
+}
+
+// MoveCard places the card between after (card above) and before (card below) in the status column.
+// Move across columns goes through the status checks first, then the status and the rank are written together.
+func (s *Service) MoveCard(ctx context.Context, crtr domain.Creator, project dto.ProjectDTO, task domain.Task, status int, afterUUID, beforeUUID *uuid.UUID, comment string) (rank string, err error) {
+	if task.Status != status {
+		check := task
+		_, err = s.checkStatus(project, &check, status, comment)
+		if err != nil {
+			return rank, err
+		}
+	}
+
+	rank, err = s.cardRank(task, status, afterUUID, beforeUUID, false)
+	if err != nil {
+		return rank, err
+	}
+
+	if task.Status != status {
+		_, _, err = s.patchStatus(ctx, crtr, project, task, status, comment, &rank)
+
+		return rank, err
+	}
+
+	err = s.repo.SetRanks(task.ProjectUUID, map[uuid.UUID]string{task.UUID: rank})
+
+	return rank, err
+}
+
+func (s *Service) cardRank(task domain.Task, status int, afterUUID, beforeUUID *uuid.UUID, rebalanced bool) (rank string, err error) {
+	ranks, err := s.repo.GetColumnRanks(task.ProjectUUID, status)
+	if err != nil {
+		return rank, err
+	}
+
+	ranks = lo.Filter(ranks, func(item columnRank, _ int) bool {
+		return item.UUID != task.UUID
+	})
+
+	// cards which were never moved get ranks once, in their current order
+	unranked := lo.ContainsBy(ranks, func(item columnRank) bool {
+		return item.BoardRank == ""
+	})
+
+	if unranked && !rebalanced {
+		err = s.rebalance(task.ProjectUUID, ranks)
+		if err != nil {
+			return rank, err
+		}
+
+		return s.cardRank(task, status, afterUUID, beforeUUID, true)
+	}
+
+	prev, next := "", ""
+
+	switch {
+	case afterUUID != nil:
+		i := lo.IndexOf(lo.Map(ranks, func(item columnRank, _ int) uuid.UUID { return item.UUID }), *afterUUID)
+		if i == -1 {
+			return rank, fmt.Errorf("задача %s не найдена в колонке", *afterUUID)
+		}
+
+		prev = ranks[i].BoardRank
+		if i+1 < len(ranks) {
+			next = ranks[i+1].BoardRank
+		}
+	case beforeUUID != nil:
+		i := lo.IndexOf(lo.Map(ranks, func(item columnRank, _ int) uuid.UUID { return item.UUID }), *beforeUUID)
+		if i == -1 {
+			return rank, fmt.Errorf("задача %s не найдена в колонке", *beforeUUID)
+		}
+
+		next = ranks[i].BoardRank
+		if i > 0 {
+			prev = ranks[i-1].BoardRank
+		}
+	case len(ranks) > 0:
+		next = ranks[0].BoardRank
+	}
+
+	rank, err = domain.RankBetween(prev, next)
+	if (err != nil || len(rank) > domain.RankMaxLen) && !rebalanced {
+		err = s.rebalance(task.ProjectUUID, ranks)
+		if err != nil {
+			return rank, err
+		}
+
+		return s.cardRank(task, status, afterUUID, beforeUUID, true)
+	}
+
+	return rank, err
+}
+
+func (s *Service) rebalance(projectUUID uuid.UUID, ranks []columnRank) error {
+	sequence := domain.RankSequence(len(ranks))
+
+	mp := make(map[uuid.UUID]string, len(ranks))
+	for i, item := range ranks {
+		mp[item.UUID] = sequence[i]
+	}
+
+	return s.repo.SetRanks(projectUUID, mp)
+}
@@ -0,0 +1,107 @@
+package task
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// BulkTaskUUIDs returns uuids of tasks found by the filter, one more than allowed so too wide filter is detected.
+func (s *Service) BulkTaskUUIDs(ctx context.Context, filter dto.TaskSearchDTO) ([]uuid.UUID, error) {
+	filter.Offset = lo.ToPtr(0)
+	filter.Limit = lo.ToPtr(domain.BulkMaxTasks + 1)
+	filter.Cursor = nil
+	filter.Total = lo.ToPtr(domain.TotalNone)
+
+	dms, _, _, err := s.GetTasks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(dms, func(dm domain.Task, _ int) uuid.UUID {
+		return dm.UUID
+	}), nil
+}
+
+// Bulk applies the operation to every task separately with the same checks as single task changes.
+// gate checks access to every task. Per task notifications are collected and sent once per person when all tasks are done.
+func (s *Service) Bulk(ctx context.Context, crtr domain.Creator, op domain.BulkOperation, change dto.TaskBulkDTO, gate func(domain.Task) error, getProject func(uuid.UUID) (dto.ProjectDTO, error)) (results []domain.BulkResult, err error) {
+	err = change.Validate(op.Operation)
+	if err != nil {
+		return results, err
+	}
+
+	n := &bulkNotifier{skip: crtr.Email, affected: make(map[string][]uuid.UUID)}
+	ctx = withBulkNotifier(ctx, n)
+
+	for _, uid := range op.TaskUUIDs {
+		result := domain.BulkResult{TaskUUID: uid}
+
+		err := s.bulkApply(ctx, crtr, op.Operation, uid, change, gate, getProject)
+		if err != nil {
+			result.Error = err.Error()
+		}
+
+		results = append(results, result)
+	}
+
+	s.TaskEventWasRaised(n.events...)
+
+	if len(n.affected) > 0 {
+		err = s.BulkWasDone(op, crtr.Email, n.affected)
+	}
+
+	return results, err
+}
+
+func (s *Service) bulkApply(ctx context.Context, crtr domain.Creator, operation string, uid uuid.UUID, change dto.TaskBulkDTO, gate func(domain.Task) error, getProject func(uuid.UUID) (dto.ProjectDTO, error)) error {
+	task, err := s.GetTask(ctx, uid, []string{})
+	if err != nil {
+		return err
+	}
+
+	err = gate(task)
+	if err != nil {
+		return err
+	}
+
+	switch operation {
+	case domain.BulkSetTeam:
+		return s.PatchTeam(ctx, crtr, uid, change.ImplementBy, change.ResponsibleBy, change.CoworkersBy, change.WatchedBy, change.ManagedBy)
+	case domain.BulkDelete:
+		return s.DeleteTask(ctx, crtr, uid)
+	case domain.BulkSetStatus:
+		if task.Status == *change.Status {
+			return nil
+		}
+
+		project, err := getProject(task.ProjectUUID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.PatchStatus(ctx, crtr, project, task, *change.Status, change.Comment)
+
+		return err
+	case domain.BulkAddTags, domain.BulkRemoveTags:
+		if operation == domain.BulkAddTags {
+			task.Tags = domain.AddTags(task.Tags, change.Tags)
+		} else {
+			task.Tags = domain.RemoveTags(task.Tags, change.Tags)
+		}
+
+		return s.UpdateTask(ctx, crtr, task, []string{"tags"})
+	case domain.BulkMoveProject:
+		project, err := getProject(*change.ProjectUUID)
+		if err != nil {
+			return err
+		}
+
+		return s.PatchProject(ctx, crtr, task, project, *change.Status, change.Comment)
+	}
+
+	return domain.ErrBulkInvalidOperation
+}
@@ -0,0 +1,250 @@
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *Service) GetChecklist(taskUUID uuid.UUID) (items []domain.ChecklistItem, err error) {
+	return s.repo.GetChecklist(taskUUID)
+}
+
+// AddChecklistItem adds the item to the end of the checklist.
+func (s *Service) AddChecklistItem(crtr domain.Creator, task domain.Task, text, assignedTo string, dueAt *time.Time) (item domain.ChecklistItem, err error) {
+	items, err := s.repo.GetChecklist(task.UUID)
+	if err != nil {
+		return item, err
+	}
+
+	if len(items) >= domain.MaxChecklistItems {
+		return item, domain.ErrChecklistFull
+	}
+
+	err = s.checkAssignee(assignedTo)
+	if err != nil {
+		return item, err
+	}
+
+	last := ""
+	if len(items) > 0 {
+		last = items[len(items)-1].Rank
+	}
+
+	rank, err := domain.RankBetween(last, "")
+	if err != nil {
+		return item, err
+	}
+
+	item, err = domain.NewChecklistItem(crtr, task.UUID, text, assignedTo, dueAt, rank)
+	if err != nil {
+		return item, err
+	}
+
+	err = s.repo.CreateChecklistItem(item)
+	if err != nil {
+		return item, err
+	}
+
+	s.checklistWasChanged(crtr, item, domain.ChecklistAdded, nil)
+
+	return item, nil
+}
+
+// PutChecklistItem replaces text, assignee and due date of the item.
+func (s *Service) PutChecklistItem(crtr domain.Creator, taskUUID, uid uuid.UUID, text, assignedTo string, dueAt *time.Time) (item domain.ChecklistItem, err error) {
+	item, _, err = s.getChecklistItem(taskUUID, uid)
+	if err != nil {
+		return item, err
+	}
+
+	err = item.SetText(text)
+	if err != nil {
+		return item, err
+	}
+
+	err = s.checkAssignee(assignedTo)
+	if err != nil {
+		return item, err
+	}
+
+	item.AssignedTo = assignedTo
+	item.DueAt = dueAt
+
+	err = s.repo.UpdateChecklistItem(item)
+	if err != nil {
+		return item, err
+	}
+
+	s.checklistWasChanged(crtr, item, domain.ChecklistChanged, nil)
+
+	return item, nil
+}
+
+func (s *Service) ToggleChecklistItem(crtr domain.Creator, taskUUID, uid uuid.UUID, done bool) (item domain.ChecklistItem, err error) {
+	item, _, err = s.getChecklistItem(taskUUID, uid)
+	if err != nil {
+		return item, err
+	}
+
+	if item.Done == done {
+		return item, nil
+	}
+
+	item.SetDone(done, crtr.Email, time.Now())
+
+	err = s.repo.UpdateChecklistItem(item)
+	if err != nil {
+		return item, err
+	}
+
+	s.checklistWasChanged(crtr, item, lo.Ternary(done, domain.ChecklistDone, domain.ChecklistUndone), nil)
+
+	return item, nil
+}
+
+// MoveChecklistItem places the item after afterUUID, nil moves it to the start.
+func (s *Service) MoveChecklistItem(crtr domain.Creator, taskUUID, uid uuid.UUID, afterUUID *uuid.UUID) (item domain.ChecklistItem, err error) {
+	item, items, err := s.getChecklistItem(taskUUID, uid)
+	if err != nil {
+		return item, err
+	}
+
+	ranks, err := domain.ChecklistRanks(items, uid, afterUUID)
+	if err != nil {
+		return item, err
+	}
+
+	err = s.repo.SetChecklistRanks(taskUUID, ranks)
+	if err != nil {
+		return item, err
+	}
+
+	item.Rank = ranks[uid]
+
+	s.checklistWasChanged(crtr, item, domain.ChecklistMoved, nil)
+
+	return item, nil
+}
+
+func (s *Service) DeleteChecklistItem(crtr domain.Creator, taskUUID, uid uuid.UUID) (err error) {
+	item, _, err := s.getChecklistItem(taskUUID, uid)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteChecklistItem(taskUUID, uid)
+	if err != nil {
+		return err
+	}
+
+	s.checklistWasChanged(crtr, item, domain.ChecklistRemoved, nil)
+
+	return nil
+}
+
+// ConvertChecklistItem creates the subtask from the item: text becomes the name, assignee - implementer,
+// due date - deadline. The item is removed from the checklist.
+func (s *Service) ConvertChecklistItem(crtr domain.Creator, task domain.Task, uid uuid.UUID) (subtask domain.Task, id int, err error) {
+	item, _, err := s.getChecklistItem(task.UUID, uid)
+	if err != nil {
+		return subtask, id, err
+	}
+
+	subtask, err = domain.NewTask(
+		item.Text,
+		task.FederationUUID,
+		task.CompanyUUID,
+		task.ProjectUUID,
+		crtr.Email,
+		nil,
+		[]string{},
+		"",
+		append([]string{}, task.Path...),
+		[]string{},
+		item.AssignedTo,
+		"",
+		task.Priority,
+		item.DueAt,
+		"",
+		"",
+		nil,
+	)
+	if err != nil {
+		return subtask, id, err
+	}
+
+	subtask, err = s.prepareTask(subtask)
+	if err != nil {
+		return subtask, id, err
+	}
+
+	orm, err := s.repo.ConvertChecklistItem(subtask, task.UUID, uid)
+	if err != nil {
+		return subtask, id, err
+	}
+
+	id = orm.ID
+
+	err = s.taskWasCreated(subtask)
+	if err != nil {
+		logrus.WithField("task", subtask.UUID).Error("checklist convert error: ", err)
+	}
+
+	s.checklistWasChanged(crtr, item, domain.ChecklistConverted, &subtask.UUID)
+
+	return subtask, id, nil
+}
+
+func (s *Service) getChecklistItem(taskUUID, uid uuid.UUID) (item domain.ChecklistItem, items []domain.ChecklistItem, err error) {
+	items, err = s.repo.GetChecklist(taskUUID)
+	if err != nil {
+		return item, items, err
+	}
+
+	item, ok := lo.Find(items, func(i domain.ChecklistItem) bool {
+		return i.UUID == uid
+	})
+	if !ok {
+		return item, items, dto.NotFoundErr("пункт чек-листа не найден")
+	}
+
+	return item, items, nil
+}
+
+func (s *Service) checkAssignee(email string) error {
+	if email == "" {
+		return nil
+	}
+
+	if _, ok := s.dict.FindUser(email); !ok {
+		return fmt.Errorf("пользователь не найден: %s", email)
+	}
+
+	return nil
+}
+
+// checklistWasChanged resets the task cache with checklist progress, records the activity and notifies
+// the assignee, errors are only logged.
+func (s *Service) checklistWasChanged(crtr domain.Creator, item domain.ChecklistItem, action string, taskUUID *uuid.UUID) {
+	s.ResetCache(item.TaskUUID)
+
+	_, err := s.as.TaskChecklistWasChanged(crtr, item, action, taskUUID)
+	if err != nil {
+		logrus.WithField("task", item.TaskUUID).Error("checklist activity error: ", err)
+	}
+
+	if item.AssignedTo == "" || item.AssignedTo == crtr.Email {
+		return
+	}
+
+	err = s.TaskWasUpdatedOrCreated(item.TaskUUID, []string{item.AssignedTo})
+	if err != nil {
+		logrus.WithField("task", item.TaskUUID).Error("checklist notify error: ", err)
+	}
+}
@@ -0,0 +1,137 @@
+package task
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// CloneTask copies the task to the project, optionally with subtasks and files. The copy is placed under
+// the parent, otherwise next to the source task in the same project or to the root of another project.
+// Fields are moved to the fields of another project by hash or name.
+func (s *Service) CloneTask(crtr domain.Creator, source domain.Task, project dto.ProjectDTO, parent *domain.Task, subtasks, files bool) (root domain.Task, count int, err error) {
+	if source.FederationUUID != project.FederationUUID {
+		return root, count, errors.New("невозможно скопировать задачу в другую федерацию")
+	}
+
+	if source.CompanyUUID != project.CompanyUUID {
+		return root, count, errors.New("невозможно скопировать задачу в другую компанию")
+	}
+
+	parentPath := []string{}
+
+	switch {
+	case parent != nil:
+		if parent.ProjectUUID != project.UUID {
+			return root, count, errors.New("родительская задача находится в другом проекте")
+		}
+
+		parentPath = parent.Path
+	case source.ProjectUUID == project.UUID:
+		parentPath = source.Path[:len(source.Path)-1]
+	}
+
+	tasks := []domain.Task{source}
+	if subtasks {
+		tasks, err = s.repo.GetSubtree(source.UUID, domain.MaxCloneTasks+1)
+		if err != nil {
+			return root, count, err
+		}
+
+		if len(tasks) > domain.MaxCloneTasks {
+			return root, count, errors.New("слишком много подзадач для копирования")
+		}
+	}
+
+	remap := func(fields map[string]interface{}) map[string]interface{} { return fields }
+
+	if source.ProjectUUID != project.UUID {
+		from := s.cloneFields(source.ProjectUUID)
+		to := s.cloneFields(project.UUID)
+
+		remap = func(fields map[string]interface{}) map[string]interface{} {
+			return domain.RemapFields(fields, from, to)
+		}
+	}
+
+	// formulas of another project may differ, values are computed over the copied fields
+	projectFields, err := s.repo.GetProjectFields(project.UUID)
+	if err != nil {
+		return root, count, err
+	}
+
+	// tasks are sorted by level, so the parent is copied before its children
+	paths := map[string][]string{}
+	copies := []domain.Task{}
+	sources := map[uuid.UUID]uuid.UUID{}
+
+	for _, t := range tasks {
+		path := parentPath
+		if t.UUID != source.UUID {
+			path = paths[t.Path[len(t.Path)-2]]
+		}
+
+		c, err := t.Clone(crtr.Email, project.UUID, path, remap(t.Fields))
+		if err != nil {
+			return root, count, err
+		}
+
+		applyFormulas(projectFields, &c)
+
+		paths[t.UUID.String()] = c.Path
+		sources[c.UUID] = t.UUID
+		copies = append(copies, c)
+	}
+
+	err = s.CreateTaskBatch(crtr.Email, copies)
+	if err != nil {
+		return root, count, err
+	}
+
+	root = copies[0]
+
+	if len(root.Path) >= 2 || len(copies) > 1 {
+		_, err = s.repo.UpdateChildTotal(uuid.MustParse(root.Path[0]))
+		if err != nil {
+			return root, count, err
+		}
+	}
+
+	if lo.SomeBy(copies, func(c domain.Task) bool { return c.Estimate != nil }) {
+		err = s.repo.UpdateEstimateTotal(lo.Map(copies, func(c domain.Task, _ int) uuid.UUID { return c.UUID }))
+		if err != nil {
+			return root, count, err
+		}
+
+		err = s.UpdateEstimateTotal(root)
+		if err != nil {
+			return root, count, err
+		}
+	}
+
+	if files {
+		for _, c := range copies {
+			_, err = s.storage.CopyTaskFiles(project.FederationUUID, sources[c.UUID], c.UUID, crtr.UUID)
+			if err != nil {
+				return root, count, err
+			}
+		}
+	}
+
+	return root, len(copies), nil
+}
+
+func (s *Service) cloneFields(projectUUID uuid.UUID) []domain.CloneField {
+	fields, _ := s.dict.FindProjectFields(projectUUID)
+
+	return lo.Map(fields, func(f dto.ProjectFieldDTO, _ int) domain.CloneField {
+		return domain.CloneField{
+			Hash:     f.Hash,
+			Name:     f.Name,
+			DataType: domain.FieldDataType(f.DataType),
+		}
+	})
+}
@@ -28,6 +28,13 @@ func (s *Service) CreateComment(ctx context.Context, uid uuid.UUID, cm domain.Co
 		return err
 	}
 
+	s.TaskEventWasRaised(domain.TaskEvent{
+		Trigger:     domain.AutomationCommentAdded,
+		TaskUUID:    uid,
+		ProjectUUID: task.ProjectUUID,
+		Actor:       cm.CreatedBy,
+	})
+
 	return nil
 }
 
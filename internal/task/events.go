@@ -1,6 +1,12 @@
 package task
 
-import "github.com/google/uuid"
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
 
 func (s *Service) OnTaskUpdatedOrCreated(fn func(uuid.UUID, []string) error) {
 	s.onTaskUpdatedOrCreated = fn
@@ -9,3 +15,55 @@ func (s *Service) OnTaskUpdatedOrCreated(fn func(uuid.UUID, []string) error) {
 func (s *Service) OnOpenTask(fn func(uuid.UUID, string) error) {
 	s.onOpenTask = fn
 }
+
+func (s *Service) OnBulkDone(fn func(domain.BulkOperation, string, map[string][]uuid.UUID) error) {
+	s.onBulkDone = fn
+}
+
+func (s *Service) OnTaskEvent(fn func([]domain.TaskEvent)) {
+	s.onTaskEvent = fn
+}
+
+type bulkNotifierKey struct{}
+
+// bulkNotifier collects notifications and task events of a bulk operation
+// so they are sent once when all tasks are done.
+type bulkNotifier struct {
+	skip     string
+	affected map[string][]uuid.UUID
+	events   []domain.TaskEvent
+}
+
+func withBulkNotifier(ctx context.Context, n *bulkNotifier) context.Context {
+	return context.WithValue(ctx, bulkNotifierKey{}, n)
+}
+
+func getBulkNotifier(ctx context.Context) *bulkNotifier {
+	n, _ := ctx.Value(bulkNotifierKey{}).(*bulkNotifier)
+	return n
+}
+
+func (s *Service) notifyTask(ctx context.Context, uid uuid.UUID, people []string) error {
+	n := getBulkNotifier(ctx)
+	if n == nil {
+		return s.TaskWasUpdatedOrCreated(uid, people)
+	}
+
+	for _, email := range people {
+		if email != n.skip && lo.IndexOf(n.affected[email], uid) == -1 {
+			n.affected[email] = append(n.affected[email], uid)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) raiseTaskEvent(ctx context.Context, ev domain.TaskEvent) {
+	n := getBulkNotifier(ctx)
+	if n == nil {
+		s.TaskEventWasRaised(ev)
+		return
+	}
+
+	n.events = append(n.events, ev)
+}
@@ -0,0 +1,97 @@
+package task
+
+import (
+	"strings"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+)
+
+var filterOperators = map[string]string{
+	domain.FilterEq:  "=",
+	domain.FilterNe:  "IS DISTINCT FROM",
+	domain.FilterGt:  ">",
+	domain.FilterGte: ">=",
+	domain.FilterLt:  "<",
+	domain.FilterLte: "<=",
+}
+
+// Values of the fields are free text for old tasks, only strings of these formats are casted.
+const (
+	dateTimePattern = `^\d{4}-(0[1-9]|1[0-2])-(0[1-9]|[12]\d|3[01])([T ]([01]\d|2[0-3]):[0-5]\d(:[0-5]\d(\.\d+)?)?(Z|[+-]\d{2}(:?\d{2})?)?)?$`
+	timePattern     = `^([01]\d|2[0-3]):[0-5]\d(:[0-5]\d(\.\d+)?)?(Z|[+-]\d{2}(:?\d{2})?)?$`
+)
+
+// fieldFilterSQL compiles prepared filter to the condition over tasks.fields.
+// Field hashes and values are passed as parameters, values of other json types never match.
+func fieldFilterSQL(f domain.FieldFilter) (sql string, args []interface{}) {
+	if f.IsGroup() {
+		items, sep := f.And, " AND "
+		if len(f.Or) > 0 {
+			items, sep = f.Or, " OR "
+		}
+
+		parts := make([]string, 0, len(items))
+		for _, item := range items {
+			s, a := fieldFilterSQL(item)
+
+			parts = append(parts, "("+s+")")
+			args = append(args, a...)
+		}
+
+		return strings.Join(parts, sep), args
+	}
+
+	switch f.Op {
+	case domain.FilterEmpty:
+		return `COALESCE(tasks.fields->? IN ('null'::jsonb, '""'::jsonb, '[]'::jsonb), true)`, []interface{}{f.Field}
+	case domain.FilterNotEmpty:
+		return `NOT COALESCE(tasks.fields->? IN ('null'::jsonb, '""'::jsonb, '[]'::jsonb), true)`, []interface{}{f.Field}
+	case domain.FilterHasAny:
+		return "jsonb_exists_any(tasks.fields->?, ?::text[])", []interface{}{f.Field, textArray(f.Value)}
+	case domain.FilterHasAll:
+		return "jsonb_exists_all(tasks.fields->?, ?::text[])", []interface{}{f.Field, textArray(f.Value)}
+	}
+
+	expr, args := fieldExpr(f)
+
+	switch f.Op {
+	case domain.FilterBetween:
+		bounds := f.Value.([]interface{})
+
+		return expr + " BETWEEN ? AND ?", append(args, bounds[0], bounds[1])
+	case domain.FilterIn:
+		return expr + " IN ?", append(args, f.Value)
+	case domain.FilterContains:
+		return expr + ` ILIKE ? ESCAPE '\'`, append(args, "%"+escapeLike(f.Value.(string))+"%")
+	}
+
+	return expr + " " + filterOperators[f.Op] + " ?", append(args, f.Value)
+}
+
+// fieldExpr returns the field value casted to its type, NULL if json type or format of the value does not match.
+func fieldExpr(f domain.FieldFilter) (string, []interface{}) {
+	switch f.DataType {
+	case domain.Integer, domain.Float, domain.Switch, domain.Phone, domain.Formula:
+		return "(CASE WHEN jsonb_typeof(tasks.fields->?) = 'number' THEN (tasks.fields->>?)::numeric END)", []interface{}{f.Field, f.Field}
+	case domain.DateTime:
+		return "(CASE WHEN tasks.fields->>? ~ ? THEN (tasks.fields->>?)::timestamptz END)", []interface{}{f.Field, dateTimePattern, f.Field}
+	case domain.Time:
+		return "(CASE WHEN tasks.fields->>? ~ ? THEN (tasks.fields->>?)::timetz::time END)", []interface{}{f.Field, timePattern, f.Field}
+	case domain.Bool:
+		return "(CASE WHEN jsonb_typeof(tasks.fields->?) = 'boolean' THEN (tasks.fields->>?)::bool END)", []interface{}{f.Field, f.Field}
+	}
+
+	return "(tasks.fields->>?)", []interface{}{f.Field}
+}
+
+func textArray(v interface{}) pq.StringArray {
+	items, _ := v.([]interface{})
+
+	return lo.Map(items, func(item interface{}, _ int) string { return item.(string) })
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
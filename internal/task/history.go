@@ -0,0 +1,56 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// GetTaskStateAt rebuilds the task at the moment by reverting activities of the current row.
+func (s *Service) GetTaskStateAt(ctx context.Context, uid uuid.UUID, at time.Time) (task domain.Task, state domain.TaskState, err error) {
+	task, current, activities, err := s.taskHistory(ctx, uid, at)
+	if err != nil {
+		return task, state, err
+	}
+
+	return task, domain.TaskStateAt(current, activities, at), nil
+}
+
+// GetTaskStateDiff returns attributes of the task changed between two moments.
+func (s *Service) GetTaskStateDiff(ctx context.Context, uid uuid.UUID, from, to time.Time) (changes []domain.TaskStateChange, err error) {
+	if to.Before(from) {
+		return changes, errors.New("начало периода должно быть раньше конца")
+	}
+
+	_, current, activities, err := s.taskHistory(ctx, uid, from)
+	if err != nil {
+		return changes, err
+	}
+
+	return domain.DiffTaskStates(domain.TaskStateAt(current, activities, from), domain.TaskStateAt(current, activities, to)), nil
+}
+
+// taskHistory returns the task, its current state and activities made after the moment.
+func (s *Service) taskHistory(ctx context.Context, uid uuid.UUID, since time.Time) (task domain.Task, current domain.TaskState, activities []domain.Activity, err error) {
+	task, err = s.GetTask(ctx, uid, []string{})
+	if err != nil {
+		return task, current, activities, err
+	}
+
+	if since.Before(task.CreatedAt) {
+		return task, current, activities, fmt.Errorf("задача создана позже %s", since.Format(time.RFC3339))
+	}
+
+	current, err = domain.NewTaskState(task)
+	if err != nil {
+		return task, current, activities, err
+	}
+
+	activities, err = s.as.GetTaskActivitiesSince(uid, since)
+
+	return task, current, activities, err
+}
@@ -0,0 +1,137 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+func (s *Service) GetLinks(taskUUID uuid.UUID) ([]domain.TaskLink, error) {
+	return s.repo.GetLinks(taskUUID)
+}
+
+func (s *Service) CreateLink(ctx context.Context, crtr domain.Creator, fromUUID, toUUID uuid.UUID, linkType string) (link domain.TaskLink, err error) {
+	link, err = domain.NewTaskLink(crtr, fromUUID, toUUID, linkType)
+	if err != nil {
+		return link, err
+	}
+
+	from, err := s.GetTask(ctx, fromUUID, []string{})
+	if err != nil {
+		return link, err
+	}
+
+	to, err := s.GetTask(ctx, toUUID, []string{})
+	if err != nil {
+		return link, err
+	}
+
+	exists, err := s.repo.LinkExists(link)
+	if err != nil {
+		return link, err
+	}
+
+	if exists {
+		return link, domain.ErrTaskLinkExists
+	}
+
+	// from -> to closes a cycle if from is already reachable from to
+	if link.IsDirected() {
+		links, err := s.repo.GetReachableLinks(toUUID, linkType)
+		if err != nil {
+			return link, err
+		}
+
+		if domain.HasPath(links, toUUID, fromUUID, linkType) {
+			return link, domain.ErrTaskLinkCycle
+		}
+	}
+
+	err = s.repo.CreateLink(link)
+	if err != nil {
+		return link, err
+	}
+
+	err = s.linkWasChanged(crtr, link, from, to, true)
+
+	return link, err
+}
+
+func (s *Service) DeleteLink(ctx context.Context, crtr domain.Creator, taskUUID, linkUUID uuid.UUID) (err error) {
+	link, err := s.repo.GetLink(linkUUID)
+	if err != nil {
+		return err
+	}
+
+	if link.FromUUID != taskUUID && link.ToUUID != taskUUID {
+		return fmt.Errorf("связь %s не относится к задаче %s", linkUUID, taskUUID)
+	}
+
+	from, err := s.GetTask(ctx, link.FromUUID, []string{})
+	if err != nil {
+		return err
+	}
+
+	to, err := s.GetTask(ctx, link.ToUUID, []string{})
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteLink(linkUUID)
+	if err != nil {
+		return err
+	}
+
+	return s.linkWasChanged(crtr, link, from, to, false)
+}
+
+// CheckBlockers returns error if the task is blocked by not finished tasks.
+func (s *Service) CheckBlockers(taskUUID uuid.UUID) error {
+	blockers, err := s.repo.GetOpenBlockers(taskUUID)
+	if err != nil {
+		return err
+	}
+
+	if len(blockers) == 0 {
+		return nil
+	}
+
+	names := lo.Map(blockers, func(t domain.Task, _ int) string {
+		return fmt.Sprintf("#%d %s", t.ID, t.Name)
+	})
+
+	return fmt.Errorf("задача заблокирована незавершенными задачами: %s", strings.Join(names, ", "))
+}
+
+func (s *Service) linkWasChanged(crtr domain.Creator, link domain.TaskLink, from, to domain.Task, added bool) (err error) {
+	activity := s.as.TaskLinkWasRemoved
+	if added {
+		activity = s.as.TaskLinkWasAdded
+	}
+
+	for _, pair := range [][2]domain.Task{{from, to}, {to, from}} {
+		task, other := pair[0], pair[1]
+
+		s.ResetCache(task.UUID)
+
+		notify := lo.Filter(task.People, func(email string, _ int) bool {
+			return email != crtr.Email
+		})
+
+		err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
+		if err != nil {
+			return err
+		}
+
+		_, err = activity(crtr, task.UUID, link, other)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -37,6 +37,8 @@ type Service struct {
 
 	onTaskUpdatedOrCreated func(uuid.UUID, []string) error
 	onOpenTask             func(uuid.UUID, string) error
+	onBulkDone             func(domain.BulkOperation, string, map[string][]uuid.UUID) error
+	onTaskEvent            func([]domain.TaskEvent)
 }
 
 func New(repo *Repository, dict *dictionary.Service, as *activities.Service, ps *profile.Service, cs *comments.Service, storage *s3.ServicePrivate) *Service {
@@ -78,57 +80,123 @@ func (s *Service) TaskWasOpen(uid uuid.UUID, email string) error {
 	return nil
 }
 
+func (s *Service) BulkWasDone(op domain.BulkOperation, createdBy string, people map[string][]uuid.UUID) error {
+	if s.onBulkDone != nil {
+		return s.onBulkDone(op, createdBy, people)
+	}
+
+	logrus.Error("onBulkDone is nil")
+
+	return nil
+}
+
+// TaskEventWasRaised passes task changes to automation rules.
+func (s *Service) TaskEventWasRaised(evs ...domain.TaskEvent) {
+	if len(evs) == 0 {
+		return
+	}
+
+	if s.onTaskEvent != nil {
+		s.onTaskEvent(evs)
+		return
+	}
+
+	logrus.Error("onTaskEvent is nil")
+}
+
 func (s *Service) CreateTask(task domain.Task) (id int, err error) {
-	filteredFields, err := s.FilterTaskFields(task)
+	task, err = s.prepareTask(task)
 	if err != nil {
 		return id, err
 	}
 
-	// @todo: filter task_entities fields by project
-
-	task.Fields = filteredFields
-
 	orm, err := s.repo.CreateTask(task, false)
 	if err != nil {
 		return id, err
 	}
 
+	return orm.ID, s.taskWasCreated(task)
+}
+
+// prepareTask validates the new task and keeps only fields of the project.
+func (s *Service) prepareTask(task domain.Task) (domain.Task, error) {
+	err := task.CheckDates()
+	if err != nil {
+		return task, err
+	}
+
+	err = task.CheckEstimate()
+	if err != nil {
+		return task, err
+	}
+
+	filteredFields, err := s.FilterTaskFields(task)
+	if err != nil {
+		return task, err
+	}
+
+	// @todo: filter task_entities fields by project
+
+	task.Fields = filteredFields
+
+	return task, nil
+}
+
+// taskWasCreated updates totals of the parents and notifies people of the stored task.
+func (s *Service) taskWasCreated(task domain.Task) (err error) {
 	path := task.Path
 	if len(path) >= 2 {
 		_, err = s.repo.UpdateChildTotal(uuid.MustParse(path[0]))
 		if err != nil {
-			return id, err
+			return err
 		}
 	}
 
-	if err == nil {
-		notify := lo.Filter(task.People, func(email string, _ int) bool {
-			return email != task.CreatedBy
-		})
-
-		err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
+	if task.Estimate != nil {
+		err = s.UpdateEstimateTotal(task)
 		if err != nil {
-			logrus.Error("TaskWasUpdatedOrCreated error: ", err)
+			return err
 		}
 	}
 
-	return orm.ID, err
+	notify := lo.Filter(task.People, func(email string, _ int) bool {
+		return email != task.CreatedBy
+	})
+
+	err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
+	if err != nil {
+		logrus.Error("TaskWasUpdatedOrCreated error: ", err)
+	}
+
+	s.TaskEventWasRaised(domain.TaskEvent{
+		Trigger:     domain.AutomationTaskCreated,
+		TaskUUID:    task.UUID,
+		ProjectUUID: task.ProjectUUID,
+		Actor:       task.CreatedBy,
+	})
+
+	return err
 }
 
-func (s *Service) UpdateTask(crtr domain.Creator, task domain.Task, shouldUpdate []string) (err error) {
-	filteredFields, err := s.FilterTaskFields(task)
+func (s *Service) UpdateTask(ctx context.Context, crtr domain.Creator, task domain.Task, shouldUpdate []string) (err error) {
+	err = task.CheckDates()
 	if err != nil {
 		return err
 	}
 
-	for k, v := range filteredFields {
-		task.Fields[k] = v
+	err = task.CheckEstimate()
+	if err != nil {
+		return err
 	}
 
-	for k, v := range task.RawFields {
-		if v == nil {
-			delete(task.Fields, k)
-		}
+	changedFields, err := s.applyFields(&task)
+	if err != nil {
+		return err
+	}
+
+	// recomputed formulas are stored even when other columns are updated
+	if len(changedFields) > 0 && !lo.Contains(shouldUpdate, "fields") {
+		shouldUpdate = append(shouldUpdate, "fields")
 	}
 
 	oldTask, err := s.GetTask(context.Background(), task.UUID, []string{})
@@ -141,27 +209,91 @@ func (s *Service) UpdateTask(crtr domain.Creator, task domain.Task, shouldUpdate
 		return err
 	}
 
-	if err == nil {
-		notify := lo.Filter(task.People, func(email string, _ int) bool {
-			// @todo: delete me from notifications
-			return email != crtr.Email
-		})
-
-		err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
+	if lo.Contains(shouldUpdate, "estimate") {
+		err = s.UpdateEstimateTotal(task)
 		if err != nil {
-			logrus.Error("TaskWasUpdatedOrCreated error: ", err)
+			return err
 		}
 	}
 
+	return s.taskWasUpdated(ctx, crtr, oldTask, task, shouldUpdate, changedFields)
+}
+
+// applyFields filters raw fields of the task and applies them to task fields, returns hashes of changed fields.
+func (s *Service) applyFields(task *domain.Task) (changedFields []string, err error) {
+	filteredFields, err := s.FilterTaskFields(*task)
+	if err != nil {
+		return changedFields, err
+	}
+
+	err = s.checkReadonlyFields(*task, filteredFields)
+	if err != nil {
+		return changedFields, err
+	}
+
+	changes := make(map[string]interface{}, len(filteredFields))
+	for k, v := range filteredFields {
+		changes[k] = v
+	}
+
+	for k, v := range task.RawFields {
+		if v == nil {
+			changes[k] = nil
+		}
+	}
+
+	changedFields = domain.ChangedFields(task.Fields, changes)
+
+	for k, v := range filteredFields {
+		if v == nil {
+			delete(task.Fields, k)
+			continue
+		}
+
+		task.Fields[k] = v
+	}
+
+	for k, v := range task.RawFields {
+		if v == nil {
+			delete(task.Fields, k)
+		}
+	}
+
+	return changedFields, nil
+}
+
+// taskWasUpdated notifies people of the stored task, raises field_changed and records activities of updated columns.
+func (s *Service) taskWasUpdated(ctx context.Context, crtr domain.Creator, oldTask, task domain.Task, shouldUpdate, changedFields []string) (err error) {
+	notify := lo.Filter(task.People, func(email string, _ int) bool {
+		// @todo: delete me from notifications
+		return email != crtr.Email
+	})
+
+	err = s.notifyTask(ctx, task.UUID, notify)
+	if err != nil {
+		logrus.Error("TaskWasUpdatedOrCreated error: ", err)
+	}
+
+	if len(changedFields) > 0 {
+		s.raiseTaskEvent(ctx, domain.TaskEvent{
+			Trigger:     domain.AutomationFieldChanged,
+			TaskUUID:    task.UUID,
+			ProjectUUID: task.ProjectUUID,
+			Actor:       crtr.Email,
+			Fields:      changedFields,
+		})
+	}
+
 	for _, field := range shouldUpdate {
 		// Get field using reflection
 
 		tp := reflect.TypeOf(task)
 		for i := 0; i < tp.NumField(); i++ {
-			if strings.EqualFold(tp.Field(i).Name, field) {
+			// finish_to is stored as FinishTo
+			if strings.EqualFold(tp.Field(i).Name, strings.ReplaceAll(field, "_", "")) {
 				valNew := reflect.ValueOf(task).Field(i)
 				valOld := reflect.ValueOf(oldTask).Field(i)
-				_, err = s.as.TaskWasChangedActivity(crtr, task.UUID, field, valNew.Interface(), valOld.Interface())
+				_, err = s.as.TaskWasChangedActivity(crtr, task.UUID, field, valOld.Interface(), valNew.Interface())
 				if err != nil {
 					return err
 				}
@@ -172,20 +304,50 @@ func (s *Service) UpdateTask(crtr domain.Creator, task domain.Task, shouldUpdate
 	return err
 }
 
+// checkReadonlyFields checks sent fields against read-only rules of the project for the current task status.
+func (s *Service) checkReadonlyFields(task domain.Task, filteredFields map[string]interface{}) error {
+	if len(task.RawFields) == 0 {
+		return nil
+	}
+
+	project, ok := s.dict.FindProject(task.ProjectUUID)
+	if !ok || project.Options == nil || project.Options.FieldRules == nil {
+		return nil
+	}
+
+	fields, _ := s.dict.FindProjectFields(task.ProjectUUID)
+	names := lo.SliceToMap(fields, func(f dto.ProjectFieldDTO) (string, string) {
+		return f.Hash, f.Name
+	})
+
+	changes := make(map[string]interface{}, len(task.RawFields))
+	for k := range task.RawFields {
+		changes[k] = filteredFields[k]
+	}
+
+	return domain.CheckReadonlyFields(*project.Options.FieldRules, names, task.Fields, changes, task.Status)
+}
+
 func (s *Service) FilterTaskFields(task domain.Task) (filteredFields map[string]interface{}, err error) {
 	filteredFields = make(map[string]interface{}, 0)
 
+	projectFields, err := s.repo.GetProjectFields(task.ProjectUUID)
+	if err != nil {
+		return filteredFields, err
+	}
+
 	if len(task.RawFields) > 0 {
-		projectFields, err := s.repo.GetProjectFields(task.ProjectUUID)
-		if err != nil {
-			return filteredFields, err
-		}
 
 		addedFieldsHash := []string{}
 		for _, pfield := range projectFields {
 			if value, ok := task.RawFields[pfield.Hash]; ok {
 				addedFieldsHash = append(addedFieldsHash, pfield.Hash)
 
+				if domain.FieldDataType(pfield.DataType) == domain.Formula {
+					msg := fmt.Sprintf("field %s (%s) is computed by formula", pfield.Name, pfield.Hash)
+					return filteredFields, errors.New(msg)
+				}
+
 				if value == nil {
 					continue
 				}
@@ -378,9 +540,90 @@ func (s *Service) FilterTaskFields(task domain.Task) (filteredFields map[string]
 		}
 	}
 
+	// formulas are recomputed on every write, so stored values follow fields changed in any way
+	computeFormulas(projectFields, task, filteredFields)
+
 	return filteredFields, nil
 }
 
+// computeFormulas puts values of formula fields to the filtered fields, so they are stored, sorted and filtered
+// like entered ones. The formula is computed over the task fields with the changes applied, nil value means
+// the stored value should be removed. Fields of the formula can be detached from the project, then it has no value.
+func computeFormulas(projectFields []CompanyFields, task domain.Task, filteredFields map[string]interface{}) {
+	types := make(map[string]domain.FieldDataType, len(projectFields))
+	for _, pfield := range projectFields {
+		types[pfield.Hash] = domain.FieldDataType(pfield.DataType)
+	}
+
+	values := make(map[string]interface{}, len(task.Fields)+len(filteredFields))
+	for k, v := range task.Fields {
+		if raw, ok := task.RawFields[k]; !ok || raw != nil {
+			values[k] = v
+		}
+	}
+
+	for k, v := range filteredFields {
+		values[k] = v
+	}
+
+	for _, pfield := range projectFields {
+		if domain.FieldDataType(pfield.DataType) != domain.Formula {
+			continue
+		}
+
+		v, ok := 0.0, false
+		if formula, err := domain.ParseFormula(pfield.Formula, types); err == nil {
+			v, ok = formula.Eval(values)
+		}
+
+		if ok {
+			filteredFields[pfield.Hash] = v
+		} else if _, exists := task.Fields[pfield.Hash]; exists {
+			filteredFields[pfield.Hash] = nil
+		}
+	}
+}
+
+// applyFormulas recomputes formula fields of the stored task in place, returns changed values, nil - removed ones.
+func applyFormulas(projectFields []CompanyFields, task *domain.Task) map[string]interface{} {
+	values := map[string]interface{}{}
+	computeFormulas(projectFields, *task, values)
+
+	changes := lo.PickByKeys(values, domain.ChangedFields(task.Fields, values))
+	if len(changes) > 0 && task.Fields == nil {
+		task.Fields = map[string]interface{}{}
+	}
+
+	for k, v := range changes {
+		if v == nil {
+			delete(task.Fields, k)
+			continue
+		}
+
+		task.Fields[k] = v
+	}
+
+	return changes
+}
+
+// RecomputeFormulas stores formula values of all tasks of the project, it is run when fields are attached
+// to the project or detached from it.
+func (s *Service) RecomputeFormulas(projectUUID uuid.UUID) error {
+	projectFields, err := s.repo.GetProjectFields(projectUUID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.EachProjectTask(projectUUID, func(task domain.Task) error {
+		changes := applyFormulas(projectFields, &task)
+		if len(changes) == 0 {
+			return nil
+		}
+
+		return s.repo.PatchFields(task.UUID, changes)
+	})
+}
+
 func (s *Service) CreateTaskBatch(updaterEmail string, tasks []domain.Task) (err error) {
 	err = s.repo.CreateInBatches(tasks)
 	if err != nil {
@@ -488,6 +731,15 @@ func (s *Service) GetTask(ctx context.Context, uid uuid.UUID, fields []string) (
 			dm.Activities = actvts
 			dm.ActivitiesTotal = total
 		}
+
+		if lo.IndexOf(fields, "links") != -1 {
+			links, err := s.repo.GetLinks(uid)
+			if err != nil {
+				return dm, err
+			}
+
+			dm.Links = links
+		}
 	}
 
 	return dm, err
@@ -507,38 +759,81 @@ func (s *Service) GetTasksNames(ctx context.Context, uid []uuid.UUID) (taskWithN
 	return s.repo.GetTaskNames(ctx, uid)
 }
 
-func (s *Service) GetTasks(ctx context.Context, filter dto.TaskSearchDTO) (dm []domain.Task, total int64, err error) {
+// GetTasks returns page of tasks and the cursor of the next page, cursor is empty on the last page.
+func (s *Service) GetTasks(ctx context.Context, filter dto.TaskSearchDTO) (dm []domain.Task, total int64, next string, err error) {
+	err = s.prepareFieldFilter(&filter)
+	if err != nil {
+		return dm, -1, next, err
+	}
+
 	allowSort := s.GetSortFields(filter.ProjectUUID)
 
-	dm, total, err = s.repo.GetTasks(ctx, filter, allowSort)
+	dm, total, next, err = s.repo.GetTasks(ctx, filter, allowSort)
 	if err != nil {
-		return dm, -1, err
+		return dm, -1, next, err
 	}
 
-	return dm, total, err
+	return dm, total, next, err
 }
 
-func (s *Service) GetTasksDto(ctx context.Context, filter dto.TaskSearchDTO) (dtos []dto.TaskDTOs, total int64, err error) {
-	dms, total, err := s.GetTasks(ctx, filter)
+// EachTask calls fn for every task found by the filter, tasks are streamed from the database.
+func (s *Service) EachTask(filter dto.TaskSearchDTO, fn func(domain.Task) error) error {
+	err := s.prepareFieldFilter(&filter)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.EachTask(filter, fn)
+}
+
+// EachDueTask calls fn for every not finished task with the deadline in the range.
+func (s *Service) EachDueTask(from, to time.Time, fn func(domain.Task) error) error {
+	return s.repo.EachDueTask(from, to, fn)
+}
+
+// prepareFieldFilter checks the custom fields filter against project fields and converts values to their types.
+func (s *Service) prepareFieldFilter(filter *dto.TaskSearchDTO) error {
+	if filter.FieldFilter == nil {
+		return nil
+	}
+
+	fields, _ := s.dict.FindProjectFields(filter.ProjectUUID)
+
+	types := lo.SliceToMap(fields, func(f dto.ProjectFieldDTO) (string, domain.FieldDataType) {
+		return f.Hash, domain.FieldDataType(f.DataType)
+	})
+
+	prepared, err := filter.FieldFilter.Prepare(types)
+	if err != nil {
+		return err
+	}
+
+	filter.FieldFilter = &prepared
+
+	return nil
+}
+
+func (s *Service) GetTasksDto(ctx context.Context, filter dto.TaskSearchDTO) (dtos []dto.TaskDTOs, total int64, next string, err error) {
+	dms, total, next, err := s.GetTasks(ctx, filter)
 	dtos = []dto.TaskDTOs{}
 
 	for _, dm := range dms {
 		d, err := dto.NewTaskDTOs(dm, s.dict), err
 		if err != nil {
-			return dtos, -1, err
+			return dtos, -1, next, err
 		}
 
 		dtos = append(dtos, d)
 	}
 
-	return dtos, total, err
+	return dtos, total, next, err
 }
 
 func (s *Service) ConvertToDto(dm domain.Task) (d dto.TaskDTO, err error) {
 	return dto.NewTaskDTO(dm, []domain.Comment{}, []domain.File{}, []domain.Reminder{}, make(map[uuid.UUID]interface{}), s.dict, s.ps), err
 }
 
-func (s *Service) PatchProject(crt domain.Creator, task domain.Task, project dto.ProjectDTO, status int, comment string) (err error) {
+func (s *Service) PatchProject(ctx context.Context, crt domain.Creator, task domain.Task, project dto.ProjectDTO, status int, comment string) (err error) {
 	logrus.Warn(task.UUID, task.ProjectUUID)
 	logrus.Warn(project.UUID)
 
@@ -560,7 +855,7 @@ func (s *Service) PatchProject(crt domain.Creator, task domain.Task, project dto
 			return err
 		}
 
-		_, _, err = s.PatchStatus(crt, project, task, status, comment)
+		_, _, err = s.PatchStatus(ctx, crt, project, task, status, comment)
 		if err != nil {
 			return err
 		}
@@ -655,6 +950,21 @@ func (s *Service) PatchTaskParent(ctx context.Context, uid uuid.UUID, parentUUID
 	//     \
 	//      h
 
+	if task.EstimateTotal > 0 {
+		moved, err := s.repo.GetTask(ctx, uid)
+		if err != nil {
+			return err
+		}
+
+		// old parents lose the estimate of the subtree, new parents get it
+		err = s.UpdateEstimateTotal(task)
+		if err != nil {
+			return err
+		}
+
+		return s.UpdateEstimateTotal(moved)
+	}
+
 	return err
 }
 
@@ -682,7 +992,7 @@ func (s *Service) PatchName(crt domain.Creator, uid uuid.UUID, name string) (err
 		}
 	}
 
-	_, err = s.as.TaskWasChangedActivity(crt, task.UUID, "name", task.Name, task.Dirty["name"])
+	_, err = s.as.TaskWasChangedActivity(crt, task.UUID, "name", task.Dirty["name"], task.Name)
 	if err != nil {
 		return err
 	}
@@ -690,80 +1000,129 @@ func (s *Service) PatchName(crt domain.Creator, uid uuid.UUID, name string) (err
 	return err
 }
 
-func (s *Service) PatchStatus(crtr domain.Creator, project dto.ProjectDTO, task domain.Task, status int, comment string) (stopUUID uuid.UUID, path []string, err error) {
-	stopUUID = uuid.New()
-
+// checkStatus validates the status change by required fields, blockers and the status graph and applies it to the task.
+func (s *Service) checkStatus(project dto.ProjectDTO, task *domain.Task, status int, comment string) (path []string, err error) {
 	// @todo: mv to domain
 	fields, _ := s.dict.FindProjectFields(task.ProjectUUID)
 	for _, field := range fields {
 		if field.RequiredOnStatuses != nil {
 			if lo.IndexOf(field.RequiredOnStatuses, status) != -1 {
 				if _, ok := task.Fields[field.Hash]; !ok {
-					return stopUUID, path, fmt.Errorf("field %s (%s) is required", field.Name, field.Hash)
+					return path, fmt.Errorf("field %s (%s) is required", field.Name, field.Hash)
 				}
 			}
 		}
 	}
 
+	if project.Options != nil && project.Options.FieldRules != nil {
+		names := lo.SliceToMap(fields, func(f dto.ProjectFieldDTO) (string, string) {
+			return f.Hash, f.Name
+		})
+
+		err = domain.CheckRequiredFields(*project.Options.FieldRules, names, task.Fields, status)
+		if err != nil {
+			return path, err
+		}
+	}
+
+	if status == domain.StatusDone {
+		err = s.CheckBlockers(task.UUID)
+		if err != nil {
+			return path, err
+		}
+	}
+
 	sg, err := domain.NewStatusGraphFromMap(*project.StatusGraph)
 	if err != nil {
-		return stopUUID, path, err
+		return path, err
 	}
 
-	path, err = task.PatchStatus(status, domain.ProjectOptions{
+	return task.PatchStatus(status, domain.ProjectOptions{
 		RequireCancelationComment: project.Options.RequireCancelationComment,
 		RequireDoneComment:        project.Options.RequireDoneComment,
 		StatusEnable:              project.Options.StatusEnable,
 	}, comment, sg)
+}
+
+func (s *Service) PatchStatus(ctx context.Context, crtr domain.Creator, project dto.ProjectDTO, task domain.Task, status int, comment string) (stopUUID uuid.UUID, path []string, err error) {
+	return s.patchStatus(ctx, crtr, project, task, status, comment, nil)
+}
+
+// patchStatus writes the status, the stop and the board rank when it is set in one transaction.
+func (s *Service) patchStatus(ctx context.Context, crtr domain.Creator, project dto.ProjectDTO, task domain.Task, status int, comment string, rank *string) (stopUUID uuid.UUID, path []string, err error) {
+	stopUUID = uuid.New()
+
+	path, err = s.checkStatus(project, &task, status, comment)
 	if err != nil {
 		return stopUUID, path, err
 	}
 
 	err = s.repo.gorm.DB.Transaction(func(tx *gorm.DB) error {
-		err = s.repo.ChangeField(task.UUID, "status", task.Status)
+		return writeStatus(tx, crtr, task, stopUUID, comment, rank)
+	})
+	if err != nil {
+		return stopUUID, path, err
+	}
 
-		if task.Status == domain.StatusDone {
-			err = s.repo.ChangeField(task.UUID, "finished_at", time.Now())
-			if err != nil {
-				return err
-			}
+	return stopUUID, path, s.statusWasChanged(ctx, crtr, project, task)
+}
 
-			err = s.repo.ChangeField(task.UUID, "finished_by", crtr.Email)
-			if err != nil {
-				return err
-			}
-		}
+// writeStatus stores the status checked by checkStatus with its stop.
+func writeStatus(tx *gorm.DB, crtr domain.Creator, task domain.Task, stopUUID uuid.UUID, comment string, rank *string) error {
+	values := map[string]interface{}{
+		"status":      task.Status,
+		"activity_at": gorm.Expr("now()"),
+		"updated_at":  gorm.Expr("now()"),
+	}
 
-		stop := Stop{
-			UUID:          stopUUID,
-			CreatedAt:     time.Now(),
-			StatusID:      task.Status,
-			StatusName:    "todo",
-			Comment:       comment,
-			CreatedBy:     crtr.Email,
-			CreatedByUUID: crtr.UUID,
-		}
+	if task.Status == domain.StatusDone {
+		values["finished_at"] = time.Now()
+		values["finished_by"] = crtr.Email
+	}
 
-		err = tx.Exec("UPDATE tasks SET stops = stops::jsonb || ?  WHERE uuid = ?", stop, task.UUID).Error
+	if rank != nil {
+		values["board_rank"] = *rank
+	}
 
-		return err
-	})
+	res := tx.Model(&Task{}).Where("uuid = ?", task.UUID).Where("deleted_at is null").Updates(values)
+	if res.Error != nil {
+		return res.Error
+	}
 
-	if err == nil {
-		notify := lo.Filter(task.People, func(email string, _ int) bool {
-			return email != crtr.Email
-		})
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("нельзя обновлять удаленную задачу")
+	}
 
-		err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
-		if err != nil {
-			return stopUUID, path, err
-		}
+	stop := Stop{
+		UUID:          stopUUID,
+		CreatedAt:     time.Now(),
+		StatusID:      task.Status,
+		StatusName:    "todo",
+		Comment:       comment,
+		CreatedBy:     crtr.Email,
+		CreatedByUUID: crtr.UUID,
+	}
+
+	return tx.Exec("UPDATE tasks SET stops = stops::jsonb || ?  WHERE uuid = ?", stop, task.UUID).Error
+}
+
+// statusWasChanged notifies people of the task with the stored status, records the activity and raises status_changed.
+func (s *Service) statusWasChanged(ctx context.Context, crtr domain.Creator, project dto.ProjectDTO, task domain.Task) (err error) {
+	go s.repo.ResetCache(task.UUID)
+
+	notify := lo.Filter(task.People, func(email string, _ int) bool {
+		return email != crtr.Email
+	})
+
+	err = s.notifyTask(ctx, task.UUID, notify)
+	if err != nil {
+		return err
 	}
 
 	//
 	if project.Statuses == nil {
 		logrus.WithField("project_uuid", project.UUID).Error("projects statuses is nil")
-		return stopUUID, path, errors.New("projects statuses is nil")
+		return errors.New("projects statuses is nil")
 	}
 
 	oldStatus, _ := lo.Find(*project.Statuses, func(item dto.ProjectStatusDTO) bool {
@@ -776,10 +1135,18 @@ func (s *Service) PatchStatus(crtr domain.Creator, project dto.ProjectDTO, task
 
 	_, err = s.as.TaskWasChangedStatusActivity(crtr, task.UUID, oldStatus.ToDTOs(), newStatus.ToDTOs())
 	if err != nil {
-		return stopUUID, path, err
+		return err
 	}
 
-	return stopUUID, path, err
+	s.raiseTaskEvent(ctx, domain.TaskEvent{
+		Trigger:     domain.AutomationStatusChanged,
+		TaskUUID:    task.UUID,
+		ProjectUUID: task.ProjectUUID,
+		Actor:       crtr.Email,
+		Status:      task.Status,
+	})
+
+	return nil
 }
 
 func (s *Service) PatchFirstOpenBy(ctx context.Context, uid, userUUID uuid.UUID) (err error) {
@@ -960,6 +1327,7 @@ func (s *Service) PatchTeam(ctx context.Context, crtr domain.Creator, uid uuid.U
 
 	}
 
+	people = task.TeamPeople(implementedBy, responsibleBy, managedBy, coworkersBy, watchedBy)
 	err = s.repo.ChangeField(task.UUID, "all_people", &people)
 	if err != nil {
 		return err
@@ -970,7 +1338,7 @@ func (s *Service) PatchTeam(ctx context.Context, crtr domain.Creator, uid uuid.U
 		return email != crtr.Email
 	})
 
-	err = s.TaskWasUpdatedOrCreated(task.UUID, notify)
+	err = s.notifyTask(ctx, task.UUID, notify)
 	if err != nil {
 		return err
 	}
@@ -988,35 +1356,37 @@ func (s *Service) CheckPath(path []string) (err error) {
 	return s.repo.CheckPath(path)
 }
 
-func (s *Service) DeleteTask(crt domain.Creator, uid uuid.UUID) (err error) {
-	t, err := s.GetTask(context.TODO(), uid, []string{})
+func (s *Service) DeleteTask(ctx context.Context, crt domain.Creator, uid uuid.UUID) (err error) {
+	t, err := s.GetTask(ctx, uid, []string{})
 	if err != nil {
 		return err
 	}
 
-	err = s.repo.DeleteTask(uid)
+	// subtasks and files go to the trash with the task, s3 objects are removed by the purge
+	_, err = s.repo.DeleteTask(uid)
 	if err != nil {
 		return err
 	}
 
-	// files
-	files, err := s.storage.GetTaskFiles(t.UUID, false)
-	if err != nil {
-		return err
-	}
-
-	err = s.TaskWasUpdatedOrCreated(uid, t.People)
-	if err != nil {
-		return err
+	if len(t.Path) >= 2 {
+		_, err = s.repo.UpdateChildTotal(uuid.MustParse(t.Path[0]))
+		if err != nil {
+			return err
+		}
 	}
 
-	for _, file := range files {
-		err := s.storage.Delete(file.UUID)
+	if t.EstimateTotal > 0 {
+		err = s.UpdateEstimateTotal(t)
 		if err != nil {
 			return err
 		}
 	}
 
+	err = s.notifyTask(ctx, uid, t.People)
+	if err != nil {
+		return err
+	}
+
 	// @todo: add action
 	_, err = s.as.TaskWasDeleted(crt, t.UUID, t.Name)
 	if err != nil {
@@ -1039,7 +1409,7 @@ func (s *Service) DeleteTaskFile(crt domain.Creator, taskUUID, fileUUID uuid.UUI
 		return errors.New("file not found")
 	}
 
-	err = s.storage.Delete(file.UUID)
+	err = s.storage.Trash(file.UUID)
 	if err != nil {
 		return err
 	}
@@ -1054,6 +1424,44 @@ func (s *Service) DeleteTaskFile(crt domain.Creator, taskUUID, fileUUID uuid.UUI
 	return err
 }
 
+// UpdateDurationTotal rolls logged time up from the task to its parents by path.
+func (s *Service) UpdateDurationTotal(task domain.Task) error {
+	return s.repo.UpdateDurationTotal(pathUUIDs(task))
+}
+
+// UpdateEstimateTotal rolls estimates up from the task to its parents by path, epics get estimates of their subtree.
+func (s *Service) UpdateEstimateTotal(task domain.Task) error {
+	return s.repo.UpdateEstimateTotal(pathUUIDs(task))
+}
+
+// pathUUIDs returns the task with its parents, broken path items are logged and skipped.
+func pathUUIDs(task domain.Task) []uuid.UUID {
+	uids := []uuid.UUID{}
+
+	for _, item := range task.Path {
+		uid, err := uuid.Parse(item)
+		if err != nil {
+			logrus.Errorf("task path uuid parse error: %s", item)
+			continue
+		}
+
+		uids = append(uids, uid)
+	}
+
+	if lo.IndexOf(uids, task.UUID) == -1 {
+		uids = append(uids, task.UUID)
+	}
+
+	return uids
+}
+
+// UpdateChildTotal recounts subtasks of the tree with the root task.
+func (s *Service) UpdateChildTotal(rootUUID uuid.UUID) error {
+	_, err := s.repo.UpdateChildTotal(rootUUID)
+
+	return err
+}
+
 func (s *Service) ResetCache(uid uuid.UUID) {
 	s.repo.cache.ClearTask(context.TODO(), uid)
 }
@@ -1064,7 +1472,7 @@ func (s *Service) GetSortFields(projectUUID uuid.UUID) []string {
 	allowOrder := s.repo.GetSortFields()
 
 	for _, field := range fields {
-		if domain.FieldDataType(field.DataType) == domain.Integer || domain.FieldDataType(field.DataType) == domain.Float || domain.FieldDataType(field.DataType) == domain.String {
+		if domain.FieldDataType(field.DataType) == domain.Integer || domain.FieldDataType(field.DataType) == domain.Float || domain.FieldDataType(field.DataType) == domain.String || domain.FieldDataType(field.DataType) == domain.Formula {
 			// fileds.a || fields.b
 			allowOrder = append(allowOrder, "fields."+field.Hash+"")
 		}
@@ -0,0 +1,163 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// MergeTask folds the duplicate source task into the target: comments, files, reminders, watchers, co-workers
+// and tags are moved, fields are merged by the conflict policy. The source is canceled and linked as a duplicate.
+// All changes are written in one transaction, people are notified after the commit.
+func (s *Service) MergeTask(ctx context.Context, crtr domain.Creator, target, source domain.Task, sourceProject dto.ProjectDTO, policy string) (conflicts []string, err error) {
+	if target.UUID == source.UUID {
+		return conflicts, domain.ErrMergeSelf
+	}
+
+	if target.FederationUUID != source.FederationUUID {
+		return conflicts, errors.New("невозможно объединить задачи разных федераций")
+	}
+
+	sourceFields := source.Fields
+	if source.ProjectUUID != target.ProjectUUID {
+		sourceFields = domain.RemapFields(source.Fields, s.cloneFields(source.ProjectUUID), s.cloneFields(target.ProjectUUID))
+	}
+
+	fields, conflicts, err := domain.MergeFields(target.Fields, sourceFields, policy)
+	if err != nil {
+		return conflicts, err
+	}
+
+	people := lo.Uniq(append(append([]string{}, target.People...), source.People...))
+
+	// everything is checked before writing: nothing is moved if the source can not be canceled
+	cancel := domain.IsTaskOpen(source.Status)
+	comment := fmt.Sprintf("Объединена с задачей #%d %s", target.ID, target.Name)
+
+	canceled := source
+	if cancel {
+		_, err = s.checkStatus(sourceProject, &canceled, domain.StatusCancel, comment)
+		if err != nil {
+			return conflicts, err
+		}
+	}
+
+	// changed fields go through the usual update: filtered, formulas recomputed and written to activities
+	formulas := lo.FilterMap(s.cloneFields(target.ProjectUUID), func(f domain.CloneField, _ int) (string, bool) {
+		return f.Hash, f.DataType == domain.Formula
+	})
+
+	oldTarget := target
+	oldTarget.Fields = lo.Assign(target.Fields)
+
+	changedFields := []string{}
+	changes := lo.OmitByKeys(lo.PickByKeys(fields, domain.ChangedFields(target.Fields, fields)), formulas)
+	if len(changes) > 0 {
+		target.RawFields = changes
+
+		changedFields, err = s.applyFields(&target)
+		if err != nil {
+			return conflicts, err
+		}
+	}
+
+	target.MergeFrom(source)
+
+	link, err := domain.NewTaskLink(crtr, source.UUID, target.UUID, domain.TaskLinkDuplicates)
+	if err != nil {
+		return conflicts, err
+	}
+
+	exists, err := s.repo.LinkExists(link)
+	if err != nil {
+		return conflicts, err
+	}
+
+	stopUUID := uuid.New()
+
+	err = s.repo.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		if len(changedFields) > 0 {
+			res := tx.Model(&Task{}).Where("uuid = ?", target.UUID).Where("deleted_at is null").Updates(map[string]interface{}{
+				"fields":      JSONB(target.Fields),
+				"activity_at": gorm.Expr("now()"),
+				"updated_at":  gorm.Expr("now()"),
+			})
+			if res.Error != nil {
+				return res.Error
+			}
+
+			if res.RowsAffected == 0 {
+				return dto.NotFoundErr("нельзя обновлять удаленную задачу")
+			}
+		}
+
+		err := mergeTask(tx, target, source.UUID)
+		if err != nil {
+			return err
+		}
+
+		if cancel {
+			err = writeStatus(tx, crtr, canceled, stopUUID, comment, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if exists {
+			return nil
+		}
+
+		return createLink(tx, link)
+	})
+	if err != nil {
+		return conflicts, err
+	}
+
+	// notifications and events go after the commit, their errors do not roll the merge back
+	go s.repo.ResetCache(target.UUID)
+	go s.repo.ResetCache(source.UUID)
+
+	if len(changedFields) > 0 {
+		err = s.taskWasUpdated(ctx, crtr, oldTarget, target, []string{"fields"}, changedFields)
+		if err != nil {
+			logrus.WithField("task", target.UUID).Error("merge update error: ", err)
+		}
+	}
+
+	if cancel {
+		err = s.statusWasChanged(ctx, crtr, sourceProject, canceled)
+		if err != nil {
+			logrus.WithField("task", source.UUID).Error("merge cancel error: ", err)
+		}
+	}
+
+	_, err = s.as.TaskWasMerged(crtr, source.UUID, "into", target, conflicts)
+	if err != nil {
+		return conflicts, err
+	}
+
+	_, err = s.as.TaskWasMerged(crtr, target.UUID, "from", source, conflicts)
+	if err != nil {
+		return conflicts, err
+	}
+
+	notify := lo.Filter(people, func(email string, _ int) bool {
+		return email != crtr.Email
+	})
+
+	err = s.notifyTask(ctx, target.UUID, notify)
+	if err != nil {
+		return conflicts, err
+	}
+
+	err = s.notifyTask(ctx, source.UUID, notify)
+
+	return conflicts, err
+}
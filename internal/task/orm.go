@@ -44,11 +44,15 @@ type Task struct {
 
 	CreatedAt  time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
 	FinishedAt *time.Time `gorm:"type:timestamptz;default:NULL;" order:""`
+	StartAt    *time.Time `gorm:"type:timestamptz;default:NULL;" order:""`
 	FinishTo   *time.Time `gorm:"type:timestamptz;default:NULL;" order:""`
 	ActivityAt time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
 
 	Duration int `gorm:"type:int;default:0;not null"`
 
+	Estimate      *float64 `gorm:"type:numeric;default:NULL;" order:""`
+	EstimateTotal float64  `gorm:"type:numeric;default:0;not null" order:""`
+
 	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null" order:""`
 	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
 
@@ -60,12 +64,26 @@ type Task struct {
 
 	Total int64 `gorm:"->"`
 
+	// sort key of the task as text, filled only in lists for the next page cursor
+	CursorKey *string `gorm:"->"`
+
+	// full-text search, filled only when searching
+	Rank                 *float64 `gorm:"->"`
+	NameHighlight        string   `gorm:"->"`
+	DescriptionHighlight string   `gorm:"->"`
+	CommentHighlight     *string  `gorm:"->"`
+
 	TaskEntities TE    `gorm:"type:jsonb;default:'{}';not null;"`
 	Stops        Stops `gorm:"type:jsonb;default:'[]';not null;"`
 
 	FirstOpen FirstOpen `gorm:"->update;type:jsonb;default:'{}';not null;"`
 
 	Description string `gorm:"type:text;default:'';not null" order:""`
+
+	BoardRank string `gorm:"type:varchar(255);default:'';not null"`
+
+	ChecklistTotal int `gorm:"type:int;default:0;not null"`
+	ChecklistDone  int `gorm:"type:int;default:0;not null"`
 }
 
 type FirstOpen map[string]time.Time
@@ -138,4 +156,39 @@ type CompanyFields struct {
 	Name        string `gorm:"type:varchar(100);not null;"`
 	DataType    int    `gorm:"type:int;not null;default:0"`
 	CompanyUUID string `gorm:"type:uuid;not null"`
+	Formula     string `gorm:"type:text;not null;default:''"`
+}
+
+type ChecklistItem struct {
+	UUID     uuid.UUID `gorm:"<-:create;type:uuid;primary_key"`
+	TaskUUID uuid.UUID `gorm:"type:uuid;not null"`
+
+	Text       string     `gorm:"type:varchar(500);not null"`
+	Done       bool       `gorm:"type:boolean;default:false;not null"`
+	DoneAt     *time.Time `gorm:"type:timestamptz"`
+	DoneBy     string     `gorm:"type:varchar(100);not null"`
+	AssignedTo string     `gorm:"type:varchar(100);not null"`
+	DueAt      *time.Time `gorm:"type:timestamptz"`
+	Rank       string     `gorm:"type:varchar(255);not null"`
+
+	CreatedBy string     `gorm:"<-:create;type:varchar(100);not null"`
+	CreatedAt time.Time  `gorm:"<-:create;type:timestamptz"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz"`
+	DeletedAt *time.Time `gorm:"type:timestamptz"`
+}
+
+func (ChecklistItem) TableName() string {
+	return "task_checklist_items"
+}
+
+type TaskLink struct {
+	UUID          uuid.UUID `gorm:"<-:create;type:uuid;primary_key"`
+	FromUUID      uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+	ToUUID        uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+	Type          string    `gorm:"<-:create;type:varchar(20);not null"`
+	CreatedBy     string    `gorm:"<-:create;type:varchar(100);not null"`
+	CreatedByUUID uuid.UUID `gorm:"<-:create;type:uuid;not null"`
+
+	CreatedAt time.Time  `gorm:"<-:create;type:timestamptz"`
+	DeletedAt *time.Time `gorm:"type:timestamptz"`
 }
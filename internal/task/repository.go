@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -16,6 +17,7 @@ import (
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/lib/pq"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
@@ -41,7 +43,42 @@ func NewRepository(db *postgres.GDB, rds *redis.RDS, metrics *helpers.MetricsCou
 func (r *Repository) CreateTask(task domain.Task, batch bool) (orm *Task, err error) {
 	defer r.storeTime("CreateTask", tm())
 
-	orm = &Task{
+	orm = newTaskOrm(task)
+
+	if !batch {
+		err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+			return createTask(tx, orm)
+		})
+	}
+
+	return orm, err
+}
+
+// ConvertChecklistItem creates the subtask and removes the checklist item in one transaction,
+// NotFound if the item is already removed or converted.
+func (r *Repository) ConvertChecklistItem(task domain.Task, taskUUID, uid uuid.UUID) (orm *Task, err error) {
+	defer r.storeTime("ConvertChecklistItem", tm())
+
+	orm = newTaskOrm(task)
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := deleteChecklistItem(tx, taskUUID, uid)
+		if err != nil {
+			return err
+		}
+
+		return createTask(tx, orm)
+	})
+
+	if err == nil {
+		go r.ResetCache(taskUUID)
+	}
+
+	return orm, err
+}
+
+func newTaskOrm(task domain.Task) *Task {
+	return &Task{
 		UUID: task.UUID,
 		Name: task.Name,
 
@@ -71,36 +108,32 @@ func (r *Repository) CreateTask(task domain.Task, batch bool) (orm *Task, err er
 
 		TaskEntities: task.TaskEntities,
 
+		StartAt:  task.StartAt,
 		FinishTo: task.FinishTo,
+		Estimate: task.Estimate,
 
 		FirstOpen: task.FirstOpen,
 
 		Description: task.Description,
 	}
+}
 
-	if !batch {
-		err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
-			project := &Project{}
-			err = tx.Raw("select * from projects where uuid = ? FOR UPDATE", task.ProjectUUID).Scan(&project).Error
-			if err != nil {
-				return err
-			}
-
-			task.ID = project.TaskID + 1
-			orm.ID = task.ID
-
-			err = tx.Create(&orm).Error
-			if err != nil {
-				return err
-			}
+// createTask inserts the task with the next id of the project, the project row is locked until the end of tx.
+func createTask(tx *gorm.DB, orm *Task) error {
+	project := &Project{}
+	err := tx.Raw("select * from projects where uuid = ? FOR UPDATE", orm.ProjectUUID).Scan(&project).Error
+	if err != nil {
+		return err
+	}
 
-			err = tx.Exec("update projects set task_id = ? where uuid = ?", task.ID, project.UUID).Error
+	orm.ID = project.TaskID + 1
 
-			return err
-		})
+	err = tx.Create(&orm).Error
+	if err != nil {
+		return err
 	}
 
-	return orm, err
+	return tx.Exec("update projects set task_id = ? where uuid = ?", orm.ID, project.UUID).Error
 }
 
 func (r *Repository) CreateInBatches(task []domain.Task) (err error) {
@@ -331,12 +364,16 @@ func (r *Repository) GetTask(_ context.Context, uid uuid.UUID) (dm domain.Task,
 			}
 		}),
 
+		StartAt:    orm.StartAt,
 		FinishTo:   orm.FinishTo,
 		FinishedAt: orm.FinishedAt,
 
 		FirstOpen: orm.FirstOpen,
 
 		ChildrensTotal: orm.ChildrensTotal,
+		Duration:       orm.Duration,
+		Estimate:       orm.Estimate,
+		EstimateTotal:  orm.EstimateTotal,
 		ChildrensUUID: lo.Map(orm.ChildrensUUID, func(item string, _ int) uuid.UUID {
 			return uuid.MustParse(item)
 		}),
@@ -412,10 +449,14 @@ func (r *Repository) GetTaskWithDeleted(_ context.Context, uid uuid.UUID) (dm do
 			}
 		}),
 
+		StartAt:    orm.StartAt,
 		FinishTo:   orm.FinishTo,
 		FinishedAt: orm.FinishedAt,
 
 		FirstOpen: orm.FirstOpen,
+
+		Estimate:      orm.Estimate,
+		EstimateTotal: orm.EstimateTotal,
 	}
 
 	return dm, nil
@@ -456,30 +497,214 @@ func (r *Repository) GetSortFields() []string {
 	return allowSort
 }
 
-func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allowSort []string) (dms []domain.Task, total int64, err error) {
+// headlineOptions - matches are marked with private use characters, the fragment is escaped before they become tags.
+var headlineOptions = "StartSel=" + domain.HighlightStart + ", StopSel=" + domain.HighlightStop + ", MaxWords=35, MinWords=15, MaxFragments=2"
+
+func searchHit(item Task) *domain.TaskSearchHit {
+	if item.Rank == nil {
+		return nil
+	}
+
+	return &domain.TaskSearchHit{
+		Rank:        *item.Rank,
+		Name:        domain.SearchHighlight(item.NameHighlight),
+		Description: domain.SearchHighlight(item.DescriptionHighlight),
+		Comment:     domain.SearchHighlight(lo.FromPtr(item.CommentHighlight)),
+	}
+}
+
+func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allowSort []string) (dms []domain.Task, total int64, next string, err error) {
 	defer r.storeTime("GetTasks", tm())
 
 	orms := []Task{}
 
 	query := r.gorm.DB
 
-	if len(allowSort) > 0 && filter.Order != nil {
-		by := "desc"
+	order, by := taskOrder(filter, allowSort)
+	expr := orderExpr(order)
+
+	filter.Search = domain.SearchText(filter.Search)
+
+	var cursor *domain.TaskCursor
+	if filter.Cursor != nil {
+		c, err := domain.ParseTaskCursor(*filter.Cursor, filter.Search != nil, order, by)
+		if err != nil {
+			return dms, -1, next, err
+		}
+
+		cursor = &c
+	}
+
+	if filter.Search != nil && order == "" {
+		query = query.Order("rank desc, created_at desc")
+	} else {
+		// uuid makes the order unique, so pages do not overlap
+		query = query.Order(expr + " " + by + ", tasks.uuid " + by)
+	}
+
+	query = filterTasks(query, filter)
+
+	limit := 5
+	if filter.Limit != nil {
+		limit = *filter.Limit
+	}
+
+	query = query.Limit(limit)
+
+	if cursor != nil {
+		query = keyset(query, expr, *cursor)
+	} else if filter.Offset != nil {
+		query = query.Offset(*filter.Offset)
+	} else {
+		query = query.Offset(0)
+	}
+
+	query = query.Where("deleted_at is null")
+
+	totalMode := lo.FromPtr(filter.Total)
+	if totalMode == "" {
+		// offset mode counts total as before, cursor mode does not need it
+		totalMode = lo.Ternary(cursor == nil, domain.TotalExact, domain.TotalNone)
+	}
+
+	window := lo.Ternary(cursor == nil && totalMode == domain.TotalExact, "count(*) OVER() AS total", "0 AS total")
+
+	if filter.Search != nil {
+		// comments weigh half of the task itself, best matching comment is highlighted
+		query = query.Select(`tasks.*, `+window+`,
+			ts_rank(tasks.search_vector, search.q) + 0.5 * COALESCE((
+				SELECT max(ts_rank(c.search_vector, search.q)) FROM comments c
+				WHERE c.task_uuid = tasks.uuid AND c.deleted_at IS NULL
+			), 0) AS rank,
+			ts_headline('russian', tasks.name, search.q, ?) AS name_highlight,
+			ts_headline('russian', tasks.description, search.q, ?) AS description_highlight,
+			(
+				SELECT ts_headline('russian', c.comment, search.q, ?) FROM comments c
+				WHERE c.task_uuid = tasks.uuid AND c.deleted_at IS NULL AND c.search_vector @@ search.q
+				ORDER BY ts_rank(c.search_vector, search.q) DESC LIMIT 1
+			) AS comment_highlight`, headlineOptions, headlineOptions, headlineOptions)
+	} else {
+		query = query.Select("tasks.*, " + window + ", (" + expr + ")::text AS cursor_key")
+	}
+
+	sql := query.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Find(&orms)
+	})
+
+	logrus.Debug("sql: ", sql)
+
+	result := query.Find(&orms)
+
+	if result.Error != nil {
+		return dms, -1, next, result.Error
+	}
+
+	switch totalMode {
+	case domain.TotalExact:
+		if cursor == nil {
+			if len(orms) > 0 {
+				total = orms[0].Total
+			}
+		} else {
+			err = filterTasks(r.gorm.DB.Model(&Task{}), filter).Where("deleted_at is null").Count(&total).Error
+		}
+	case domain.TotalEstimated:
+		total, err = r.estimateTasks(filterTasks(r.gorm.DB.Model(&Task{}), filter).Where("deleted_at is null"))
+	default:
+		total = -1
+	}
+
+	if err != nil {
+		return dms, -1, next, err
+	}
+
+	if filter.Search == nil && len(orms) == limit {
+		last := orms[len(orms)-1]
+
+		next = domain.TaskCursor{Order: order, By: by, Key: last.CursorKey, UUID: last.UUID}.Encode()
+	}
+
+	dms = helpers.Map(orms, func(item Task, _ int) domain.Task {
+		return toListDomain(item)
+	})
+
+	return dms, total, next, nil
+}
+
+// taskOrder returns sort field and direction, empty field means default order.
+func taskOrder(filter dto.TaskSearchDTO, allowSort []string) (order, by string) {
+	if len(allowSort) > 0 && filter.Order != nil && helpers.InArray(*filter.Order, allowSort) {
+		by = "desc"
 		if filter.By != nil && *filter.By == "asc" {
 			by = "asc"
 		}
 
-		if helpers.InArray(*filter.Order, allowSort) {
-			if strings.HasPrefix(*filter.Order, "fields.") {
-				*filter.Order = "fields->>'" + strings.Replace(*filter.Order, "fields.", "", 1) + "'"
-			}
+		return *filter.Order, by
+	}
+
+	return "", "desc"
+}
+
+func orderExpr(order string) string {
+	if order == "" {
+		return "tasks.created_at"
+	}
+
+	if hash, ok := strings.CutPrefix(order, "fields."); ok {
+		return "tasks.fields->>'" + hash + "'"
+	}
+
+	return "tasks." + order
+}
 
-			query = query.Order(*filter.Order + " " + by)
+// keyset skips tasks up to the cursor. Postgres sorts NULL as the greatest value:
+// last for asc and first for desc.
+func keyset(query *gorm.DB, expr string, c domain.TaskCursor) *gorm.DB {
+	op := lo.Ternary(c.By == "asc", ">", "<")
+
+	if c.Key == nil {
+		if c.By == "asc" {
+			return query.Where(fmt.Sprintf("(%s IS NULL AND tasks.uuid > ?)", expr), c.UUID)
 		}
-	} else {
-		query = query.Order("created_at desc")
+
+		return query.Where(fmt.Sprintf("((%[1]s IS NULL AND tasks.uuid < ?) OR %[1]s IS NOT NULL)", expr), c.UUID)
+	}
+
+	cond := fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND tasks.uuid %[2]s ?)", expr, op)
+	if c.By == "asc" {
+		cond += fmt.Sprintf(" OR %s IS NULL", expr)
+	}
+
+	return query.Where("("+cond+")", *c.Key, *c.Key, c.UUID)
+}
+
+// estimateTasks returns planner estimate of the query rows, it is much cheaper than count on big projects.
+func (r *Repository) estimateTasks(query *gorm.DB) (int64, error) {
+	stmt := query.Select("tasks.uuid").Session(&gorm.Session{DryRun: true}).Find(&[]Task{}).Statement
+
+	var plan string
+
+	err := r.gorm.DB.Raw("EXPLAIN (FORMAT JSON) "+stmt.SQL.String(), stmt.Vars...).Row().Scan(&plan)
+	if err != nil {
+		return -1, err
+	}
+
+	plans := []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}{}
+
+	err = json.Unmarshal([]byte(plan), &plans)
+	if err != nil || len(plans) == 0 {
+		return -1, fmt.Errorf("не удалось оценить количество задач: %w", err)
 	}
 
+	return int64(plans[0].Plan.Rows), nil
+}
+
+// filterTasks applies task search filter, project and federation scoping included.
+func filterTasks(query *gorm.DB, filter dto.TaskSearchDTO) *gorm.DB {
 	if filter.Status != nil {
 		query = query.Where("status = ?", *filter.Status)
 	}
@@ -505,6 +730,12 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 		query = query.Where("name iLIKE ? OR name iLIKE ?", *filter.Name+"%", "% "+*filter.Name+"%")
 	}
 
+	if search := domain.SearchText(filter.Search); search != nil {
+		query = query.
+			Joins("CROSS JOIN (SELECT websearch_to_tsquery('russian', ?) || websearch_to_tsquery('simple', ?) AS q) AS search", *search, *search).
+			Where("(tasks.search_vector @@ search.q OR EXISTS (SELECT 1 FROM comments c WHERE c.task_uuid = tasks.uuid AND c.deleted_at IS NULL AND c.search_vector @@ search.q))")
+	}
+
 	if filter.IsMy != nil && *filter.IsMy && filter.MyEmail != nil {
 		query = query.Where("? = ANY (all_people)", filter.MyEmail)
 	}
@@ -529,71 +760,219 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 		}
 	}
 
+	if filter.FieldFilter != nil {
+		sql, args := fieldFilterSQL(*filter.FieldFilter)
+		query = query.Where("("+sql+")", args...)
+	}
+
 	if filter.Path != nil {
 		query = query.Where("path ~ ?", *filter.Path)
 	}
 
-	if filter.Limit != nil {
-		query = query.Limit(*filter.Limit)
-	} else {
-		query = query.Limit(5)
+	return query
+}
+
+// EachTask reads tasks found by the filter one by one, without loading all of them to memory.
+func (r *Repository) EachTask(filter dto.TaskSearchDTO, fn func(domain.Task) error) error {
+	defer r.storeTime("EachTask", tm())
+
+	query := filterTasks(r.gorm.DB.Model(&Task{}), filter).
+		Select("tasks.*").
+		Where("deleted_at is null").
+		Order("id asc")
+
+	return r.eachTask(query, fn)
+}
+
+// EachDueTask reads not finished tasks with the deadline in the range.
+func (r *Repository) EachDueTask(from, to time.Time, fn func(domain.Task) error) error {
+	defer r.storeTime("EachDueTask", tm())
+
+	query := r.gorm.DB.Model(&Task{}).
+		Where("finish_to BETWEEN ? AND ?", from, to).
+		Where("status NOT IN ?", []int{domain.StatusDone, domain.StatusCancel}).
+		Where("deleted_at is null").
+		Order("finish_to asc")
+
+	return r.eachTask(query, fn)
+}
+
+// EachProjectTask calls fn for every task of the project.
+func (r *Repository) EachProjectTask(projectUUID uuid.UUID, fn func(domain.Task) error) error {
+	defer r.storeTime("EachProjectTask", tm())
+
+	query := r.gorm.DB.Model(&Task{}).
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Order("id asc")
+
+	return r.eachTask(query, fn)
+}
+
+// GetSubtree returns the task and its subtasks, parents go before children.
+func (r *Repository) GetSubtree(uid uuid.UUID, limit int) (dms []domain.Task, err error) {
+	defer r.storeTime("GetSubtree", tm())
+
+	query := r.gorm.DB.Model(&Task{}).
+		Where("path ~ ?", "*."+uid.String()+".*").
+		Where("deleted_at is null").
+		Order("nlevel(path) asc, id asc").
+		Limit(limit)
+
+	err = r.eachTask(query, func(dm domain.Task) error {
+		dms = append(dms, dm)
+		return nil
+	})
+
+	return dms, err
+}
+
+// GetTimeline returns tasks of the project or of the subtree when root is set, parents go before children.
+func (r *Repository) GetTimeline(projectUUID uuid.UUID, root *uuid.UUID, limit int) (dms []domain.Task, err error) {
+	defer r.storeTime("GetTimeline", tm())
+
+	query := r.gorm.DB.Model(&Task{}).
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Order("nlevel(path) asc, start_at asc nulls last, finish_to asc nulls last, id asc").
+		Limit(limit)
+
+	if root != nil {
+		query = query.Where("path ~ ?", "*."+root.String()+".*")
 	}
 
-	if filter.Offset != nil {
-		query = query.Offset(*filter.Offset)
-	} else {
-		query = query.Offset(0)
+	err = r.eachTask(query, func(dm domain.Task) error {
+		dms = append(dms, dm)
+		return nil
+	})
+
+	return dms, err
+}
+
+func (r *Repository) eachTask(query *gorm.DB, fn func(domain.Task) error) error {
+	rows, err := query.Rows()
+	if err != nil {
+		return err
 	}
+	defer rows.Close()
 
-	query = query.Where("deleted_at is null")
+	for rows.Next() {
+		orm := Task{}
 
-	query = query.Select("*, count(*) OVER() AS total")
+		err = r.gorm.DB.ScanRows(rows, &orm)
+		if err != nil {
+			return err
+		}
 
-	sql := query.ToSQL(func(tx *gorm.DB) *gorm.DB {
-		return tx.Find(&orms)
-	})
+		dm := toListDomain(orm)
+		dm.Description = orm.Description
+		dm.ManagedBy = orm.ManagedBy
+		dm.FinishedBy = orm.FinishedBy
+		dm.CompanyUUID = orm.CompanyUUID
+		dm.Icon = orm.Icon
+		dm.TaskEntities = orm.TaskEntities
+		dm.Path = strings.Split(orm.Path, ".")
 
-	logrus.Debug("sql: ", sql)
+		err = fn(dm)
+		if err != nil {
+			return err
+		}
+	}
 
-	result := query.Find(&orms)
+	return rows.Err()
+}
 
-	if result.Error != nil {
-		return dms, -1, result.Error
+// toListDomain maps task fields used in lists.
+func toListDomain(item Task) domain.Task {
+	return domain.Task{
+		UUID:           item.UUID,
+		Name:           item.Name,
+		ID:             item.ID,
+		ProjectUUID:    item.ProjectUUID,
+		FederationUUID: item.FederationUUID,
+		Priority:       item.Priority,
+		IsEpic:         item.IsEpic,
+		CreatedBy:      item.CreatedBy,
+		CoWorkersBy:    item.CoWorkersBy,
+		WatchBy:        item.WatchBy,
+		ResponsibleBy:  item.ResponsibleBy,
+		ImplementBy:    item.ImplementBy,
+		Tags:           item.Tags,
+		Status:         item.Status,
+		Fields:         item.Fields,
+
+		ActivityAt:     item.ActivityAt,
+		ChildrensTotal: item.ChildrensTotal,
+		Duration:       item.Duration,
+		Estimate:       item.Estimate,
+		EstimateTotal:  item.EstimateTotal,
+		StartAt:        item.StartAt,
+		FinishTo:       item.FinishTo,
+		FinishedAt:     item.FinishedAt,
+
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+
+		BoardRank: item.BoardRank,
+
+		ChecklistTotal: item.ChecklistTotal,
+		ChecklistDone:  item.ChecklistDone,
+
+		Search: searchHit(item),
 	}
+}
 
-	if len(orms) > 0 {
-		total = orms[0].Total
+// PatchFields sets the fields of the task by hash, nil values are removed, other fields are kept.
+func (r *Repository) PatchFields(uid uuid.UUID, changes map[string]interface{}) error {
+	defer r.storeTime("PatchFields", tm())
+
+	set := JSONB(lo.OmitBy(changes, func(_ string, v interface{}) bool { return v == nil }))
+	remove := pq.StringArray(lo.Keys(lo.PickBy(changes, func(_ string, v interface{}) bool { return v == nil })))
+
+	err := r.gorm.DB.Exec(`UPDATE tasks SET fields = (fields || ?::jsonb) - ?::text[] WHERE uuid = ?`, set, remove, uid).Error
+	if err == nil {
+		go r.ResetCache(uid)
 	}
 
-	dms = helpers.Map(orms, func(item Task, i int) domain.Task {
-		return domain.Task{
-			UUID:           item.UUID,
-			Name:           item.Name,
-			ID:             item.ID,
-			ProjectUUID:    item.ProjectUUID,
-			FederationUUID: item.FederationUUID,
-			Priority:       item.Priority,
-			IsEpic:         item.IsEpic,
-			CreatedBy:      item.CreatedBy,
-			CoWorkersBy:    item.CoWorkersBy,
-			WatchBy:        item.WatchBy,
-			ResponsibleBy:  item.ResponsibleBy,
-			ImplementBy:    item.ImplementBy,
-			Tags:           item.Tags,
-			Status:         item.Status,
-			Fields:         item.Fields,
-
-			ActivityAt:     item.ActivityAt,
-			ChildrensTotal: item.ChildrensTotal,
-			FinishTo:       item.FinishTo,
-			FinishedAt:     item.FinishedAt,
-
-			CreatedAt: item.CreatedAt,
-			UpdatedAt: item.UpdatedAt,
+	return err
+}
+
+// ShiftTasks writes dates of the shifted tasks in one transaction, nothing is written if any task is deleted.
+func (r *Repository) ShiftTasks(tasks []domain.Task) error {
+	defer r.storeTime("ShiftTasks", tm())
+
+	err := r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		for _, task := range tasks {
+			res := tx.Model(&Task{}).
+				Where("uuid = ?", task.UUID).
+				Where("deleted_at is null").
+				Updates(map[string]interface{}{
+					"start_at":    task.StartAt,
+					"finish_to":   task.FinishTo,
+					"activity_at": gorm.Expr("now()"),
+					"updated_at":  gorm.Expr("now()"),
+				})
+			if res.Error != nil {
+				return res.Error
+			}
+
+			if res.RowsAffected == 0 {
+				return dto.NotFoundErr("нельзя обновлять удаленную задачу")
+			}
 		}
+
+		return nil
 	})
 
-	return dms, total, nil
+	if err == nil {
+		go func() {
+			for _, task := range tasks {
+				r.ResetCache(task.UUID)
+			}
+		}()
+	}
+
+	return err
 }
 
 func (r *Repository) ChangeField(uid uuid.UUID, fieldName string, value interface{}) error {
@@ -749,6 +1128,10 @@ func (r *Repository) UpdateTask(task domain.Task, shouldUpdate []string) (err er
 			err = r.ChangeField(task.UUID, "tags", "{"+strings.Join(task.Tags, ",")+"}")
 		case "fields":
 			err = r.ChangeField(task.UUID, "fields", task.Fields)
+		case "estimate":
+			err = r.ChangeField(task.UUID, "estimate", task.Estimate)
+		case "start_at":
+			err = r.ChangeField(task.UUID, "start_at", task.StartAt)
 		case "finish_to":
 			err = r.ChangeField(task.UUID, "finish_to", task.FinishTo)
 		case "description":
@@ -769,24 +1152,545 @@ func (r *Repository) CheckPath(path []string) (err error) {
 	return err
 }
 
-func (r *Repository) DeleteTask(uid uuid.UUID) (err error) {
+// DeleteTask moves the task with subtasks and their files to the trash.
+// All rows get the same deleted_at (time of the transaction), so they are restored together.
+func (r *Repository) DeleteTask(uid uuid.UUID) (uuids []uuid.UUID, err error) {
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw(`
+			UPDATE tasks SET deleted_at = now()
+			WHERE deleted_at IS NULL
+			  AND path ~ (SELECT ('*.' || uuid::text || '.*')::lquery FROM tasks WHERE uuid = ? AND deleted_at IS NULL)
+			RETURNING uuid`, uid).
+			Scan(&uuids).Error
+		if err != nil {
+			return err
+		}
+
+		if len(uuids) == 0 {
+			return dto.NotFoundErr("задача не найдена")
+		}
+
+		return tx.Exec(`
+			UPDATE files SET deleted_at = now()
+			WHERE type = 'task' AND type_uuid IN ? AND deleted_at IS NULL`, uuids).Error
+	})
+
+	if err == nil {
+		go func() {
+			for _, u := range uuids {
+				r.ResetCache(u)
+			}
+		}()
+	}
+
+	return uuids, err
+}
+
+// MergeTask moves comments, files and reminders of the source task to the target and saves merged attributes.
+// mergeTask moves comments, files and reminders of the source to the target and stores merged people and tags.
+func mergeTask(tx *gorm.DB, target domain.Task, sourceUUID uuid.UUID) error {
+	err := tx.Exec(`UPDATE comments SET task_uuid = ? WHERE task_uuid = ?`, target.UUID, sourceUUID).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Exec(`UPDATE files SET type_uuid = ? WHERE type = 'task' AND type_uuid = ? AND deleted_at IS NULL`, target.UUID, sourceUUID).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Exec(`UPDATE reminders SET task_uuid = ? WHERE task_uuid = ?`, target.UUID, sourceUUID).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Exec(`
+		UPDATE tasks SET tags = ?, watch_by = ?, co_workers_by = ?, all_people = ?
+		WHERE uuid = ?`,
+		pq.StringArray(target.Tags),
+		pq.StringArray(target.WatchBy),
+		pq.StringArray(target.CoWorkersBy),
+		pq.StringArray(target.People),
+		target.UUID,
+	).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+		UPDATE tasks SET comments_total = (SELECT count(*) FROM comments c WHERE c.task_uuid = tasks.uuid AND c.deleted_at IS NULL)
+		WHERE uuid IN ?`, []uuid.UUID{target.UUID, sourceUUID}).Error
+}
+
+func (r *Repository) ResetCache(uid uuid.UUID) {
+	r.cache.ClearTask(context.TODO(), uid)
+}
+
+type taskLinkRow struct {
+	TaskLink
+
+	TaskID          int
+	TaskName        string
+	TaskStatus      int
+	TaskProjectUUID uuid.UUID
+}
+
+func (r *Repository) CreateLink(link domain.TaskLink) (err error) {
+	defer r.storeTime("CreateLink", tm())
+
+	return createLink(r.gorm.DB, link)
+}
+
+func createLink(tx *gorm.DB, link domain.TaskLink) error {
+	orm := &TaskLink{
+		UUID:          link.UUID,
+		FromUUID:      link.FromUUID,
+		ToUUID:        link.ToUUID,
+		Type:          link.Type,
+		CreatedBy:     link.CreatedBy,
+		CreatedByUUID: link.CreatedByUUID,
+		CreatedAt:     link.CreatedAt,
+	}
+
+	return tx.Create(orm).Error
+}
+
+func (r *Repository) GetLink(uid uuid.UUID) (link domain.TaskLink, err error) {
+	orm := TaskLink{}
+
 	res := r.gorm.DB.
-		Model(&Task{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Find(&orm)
+
+	if res.Error != nil {
+		return link, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return link, dto.NotFoundErr("связь не найдена")
+	}
+
+	return linkToDomain(orm), nil
+}
+
+func (r *Repository) DeleteLink(uid uuid.UUID) (err error) {
+	res := r.gorm.DB.
+		Model(&TaskLink{}).
 		Where("uuid = ?", uid).
 		Where("deleted_at is null").
 		Update("deleted_at", "now()")
 
+	if res.Error != nil {
+		return res.Error
+	}
+
 	if res.RowsAffected == 0 {
-		return dto.NotFoundErr("задача не найдена")
+		return dto.NotFoundErr("связь не найдена")
 	}
 
-	if res.Error == nil {
-		go r.ResetCache(uid)
+	return nil
+}
+
+// GetLinks returns links of the task in both directions with the other side filled.
+func (r *Repository) GetLinks(taskUUID uuid.UUID) (links []domain.TaskLink, err error) {
+	defer r.storeTime("GetLinks", tm())
+
+	rows := []taskLinkRow{}
+
+	err = r.gorm.DB.Raw(`
+		SELECT l.*, t.id AS task_id, t.name AS task_name, t.status AS task_status, t.project_uuid AS task_project_uuid
+		FROM task_links l
+		JOIN tasks t ON t.uuid = CASE WHEN l.from_uuid = @uid THEN l.to_uuid ELSE l.from_uuid END
+		WHERE (l.from_uuid = @uid OR l.to_uuid = @uid)
+			AND l.deleted_at IS NULL
+			AND t.deleted_at IS NULL
+		ORDER BY l.created_at`, map[string]interface{}{"uid": taskUUID}).
+		Scan(&rows).
+		Error
+
+	if err != nil {
+		return links, err
 	}
 
-	return res.Error
+	return lo.Map(rows, func(row taskLinkRow, _ int) domain.TaskLink {
+		link := linkToDomain(row.TaskLink)
+		link.Task = &domain.Task{
+			UUID:        link.Other(taskUUID),
+			ID:          row.TaskID,
+			Name:        row.TaskName,
+			Status:      row.TaskStatus,
+			ProjectUUID: row.TaskProjectUUID,
+		}
+
+		return link
+	}), nil
 }
 
-func (r *Repository) ResetCache(uid uuid.UUID) {
-	r.cache.ClearTask(context.TODO(), uid)
+// GetLinksBetween returns links with both sides in the tasks.
+func (r *Repository) GetLinksBetween(uuids []uuid.UUID) (links []domain.TaskLink, err error) {
+	defer r.storeTime("GetLinksBetween", tm())
+
+	if len(uuids) == 0 {
+		return links, nil
+	}
+
+	orms := []TaskLink{}
+
+	err = r.gorm.DB.
+		Model(&TaskLink{}).
+		Where("from_uuid IN ? AND to_uuid IN ?", uuids, uuids).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&orms).
+		Error
+
+	return lo.Map(orms, func(orm TaskLink, _ int) domain.TaskLink {
+		return linkToDomain(orm)
+	}), err
+}
+
+// LinkExists checks link of the type between tasks, relates-to is checked in both directions.
+func (r *Repository) LinkExists(link domain.TaskLink) (exists bool, err error) {
+	q := r.gorm.DB.
+		Model(&TaskLink{}).
+		Select("count(*) > 0").
+		Where("type = ?", link.Type).
+		Where("deleted_at is null")
+
+	if link.IsDirected() {
+		q = q.Where("from_uuid = ? AND to_uuid = ?", link.FromUUID, link.ToUUID)
+	} else {
+		q = q.Where("(from_uuid = ? AND to_uuid = ?) OR (from_uuid = ? AND to_uuid = ?)", link.FromUUID, link.ToUUID, link.ToUUID, link.FromUUID)
+	}
+
+	err = q.Find(&exists).Error
+
+	return exists, err
+}
+
+// GetReachableLinks returns links of the type on all paths going from the task.
+func (r *Repository) GetReachableLinks(from uuid.UUID, linkType string) (dms []domain.TaskLink, err error) {
+	defer r.storeTime("GetReachableLinks", tm())
+
+	orms := []TaskLink{}
+
+	err = r.gorm.DB.Raw(`
+		WITH RECURSIVE reachable(uuid) AS (
+			SELECT @from::uuid
+			UNION
+			SELECT l.to_uuid FROM task_links l
+			JOIN reachable r ON l.from_uuid = r.uuid
+			WHERE l.type = @type AND l.deleted_at IS NULL
+		)
+		SELECT l.* FROM task_links l
+		JOIN reachable r ON l.from_uuid = r.uuid
+		WHERE l.type = @type AND l.deleted_at IS NULL`,
+		map[string]interface{}{"from": from, "type": linkType}).
+		Scan(&orms).
+		Error
+
+	return lo.Map(orms, func(orm TaskLink, _ int) domain.TaskLink {
+		return linkToDomain(orm)
+	}), err
+}
+
+// GetOpenBlockers returns not finished tasks which block the task.
+func (r *Repository) GetOpenBlockers(taskUUID uuid.UUID) (dms []domain.Task, err error) {
+	defer r.storeTime("GetOpenBlockers", tm())
+
+	orms := []Task{}
+
+	err = r.gorm.DB.
+		Model(&Task{}).
+		Select("tasks.uuid, tasks.id, tasks.name, tasks.status").
+		Joins("JOIN task_links l ON l.from_uuid = tasks.uuid").
+		Where("l.to_uuid = ?", taskUUID).
+		Where("l.type = ?", domain.TaskLinkBlocks).
+		Where("l.deleted_at is null").
+		Where("tasks.deleted_at is null").
+		Where("tasks.status NOT IN ?", []int{domain.StatusDone, domain.StatusCancel}).
+		Find(&orms).
+		Error
+
+	return lo.Map(orms, func(item Task, _ int) domain.Task {
+		return toListDomain(item)
+	}), err
+}
+
+func linkToDomain(orm TaskLink) domain.TaskLink {
+	return domain.TaskLink{
+		UUID:          orm.UUID,
+		FromUUID:      orm.FromUUID,
+		ToUUID:        orm.ToUUID,
+		Type:          orm.Type,
+		CreatedBy:     orm.CreatedBy,
+		CreatedByUUID: orm.CreatedByUUID,
+		CreatedAt:     orm.CreatedAt,
+	}
+}
+
+// UpdateDurationTotal recalculates logged seconds of the tasks including their children.
+func (r *Repository) UpdateDurationTotal(uids []uuid.UUID) error {
+	defer r.storeTime("UpdateDurationTotal", tm())
+
+	return r.updateTotal("duration", "SELECT COALESCE(SUM(w.duration), 0) FROM worklogs w JOIN tasks t ON t.uuid = w.task_uuid AND w.deleted_at IS NULL", uids)
+}
+
+// UpdateEstimateTotal recalculates estimates of the tasks including their children.
+func (r *Repository) UpdateEstimateTotal(uids []uuid.UUID) error {
+	defer r.storeTime("UpdateEstimateTotal", tm())
+
+	return r.updateTotal("estimate_total", "SELECT COALESCE(SUM(t.estimate), 0) FROM tasks t", uids)
+}
+
+// updateTotal sets the column of each task to the sum over its subtree, sum selects from tasks aliased as t.
+func (r *Repository) updateTotal(column, sum string, uids []uuid.UUID) error {
+	if len(uids) == 0 {
+		return nil
+	}
+
+	err := r.gorm.DB.Exec(`
+		UPDATE tasks SET `+column+` = (
+			`+sum+`
+			WHERE t.path ~ ('*.' || tasks.uuid::text || '.*')::lquery AND t.deleted_at IS NULL
+		)
+		WHERE uuid IN ?`, uids).Error
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for _, u := range uids {
+			r.ResetCache(u)
+		}
+	}()
+
+	return nil
+}
+
+// boardOrder - ranked cards first, cards which were never moved are below by creation time.
+const boardOrder = "board_rank = '' ASC, board_rank ASC, created_at DESC"
+
+// GetBoardColumn returns cards of one status in board order.
+func (r *Repository) GetBoardColumn(filter dto.TaskSearchDTO, offset, limit int) (dms []domain.Task, total int64, err error) {
+	defer r.storeTime("GetBoardColumn", tm())
+
+	orms := []Task{}
+
+	err = filterTasks(r.gorm.DB, filter).
+		Where("deleted_at is null").
+		Select("*, count(*) OVER() AS total").
+		Order(boardOrder).
+		Offset(offset).
+		Limit(limit).
+		Find(&orms).
+		Error
+
+	if err != nil {
+		return dms, -1, err
+	}
+
+	if len(orms) > 0 {
+		total = orms[0].Total
+	}
+
+	return helpers.Map(orms, func(item Task, _ int) domain.Task {
+		return toListDomain(item)
+	}), total, nil
+}
+
+type columnRank struct {
+	UUID      uuid.UUID
+	BoardRank string
+}
+
+// GetColumnRanks returns all cards of the status in board order.
+func (r *Repository) GetColumnRanks(projectUUID uuid.UUID, status int) (ranks []columnRank, err error) {
+	err = r.gorm.DB.
+		Model(&Task{}).
+		Select("uuid, board_rank").
+		Where("project_uuid = ?", projectUUID).
+		Where("status = ?", status).
+		Where("deleted_at is null").
+		Order(boardOrder).
+		Find(&ranks).
+		Error
+
+	return ranks, err
+}
+
+func (r *Repository) SetRanks(projectUUID uuid.UUID, ranks map[uuid.UUID]string) (err error) {
+	defer r.storeTime("SetRanks", tm())
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		for uid, rank := range ranks {
+			err := tx.Exec("UPDATE tasks SET board_rank = ? WHERE project_uuid = ? AND uuid = ?", rank, projectUUID, uid).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err == nil {
+		for uid := range ranks {
+			go r.ResetCache(uid)
+		}
+	}
+
+	return err
+}
+
+func (r *Repository) GetChecklist(taskUUID uuid.UUID) (dms []domain.ChecklistItem, err error) {
+	defer r.storeTime("GetChecklist", tm())
+
+	orm := []ChecklistItem{}
+
+	err = r.gorm.DB.
+		Where("task_uuid = ?", taskUUID).
+		Where("deleted_at is null").
+		Order("rank asc, created_at asc").
+		Find(&orm).Error
+
+	dms = lo.Map(orm, func(item ChecklistItem, _ int) domain.ChecklistItem {
+		return checklistToDomain(item)
+	})
+
+	return dms, err
+}
+
+func (r *Repository) CreateChecklistItem(dm domain.ChecklistItem) (err error) {
+	defer r.storeTime("CreateChecklistItem", tm())
+
+	orm := checklistToORM(dm)
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&orm).Error
+		if err != nil {
+			return err
+		}
+
+		return updateChecklistTotal(tx, dm.TaskUUID)
+	})
+
+	if err == nil {
+		go r.ResetCache(dm.TaskUUID)
+	}
+
+	return err
+}
+
+func (r *Repository) UpdateChecklistItem(dm domain.ChecklistItem) (err error) {
+	defer r.storeTime("UpdateChecklistItem", tm())
+
+	orm := checklistToORM(dm)
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&ChecklistItem{}).
+			Where("uuid = ?", dm.UUID).
+			Where("deleted_at is null").
+			Select("text", "done", "done_at", "done_by", "assigned_to", "due_at", "updated_at").
+			Updates(&orm).Error
+		if err != nil {
+			return err
+		}
+
+		return updateChecklistTotal(tx, dm.TaskUUID)
+	})
+
+	if err == nil {
+		go r.ResetCache(dm.TaskUUID)
+	}
+
+	return err
+}
+
+func (r *Repository) SetChecklistRanks(taskUUID uuid.UUID, ranks map[uuid.UUID]string) (err error) {
+	defer r.storeTime("SetChecklistRanks", tm())
+
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		for uid, rank := range ranks {
+			err := tx.Exec("UPDATE task_checklist_items SET rank = ? WHERE task_uuid = ? AND uuid = ?", rank, taskUUID, uid).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) DeleteChecklistItem(taskUUID, uid uuid.UUID) (err error) {
+	defer r.storeTime("DeleteChecklistItem", tm())
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		return deleteChecklistItem(tx, taskUUID, uid)
+	})
+
+	if err == nil {
+		go r.ResetCache(taskUUID)
+	}
+
+	return err
+}
+
+func deleteChecklistItem(tx *gorm.DB, taskUUID, uid uuid.UUID) error {
+	res := tx.Model(&ChecklistItem{}).
+		Where("uuid = ?", uid).
+		Where("task_uuid = ?", taskUUID).
+		Where("deleted_at is null").
+		Update("deleted_at", time.Now())
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected != 1 {
+		return dto.NotFoundErr("пункт чек-листа не найден")
+	}
+
+	return updateChecklistTotal(tx, taskUUID)
+}
+
+// updateChecklistTotal keeps checklist progress on the task, so lists do not read items.
+func updateChecklistTotal(tx *gorm.DB, taskUUID uuid.UUID) error {
+	return tx.Exec(`
+		UPDATE tasks SET
+			checklist_total = (SELECT count(*) FROM task_checklist_items i WHERE i.task_uuid = tasks.uuid AND i.deleted_at IS NULL),
+			checklist_done = (SELECT count(*) FROM task_checklist_items i WHERE i.task_uuid = tasks.uuid AND i.deleted_at IS NULL AND i.done)
+		WHERE uuid = ?`, taskUUID).Error
+}
+
+func checklistToORM(dm domain.ChecklistItem) ChecklistItem {
+	return ChecklistItem{
+		UUID:       dm.UUID,
+		TaskUUID:   dm.TaskUUID,
+		Text:       dm.Text,
+		Done:       dm.Done,
+		DoneAt:     dm.DoneAt,
+		DoneBy:     dm.DoneBy,
+		AssignedTo: dm.AssignedTo,
+		DueAt:      dm.DueAt,
+		Rank:       dm.Rank,
+		CreatedBy:  dm.CreatedBy,
+		CreatedAt:  dm.CreatedAt,
+		UpdatedAt:  time.Now(),
+	}
+}
+
+func checklistToDomain(orm ChecklistItem) domain.ChecklistItem {
+	return domain.ChecklistItem{
+		UUID:       orm.UUID,
+		TaskUUID:   orm.TaskUUID,
+		Text:       orm.Text,
+		Done:       orm.Done,
+		DoneAt:     orm.DoneAt,
+		DoneBy:     orm.DoneBy,
+		AssignedTo: orm.AssignedTo,
+		DueAt:      orm.DueAt,
+		Rank:       orm.Rank,
+		CreatedBy:  orm.CreatedBy,
+		CreatedAt:  orm.CreatedAt,
+	}
 }
@@ -0,0 +1,122 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// GetTimeline returns tasks of the project with blocks links between them, the epic limits tasks to its subtree.
+func (s *Service) GetTimeline(ctx context.Context, projectUUID uuid.UUID, epicUUID *uuid.UUID) (timeline domain.Timeline, err error) {
+	if epicUUID != nil {
+		epic, err := s.GetTask(ctx, *epicUUID, []string{})
+		if err != nil {
+			return timeline, err
+		}
+
+		if epic.ProjectUUID != projectUUID {
+			return timeline, errors.New("эпик находится в другом проекте")
+		}
+
+		if !epic.IsEpic {
+			return timeline, errors.New("задача не является эпиком")
+		}
+	}
+
+	tasks, err := s.repo.GetTimeline(projectUUID, epicUUID, domain.MaxTimelineTasks)
+	if err != nil {
+		return timeline, err
+	}
+
+	links, err := s.repo.GetLinksBetween(lo.Map(tasks, func(t domain.Task, _ int) uuid.UUID {
+		return t.UUID
+	}))
+	if err != nil {
+		return timeline, err
+	}
+
+	return domain.NewTimeline(tasks, links), nil
+}
+
+// ShiftTask moves dates of the task, with cascade dates of its subtasks are moved too. Tasks without dates are skipped.
+// Dates of all tasks are checked before writing, either all tasks are shifted or none.
+func (s *Service) ShiftTask(ctx context.Context, crtr domain.Creator, task domain.Task, d time.Duration, cascade bool) (shifted []domain.Task, err error) {
+	if d == 0 {
+		return shifted, errors.New("сдвиг не может быть нулевым")
+	}
+
+	tasks := []domain.Task{task}
+	if cascade {
+		subtree, err := s.repo.GetSubtree(task.UUID, domain.MaxTimelineTasks+1)
+		if err != nil {
+			return shifted, err
+		}
+
+		if len(subtree) > domain.MaxTimelineTasks {
+			return shifted, errors.New("слишком много подзадач для переноса")
+		}
+
+		tasks = append(tasks, lo.Filter(subtree, func(t domain.Task, _ int) bool {
+			return t.UUID != task.UUID
+		})...)
+	}
+
+	olds := []domain.Task{}
+	for _, t := range tasks {
+		old := t
+		if !t.Shift(d) {
+			continue
+		}
+
+		err = t.CheckDates()
+		if err != nil {
+			return nil, fmt.Errorf("задача #%d: %w", t.ID, err)
+		}
+
+		olds = append(olds, old)
+		shifted = append(shifted, t)
+	}
+
+	err = s.repo.ShiftTasks(shifted)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, t := range shifted {
+		s.taskWasShifted(ctx, crtr, olds[i], t)
+	}
+
+	return shifted, nil
+}
+
+// taskWasShifted notifies people of the task and records changed dates, errors are only logged.
+func (s *Service) taskWasShifted(ctx context.Context, crtr domain.Creator, old, task domain.Task) {
+	notify := lo.Filter(task.People, func(email string, _ int) bool {
+		return email != crtr.Email
+	})
+
+	err := s.notifyTask(ctx, task.UUID, notify)
+	if err != nil {
+		logrus.WithField("task", task.UUID).Error("shift notify error: ", err)
+	}
+
+	if task.StartAt != nil {
+		_, err = s.as.TaskWasChangedActivity(crtr, task.UUID, "start_at", old.StartAt, task.StartAt)
+		if err != nil {
+			logrus.WithField("task", task.UUID).Error("shift activity error: ", err)
+		}
+	}
+
+	if task.FinishTo != nil {
+		_, err = s.as.TaskWasChangedActivity(crtr, task.UUID, "finish_to", old.FinishTo, task.FinishTo)
+		if err != nil {
+			logrus.WithField("task", task.UUID).Error("shift activity error: ", err)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/activities"
+	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const lockKey = "trash:lock"
+
+// purgeBatch - max files removed from s3 in one run.
+const purgeBatch = 500
+
+type Service struct {
+	repo    *Repository
+	ts      *task.Service
+	as      *activities.Service
+	storage *s3.ServicePrivate
+	rds     *redis.RDS
+}
+
+func New(repo *Repository, ts *task.Service, as *activities.Service, storage *s3.ServicePrivate, rds *redis.RDS) *Service {
+	return &Service{
+		repo:    repo,
+		ts:      ts,
+		as:      as,
+		storage: storage,
+		rds:     rds,
+	}
+}
+
+func (s *Service) GetItems(projectUUID uuid.UUID, itemType string, limit, offset int) (dms []domain.TrashItem, total int64, err error) {
+	if itemType != "" && !lo.Contains(domain.GetTrashTypes(), itemType) {
+		return dms, total, domain.ErrTrashInvalidType
+	}
+
+	return s.repo.GetItems(projectUUID, itemType, limit, offset)
+}
+
+// Restore brings back the item, the task is restored with subtasks, comments and files deleted with it.
+func (s *Service) Restore(crtr domain.Creator, projectUUID uuid.UUID, itemType string, uid uuid.UUID) (item domain.TrashItem, err error) {
+	if !lo.Contains(domain.GetTrashTypes(), itemType) {
+		return item, domain.ErrTrashInvalidType
+	}
+
+	item, err = s.repo.GetItem(projectUUID, itemType, uid)
+	if err != nil {
+		return item, err
+	}
+
+	switch item.Type {
+	case domain.TrashTask:
+		uuids, rootUUID, err := s.repo.RestoreTask(item)
+		if err != nil {
+			return item, err
+		}
+
+		err = s.ts.UpdateChildTotal(rootUUID)
+		if err != nil {
+			return item, err
+		}
+
+		for _, u := range uuids {
+			s.ts.ResetCache(u)
+		}
+
+		restored, err := s.ts.GetTask(context.TODO(), item.UUID, []string{})
+		if err != nil {
+			return item, err
+		}
+
+		err = s.ts.UpdateEstimateTotal(restored)
+		if err != nil {
+			return item, err
+		}
+	case domain.TrashComment:
+		err = s.repo.RestoreComment(item)
+	case domain.TrashFile:
+		err = s.repo.RestoreFile(item)
+	}
+
+	if err != nil {
+		return item, err
+	}
+
+	s.ts.ResetCache(item.TaskUUID)
+
+	_, err = s.as.TaskWasRestored(crtr, item.TaskUUID, item)
+
+	return item, err
+}
+
+// Purge removes items deleted before the retention time: s3 objects first, then rows.
+// The run is guarded by redis lock for the interval, so only one instance purges.
+func (s *Service) Purge(ctx context.Context, now time.Time, retentionDays int, interval time.Duration) (purged int64, err error) {
+	locked, err := s.rds.SetNX(ctx, lockKey, now.Format(time.RFC3339), int(interval/time.Second))
+	if err != nil || !locked {
+		return purged, err
+	}
+
+	before := domain.TrashPurgeBefore(now, retentionDays)
+
+	files, err := s.repo.GetExpiredFiles(before, purgeBatch)
+	if err != nil {
+		return purged, err
+	}
+
+	for _, file := range files {
+		// the row is kept if the object is not removed, it is tried again on the next run
+		err := s.storage.DeleteFile(file)
+		if err != nil {
+			logrus.WithField("file", file.UUID).Error("trash purge error: ", err)
+			continue
+		}
+
+		err = s.repo.DeleteFile(file.UUID)
+		if err != nil {
+			return purged, err
+		}
+
+		purged++
+	}
+
+	comments, tasks, err := s.repo.Purge(before)
+
+	return purged + comments + tasks, err
+}
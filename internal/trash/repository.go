@@ -0,0 +1,309 @@
+package trash
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm"
+)
+
+// itemsSQL lists trash of the project. Subtasks deleted with the parent (same deleted_at) are not listed,
+// comments and files are listed while their task is not deleted, files of deleted comments too.
+// Files with to_deleted_at are already removed from s3, they can not be restored.
+const itemsSQL = `
+	SELECT 'task' AS type, t.uuid, t.name, t.uuid AS task_uuid, t.deleted_at
+	FROM tasks t
+	WHERE t.project_uuid = @project AND t.deleted_at IS NOT NULL
+	  AND NOT EXISTS (
+		SELECT 1 FROM tasks p
+		WHERE nlevel(t.path) > 1
+		  AND p.uuid::text = subpath(t.path, nlevel(t.path) - 2, 1)::text
+		  AND p.deleted_at = t.deleted_at
+	  )
+	UNION ALL
+	SELECT 'comment', c.uuid, left(c.comment, 100), c.task_uuid, c.deleted_at
+	FROM comments c
+	JOIN tasks t ON t.uuid = c.task_uuid AND t.deleted_at IS NULL
+	WHERE t.project_uuid = @project AND c.deleted_at IS NOT NULL
+	UNION ALL
+	SELECT 'file', f.uuid, f.name, t.uuid, f.deleted_at
+	FROM files f
+	LEFT JOIN comments c ON f.type = 'comment' AND c.uuid = f.type_uuid
+	JOIN tasks t ON t.uuid = (CASE WHEN f.type = 'task' THEN f.type_uuid ELSE c.task_uuid END) AND t.deleted_at IS NULL
+	WHERE t.project_uuid = @project AND f.deleted_at IS NOT NULL AND f.to_deleted_at IS NULL
+	  AND (f.type = 'task' OR (f.type = 'comment' AND c.deleted_at IS NULL))`
+
+// expiredTasksSQL - tasks deleted before the retention time.
+const expiredTasksSQL = `SELECT uuid FROM tasks WHERE deleted_at < @before`
+
+// commentsTotalSQL recounts comments of the tasks after restore.
+const commentsTotalSQL = `
+	UPDATE tasks SET comments_total = (SELECT count(*) FROM comments c WHERE c.task_uuid = tasks.uuid AND c.deleted_at IS NULL)
+	WHERE uuid IN ?`
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+type trashItem struct {
+	Type      string
+	UUID      uuid.UUID
+	Name      string
+	TaskUUID  uuid.UUID
+	DeletedAt time.Time
+}
+
+func (r *Repository) GetItems(projectUUID uuid.UUID, itemType string, limit, offset int) (dms []domain.TrashItem, total int64, err error) {
+	args := map[string]interface{}{
+		"project": projectUUID,
+		"type":    itemType,
+		"limit":   limit,
+		"offset":  offset,
+	}
+
+	err = r.gorm.DB.Raw(`SELECT count(*) FROM (`+itemsSQL+`) items WHERE (@type = '' OR type = @type)`, args).
+		Scan(&total).Error
+	if err != nil {
+		return dms, total, err
+	}
+
+	orm := []trashItem{}
+
+	err = r.gorm.DB.Raw(`SELECT * FROM (`+itemsSQL+`) items WHERE (@type = '' OR type = @type)
+		ORDER BY deleted_at DESC, uuid LIMIT @limit OFFSET @offset`, args).
+		Scan(&orm).Error
+
+	for _, item := range orm {
+		dms = append(dms, toDomain(item))
+	}
+
+	return dms, total, err
+}
+
+func (r *Repository) GetItem(projectUUID uuid.UUID, itemType string, uid uuid.UUID) (dm domain.TrashItem, err error) {
+	orm := []trashItem{}
+
+	err = r.gorm.DB.Raw(`SELECT * FROM (`+itemsSQL+`) items WHERE type = @type AND uuid = @uuid`, map[string]interface{}{
+		"project": projectUUID,
+		"type":    itemType,
+		"uuid":    uid,
+	}).Scan(&orm).Error
+	if err != nil {
+		return dm, err
+	}
+
+	if len(orm) == 0 {
+		return dm, dto.NotFoundErr("элемент не найден в корзине")
+	}
+
+	return toDomain(orm[0]), nil
+}
+
+// RestoreTask restores the task with subtasks deleted together with it, their comments and files.
+// The task is linked to the parent by its current path, it becomes a root task if the parent is deleted.
+func (r *Repository) RestoreTask(item domain.TrashItem) (uuids []uuid.UUID, rootUUID uuid.UUID, err error) {
+	rootUUID = item.UUID
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		var parentPath string
+
+		err := tx.Raw(`
+			SELECT coalesce(min(p.path::text), '') FROM tasks t
+			JOIN tasks p ON p.uuid::text = subpath(t.path, nlevel(t.path) - 2, 1)::text AND p.deleted_at IS NULL
+			WHERE t.uuid = ? AND nlevel(t.path) > 1`, item.UUID).
+			Scan(&parentPath).Error
+		if err != nil {
+			return err
+		}
+
+		if parentPath != "" {
+			rootUUID, err = uuid.Parse(strings.Split(parentPath, ".")[0])
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Raw(`SELECT uuid FROM tasks WHERE path ~ ? AND deleted_at = ?`, "*."+item.UUID.String()+".*", item.DeletedAt).
+			Scan(&uuids).Error
+		if err != nil {
+			return err
+		}
+
+		if len(uuids) == 0 {
+			return dto.NotFoundErr("задача не найдена в корзине")
+		}
+
+		commentUUIDs := []uuid.UUID{}
+
+		err = tx.Raw(`SELECT uuid FROM comments WHERE task_uuid IN ? AND deleted_at >= ?`, uuids, item.DeletedAt).
+			Scan(&commentUUIDs).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`
+			UPDATE files SET deleted_at = NULL
+			WHERE deleted_at >= ? AND to_deleted_at IS NULL
+			  AND ((type = 'task' AND type_uuid IN ?) OR (type = 'comment' AND type_uuid IN ?))`,
+			item.DeletedAt, uuids, commentUUIDs).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`UPDATE comments SET deleted_at = NULL WHERE uuid IN ?`, commentUUIDs).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(commentsTotalSQL, uuids).Error
+		if err != nil {
+			return err
+		}
+
+		// the parent could be moved or deleted, the path of the subtree is built again
+		return tx.Exec(`
+			UPDATE tasks SET deleted_at = NULL, path = ?::ltree || subpath(path, index(path, ?::ltree))
+			WHERE uuid IN ?`, parentPath, item.UUID.String(), uuids).Error
+	})
+
+	return uuids, rootUUID, err
+}
+
+// RestoreComment restores the comment with files deleted together with it.
+func (r *Repository) RestoreComment(item domain.TrashItem) error {
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`
+			UPDATE files SET deleted_at = NULL
+			WHERE type = 'comment' AND type_uuid = ? AND deleted_at >= ? AND to_deleted_at IS NULL`,
+			item.UUID, item.DeletedAt).Error
+		if err != nil {
+			return err
+		}
+
+		res := tx.Exec(`UPDATE comments SET deleted_at = NULL WHERE uuid = ? AND deleted_at IS NOT NULL`, item.UUID)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return dto.NotFoundErr("комментарий не найден в корзине")
+		}
+
+		return tx.Exec(commentsTotalSQL, []uuid.UUID{item.TaskUUID}).Error
+	})
+}
+
+func (r *Repository) RestoreFile(item domain.TrashItem) error {
+	res := r.gorm.DB.Exec(`UPDATE files SET deleted_at = NULL WHERE uuid = ? AND deleted_at IS NOT NULL AND to_deleted_at IS NULL`, item.UUID)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("файл не найден в корзине")
+	}
+
+	return nil
+}
+
+// GetExpiredFiles returns files of tasks and comments which should be removed from s3:
+// deleted before the time or belonging to the expired task or comment.
+func (r *Repository) GetExpiredFiles(before time.Time, limit int) (files []s3.File, err error) {
+	err = r.gorm.DB.Raw(`
+		SELECT f.* FROM files f
+		WHERE f.to_deleted_at IS NULL AND f.type IN ('task', 'comment')
+		  AND (
+			f.deleted_at < @before
+			OR (f.type = 'task' AND f.type_uuid IN (`+expiredTasksSQL+`))
+			OR (f.type = 'comment' AND f.type_uuid IN (
+				SELECT c.uuid FROM comments c
+				WHERE c.deleted_at < @before OR c.task_uuid IN (`+expiredTasksSQL+`)
+			))
+		  )
+		LIMIT @limit`, map[string]interface{}{
+		"before": before,
+		"limit":  limit,
+	}).Scan(&files).Error
+
+	return files, err
+}
+
+func (r *Repository) DeleteFile(uid uuid.UUID) error {
+	return r.gorm.DB.Exec(`DELETE FROM files WHERE uuid = ?`, uid).Error
+}
+
+// Purge removes expired comments, tasks and rows of the tasks: links, worklogs, reminders, checklists,
+// automation runs and activities. Rows with files still stored in s3 are kept
+// till the next run, so no object is left without a row.
+func (r *Repository) Purge(before time.Time) (comments, tasks int64, err error) {
+	args := map[string]interface{}{"before": before}
+
+	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		res := tx.Exec(`
+			DELETE FROM comments c
+			WHERE (c.deleted_at < @before OR c.task_uuid IN (`+expiredTasksSQL+`))
+			  AND NOT EXISTS (SELECT 1 FROM files f WHERE f.type = 'comment' AND f.type_uuid = c.uuid AND f.to_deleted_at IS NULL)`,
+			args)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		comments = res.RowsAffected
+
+		uuids := []uuid.UUID{}
+
+		err := tx.Raw(`
+			DELETE FROM tasks t
+			WHERE t.deleted_at < @before
+			  AND NOT EXISTS (SELECT 1 FROM files f WHERE f.type = 'task' AND f.type_uuid = t.uuid AND f.to_deleted_at IS NULL)
+			  AND NOT EXISTS (SELECT 1 FROM comments c WHERE c.task_uuid = t.uuid)
+			RETURNING t.uuid`, args).
+			Scan(&uuids).Error
+		if err != nil {
+			return err
+		}
+
+		tasks = int64(len(uuids))
+
+		if len(uuids) == 0 {
+			return nil
+		}
+
+		err = tx.Exec(`DELETE FROM task_links WHERE from_uuid IN ? OR to_uuid IN ?`, uuids, uuids).Error
+		if err != nil {
+			return err
+		}
+
+		for _, table := range []string{"worklogs", "reminders", "task_checklist_items", "automation_runs"} {
+			err = tx.Exec(`DELETE FROM `+table+` WHERE task_uuid IN ?`, uuids).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Exec(`DELETE FROM activities WHERE entity_type = 'task' AND entity_uuid IN ?`, uuids).Error
+	})
+
+	return comments, tasks, err
+}
+
+func toDomain(orm trashItem) domain.TrashItem {
+	return domain.TrashItem{
+		Type:      orm.Type,
+		UUID:      orm.UUID,
+		Name:      orm.Name,
+		TaskUUID:  orm.TaskUUID,
+		DeletedAt: orm.DeletedAt,
+	}
+}
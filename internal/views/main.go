@@ -0,0 +1,69 @@
+package views
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type Service struct {
+	repo *Repository
+}
+
+func New(repo *Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+func (s *Service) Create(dm domain.TaskView) error {
+	return s.repo.Create(dm)
+}
+
+func (s *Service) Get(uid, userUUID uuid.UUID) (dm domain.TaskView, err error) {
+	dm, err = s.repo.Get(uid)
+	if err != nil {
+		return dm, err
+	}
+
+	if !dm.CanRead(userUUID) {
+		return dm, domain.ErrTaskViewForbidden
+	}
+
+	return dm, nil
+}
+
+func (s *Service) GetByProject(projectUUID, userUUID uuid.UUID) ([]domain.TaskView, error) {
+	return s.repo.GetByProject(projectUUID, userUUID)
+}
+
+// Update replaces view settings, only the author can change the view.
+func (s *Service) Update(userUUID uuid.UUID, dm domain.TaskView) error {
+	current, err := s.repo.Get(dm.UUID)
+	if err != nil {
+		return err
+	}
+
+	if !current.CanEdit(userUUID) {
+		return domain.ErrTaskViewForbidden
+	}
+
+	err = dm.Validate()
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Update(dm)
+}
+
+func (s *Service) Delete(uid, userUUID uuid.UUID) error {
+	current, err := s.repo.Get(uid)
+	if err != nil {
+		return err
+	}
+
+	if !current.CanEdit(userUUID) {
+		return domain.ErrTaskViewForbidden
+	}
+
+	return s.repo.Delete(uid)
+}
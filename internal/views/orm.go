@@ -0,0 +1,26 @@
+package views
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type TaskView struct {
+	UUID           uuid.UUID `gorm:"<-:create;type:uuid;primary_key"`
+	FederationUUID uuid.UUID `gorm:"<-:create;type:uuid"`
+	ProjectUUID    uuid.UUID `gorm:"<-:create;type:uuid"`
+	CreatedBy      uuid.UUID `gorm:"<-:create;type:uuid"`
+
+	Name     string         `gorm:"type:varchar(100)"`
+	IsShared bool           `gorm:"type:boolean"`
+	Filter   datatypes.JSON `gorm:"type:jsonb"`
+	Order    *string        `gorm:"type:varchar(50)"`
+	By       *string        `gorm:"type:varchar(4)"`
+	Columns  datatypes.JSON `gorm:"type:jsonb"`
+
+	CreatedAt time.Time `gorm:"<-:create;type:timestamptz"`
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
@@ -0,0 +1,173 @@
+package views
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Create(dm domain.TaskView) error {
+	orm, err := toORM(dm)
+	if err != nil {
+		return err
+	}
+
+	return r.gorm.DB.Create(&orm).Error
+}
+
+func (r *Repository) Get(uid uuid.UUID) (dm domain.TaskView, err error) {
+	orm := TaskView{}
+
+	res := r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at IS NULL").
+		Find(&orm)
+
+	if res.Error != nil {
+		return dm, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dm, dto.NotFoundErr("представление не найдено")
+	}
+
+	return toDomain(orm), nil
+}
+
+// GetByProject returns own and shared views of the project.
+func (r *Repository) GetByProject(projectUUID, userUUID uuid.UUID) (dms []domain.TaskView, err error) {
+	orm := []TaskView{}
+
+	err = r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Where("created_by = ? OR is_shared = true", userUUID).
+		Where("deleted_at IS NULL").
+		Order("name ASC").
+		Find(&orm).
+		Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	return lo.Map(orm, func(item TaskView, _ int) domain.TaskView {
+		return toDomain(item)
+	}), nil
+}
+
+func (r *Repository) Update(dm domain.TaskView) error {
+	orm, err := toORM(dm)
+	if err != nil {
+		return err
+	}
+
+	res := r.gorm.DB.
+		Model(&TaskView{}).
+		Where("uuid = ?", dm.UUID).
+		Where("deleted_at IS NULL").
+		Updates(map[string]interface{}{
+			"name":       orm.Name,
+			"is_shared":  orm.IsShared,
+			"filter":     orm.Filter,
+			"order":      orm.Order,
+			"by":         orm.By,
+			"columns":    orm.Columns,
+			"updated_at": "now()",
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("представление не найдено")
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&TaskView{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at IS NULL").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("представление не найдено")
+	}
+
+	return nil
+}
+
+func toORM(dm domain.TaskView) (orm TaskView, err error) {
+	filter, err := json.Marshal(dm.Filter)
+	if err != nil {
+		return orm, err
+	}
+
+	columns, err := json.Marshal(lo.Ternary(dm.Columns == nil, []string{}, dm.Columns))
+	if err != nil {
+		return orm, err
+	}
+
+	return TaskView{
+		UUID:           dm.UUID,
+		FederationUUID: dm.FederationUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		CreatedBy:      dm.CreatedByUUID,
+		Name:           dm.Name,
+		IsShared:       dm.IsShared,
+		Filter:         filter,
+		Order:          dm.Order,
+		By:             dm.By,
+		Columns:        columns,
+		CreatedAt:      dm.CreatedAt,
+	}, nil
+}
+
+func toDomain(orm TaskView) domain.TaskView {
+	dm := domain.TaskView{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		ProjectUUID:    orm.ProjectUUID,
+		CreatedByUUID:  orm.CreatedBy,
+		Name:           orm.Name,
+		IsShared:       orm.IsShared,
+		Order:          orm.Order,
+		By:             orm.By,
+		CreatedAt:      orm.CreatedAt,
+		UpdatedAt:      orm.UpdatedAt,
+	}
+
+	err := json.Unmarshal(orm.Filter, &dm.Filter)
+	if err != nil {
+		logrus.WithField("uuid", orm.UUID).Error("task view filter unmarshal error: ", err)
+	}
+
+	err = json.Unmarshal(orm.Columns, &dm.Columns)
+	if err != nil {
+		logrus.WithField("uuid", orm.UUID).Error("task view columns unmarshal error: ", err)
+	}
+
+	return dm
+}
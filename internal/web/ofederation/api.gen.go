@@ -22,6 +22,39 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for ProjectRequestOptionsEstimateUnit.
+const (
+	Hours  ProjectRequestOptionsEstimateUnit = "hours"
+	Points ProjectRequestOptionsEstimateUnit = "points"
+)
+
+// Defines values for GetProjectUUIDGraphExportParamsFormat.
+const (
+	Dot     GetProjectUUIDGraphExportParamsFormat = "dot"
+	Mermaid GetProjectUUIDGraphExportParamsFormat = "mermaid"
+)
+
+// Defines values for GetProjectUUIDReportCycleTimeParamsGroupBy.
+const (
+	ImplementBy GetProjectUUIDReportCycleTimeParamsGroupBy = "implement_by"
+	Priority    GetProjectUUIDReportCycleTimeParamsGroupBy = "priority"
+	Tag         GetProjectUUIDReportCycleTimeParamsGroupBy = "tag"
+)
+
+// Defines values for GetProjectUUIDTrashParamsType.
+const (
+	GetProjectUUIDTrashParamsTypeComment GetProjectUUIDTrashParamsType = "comment"
+	GetProjectUUIDTrashParamsTypeFile    GetProjectUUIDTrashParamsType = "file"
+	GetProjectUUIDTrashParamsTypeTask    GetProjectUUIDTrashParamsType = "task"
+)
+
+// Defines values for PostProjectUUIDTrashEntityUUIDRestoreParamsType.
+const (
+	PostProjectUUIDTrashEntityUUIDRestoreParamsTypeComment PostProjectUUIDTrashEntityUUIDRestoreParamsType = "comment"
+	PostProjectUUIDTrashEntityUUIDRestoreParamsTypeFile    PostProjectUUIDTrashEntityUUIDRestoreParamsType = "file"
+	PostProjectUUIDTrashEntityUUIDRestoreParamsTypeTask    PostProjectUUIDTrashEntityUUIDRestoreParamsType = "task"
+)
+
 // AddGroupRequest defines model for AddGroupRequest.
 type AddGroupRequest struct {
 	Name string `json:"name" validate:"trim,name,min=3,max=100"`
@@ -49,6 +82,30 @@ type AgentPatchRequest struct {
 	Name string `json:"name" validate:"trim,name,min=3,max=100"`
 }
 
+// AutomationAction defines model for AutomationAction.
+type AutomationAction = domain.AutomationAction
+
+// AutomationCondition defines model for AutomationCondition.
+type AutomationCondition = domain.AutomationCondition
+
+// AutomationRuleBody defines model for AutomationRuleBody.
+type AutomationRuleBody struct {
+	Actions    []AutomationAction     `json:"actions"`
+	Conditions *[]AutomationCondition `json:"conditions,omitempty"`
+	Enabled    *bool                  `json:"enabled,omitempty"`
+	Name       string                 `json:"name" validate:"trim,min=1,max=100"`
+	Trigger    AutomationTrigger      `json:"trigger"`
+}
+
+// AutomationRuleDTO defines model for AutomationRuleDTO.
+type AutomationRuleDTO = dto.AutomationRuleDTO
+
+// AutomationRunDTO defines model for AutomationRunDTO.
+type AutomationRunDTO = dto.AutomationRunDTO
+
+// AutomationTrigger defines model for AutomationTrigger.
+type AutomationTrigger = domain.AutomationTrigger
+
 // BankAccountDTO defines model for BankAccountDTO.
 type BankAccountDTO struct {
 	AccountNumber        *string             `json:"account_number,omitempty"`
@@ -65,6 +122,12 @@ type BankAccountDTO struct {
 	Uuid                 *openapi_types.UUID `json:"uuid,omitempty"`
 }
 
+// BurndownPointDTO defines model for BurndownPointDTO.
+type BurndownPointDTO = dto.BurndownPointDTO
+
+// BurnupPointDTO defines model for BurnupPointDTO.
+type BurnupPointDTO = dto.BurnupPointDTO
+
 // CompanyAddUserRequest defines model for CompanyAddUserRequest.
 type CompanyAddUserRequest struct {
 	UserUuid openapi_types.UUID `json:"user_uuid" validate:"uuid"`
@@ -91,6 +154,18 @@ type CreateLegalEntityRequest struct {
 	Name string `json:"name"`
 }
 
+// CycleTimeGroupDTO defines model for CycleTimeGroupDTO.
+type CycleTimeGroupDTO = dto.CycleTimeGroupDTO
+
+// CycleTimeReportDTO defines model for CycleTimeReportDTO.
+type CycleTimeReportDTO = dto.CycleTimeReportDTO
+
+// DurationStatsDTO Seconds
+type DurationStatsDTO = dto.DurationStatsDTO
+
+// EscalationLevel defines model for EscalationLevel.
+type EscalationLevel = domain.EscalationLevel
+
 // FederationAddUserRequest defines model for FederationAddUserRequest.
 type FederationAddUserRequest struct {
 	UserUuid openapi_types.UUID `json:"user_uuid" validate:"uuid"`
@@ -110,6 +185,9 @@ type FederationCreateRequest struct {
 // FederationDTO defines model for FederationDTO.
 type FederationDTO = dto.FederationDTO
 
+// FieldRule defines model for FieldRule.
+type FieldRule = domain.FieldRule
+
 // GroupDTO defines model for GroupDTO.
 type GroupDTO = dto.GroupDTO
 
@@ -183,12 +261,15 @@ type ProjectDTOs = dto.ProjectDTOs
 
 // ProjectFieldCreateRequest defines model for ProjectFieldCreateRequest.
 type ProjectFieldCreateRequest struct {
-	DataType           domain.FieldDataType `json:"data_type" validate:"min=0,max=14"`
-	DataUuid           *openapi_types.UUID  `json:"data_uuid,omitempty" validate:"omitempty,uuid"`
-	Description        string               `json:"description" validate:"trim,max=5000"`
-	Icon               string               `json:"icon" validate:"trim,omitempty,lte=50"`
-	Name               string               `json:"name" validate:"trim,name,min=1,max=50"`
-	RequiredOnStatuses []int                `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
+	DataType    domain.FieldDataType `json:"data_type" validate:"min=0,max=15"`
+	DataUuid    *openapi_types.UUID  `json:"data_uuid,omitempty" validate:"omitempty,uuid"`
+	Description string               `json:"description" validate:"trim,max=5000"`
+
+	// Formula Expression of the formula field (data_type 15) over number and datetime fields by hash, e.g. `a * b` or `days(c, d)`
+	Formula            *string `json:"formula,omitempty" validate:"omitempty,max=500"`
+	Icon               string  `json:"icon" validate:"trim,omitempty,lte=50"`
+	Name               string  `json:"name" validate:"trim,name,min=1,max=50"`
+	RequiredOnStatuses []int   `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
 }
 
 // ProjectFieldPutRequest defines model for ProjectFieldPutRequest.
@@ -201,12 +282,24 @@ type ProjectFieldPutRequest struct {
 
 // ProjectRequestOptions defines model for ProjectRequestOptions.
 type ProjectRequestOptions struct {
-	Color                     *string `json:"color,omitempty" validate:"omitempty,color"`
-	RequireCancelationComment *bool   `json:"require_cancelation_comment,omitempty"`
-	RequireDoneComment        *bool   `json:"require_done_comment,omitempty"`
-	StatusEnable              *bool   `json:"status_enable,omitempty"`
+	Color *string `json:"color,omitempty" validate:"omitempty,color"`
+
+	// Escalations Deadline notification levels, empty array disables notifications, default levels are used when not set
+	Escalations *[]EscalationLevel `json:"escalations,omitempty"`
+
+	// EstimateUnit Unit of task estimates, points are used when not set
+	EstimateUnit *ProjectRequestOptionsEstimateUnit `json:"estimate_unit,omitempty"`
+
+	// FieldRules Custom fields required to move the task to statuses or read-only in statuses, empty array removes rules
+	FieldRules                *[]FieldRule `json:"field_rules,omitempty"`
+	RequireCancelationComment *bool        `json:"require_cancelation_comment,omitempty"`
+	RequireDoneComment        *bool        `json:"require_done_comment,omitempty"`
+	StatusEnable              *bool        `json:"status_enable,omitempty"`
 }
 
+// ProjectRequestOptionsEstimateUnit Unit of task estimates, points are used when not set
+type ProjectRequestOptionsEstimateUnit string
+
 // ProjectRequestParams defines model for ProjectRequestParams.
 type ProjectRequestParams struct {
 	Description   *string   `json:"description,omitempty" validate:"omitempty,trim,max=5000"`
@@ -237,6 +330,9 @@ type SearchUserRequest struct {
 // SmsDTO defines model for SmsDTO.
 type SmsDTO = dto.SmsDTO
 
+// StatusGraphIssue defines model for StatusGraphIssue.
+type StatusGraphIssue = domain.StatusGraphIssue
+
 // SurveyCreateRequest defines model for SurveyCreateRequest.
 type SurveyCreateRequest struct {
 	Body map[string]interface{} `json:"body"`
@@ -256,6 +352,15 @@ type TagCreateRequest struct {
 // TagDTO defines model for TagDTO.
 type TagDTO = dto.TagDTO
 
+// TimelineDTO defines model for TimelineDTO.
+type TimelineDTO = dto.TimelineDTO
+
+// TimelineTaskDTO defines model for TimelineTaskDTO.
+type TimelineTaskDTO = dto.TimelineTaskDTO
+
+// TrashItemDTO defines model for TrashItemDTO.
+type TrashItemDTO = dto.TrashItemDTO
+
 // UUIDResponse defines model for UUIDResponse.
 type UUIDResponse struct {
 	Uuid openapi_types.UUID `json:"uuid"`
@@ -280,6 +385,9 @@ type UpdateLegalEntityRequest struct {
 // UserDTO defines model for UserDTO.
 type UserDTO = dto.UserDTO
 
+// WorkloadDTO defines model for WorkloadDTO.
+type WorkloadDTO = dto.WorkloadDTO
+
 // EntityName defines model for entityName.
 type EntityName = string
 
@@ -355,6 +463,14 @@ type GetFederationUUIDProjectParams struct {
 	CompanyUuid *openapi_types.UUID `form:"company_uuid,omitempty" json:"company_uuid,omitempty"`
 }
 
+// GetFederationUUIDWorkloadParams defines parameters for GetFederationUUIDWorkload.
+type GetFederationUUIDWorkloadParams struct {
+	CompanyUuid *openapi_types.UUID `form:"company_uuid,omitempty" json:"company_uuid,omitempty"`
+
+	// GroupUuid requires company_uuid
+	GroupUuid *openapi_types.UUID `form:"group_uuid,omitempty" json:"group_uuid,omitempty"`
+}
+
 // DeleteGroupUUIDUserJSONBody defines parameters for DeleteGroupUUIDUser.
 type DeleteGroupUUIDUserJSONBody struct {
 	Uuid openapi_types.UUID `json:"uuid" validate:"uuid"`
@@ -365,6 +481,18 @@ type PostGroupUUIDUserJSONBody struct {
 	Uuid openapi_types.UUID `json:"uuid" validate:"uuid"`
 }
 
+// GetProjectUUIDBurndownParams defines parameters for GetProjectUUIDBurndown.
+type GetProjectUUIDBurndownParams struct {
+	DateFrom time.Time `form:"date_from" json:"date_from"`
+	DateTo   time.Time `form:"date_to" json:"date_to"`
+}
+
+// GetProjectUUIDBurnupParams defines parameters for GetProjectUUIDBurnup.
+type GetProjectUUIDBurnupParams struct {
+	DateFrom time.Time `form:"date_from" json:"date_from"`
+	DateTo   time.Time `form:"date_to" json:"date_to"`
+}
+
 // PostProjectUUIDCatalogJSONBody defines parameters for PostProjectUUIDCatalog.
 type PostProjectUUIDCatalogJSONBody struct {
 	CatalogName domain.ProjectCatalogType `json:"catalog_name" validate:"trim,required,eq=reasons|eq=reasons"`
@@ -387,6 +515,24 @@ type PatchProjectUUIDGraphJSONBody struct {
 	Graph map[string]interface{} `json:"graph"`
 }
 
+// GetProjectUUIDGraphExportParams defines parameters for GetProjectUUIDGraphExport.
+type GetProjectUUIDGraphExportParams struct {
+	Format GetProjectUUIDGraphExportParamsFormat `form:"format" json:"format"`
+}
+
+// GetProjectUUIDGraphExportParamsFormat defines parameters for GetProjectUUIDGraphExport.
+type GetProjectUUIDGraphExportParamsFormat string
+
+// GetProjectUUIDReportCycleTimeParams defines parameters for GetProjectUUIDReportCycleTime.
+type GetProjectUUIDReportCycleTimeParams struct {
+	DateFrom time.Time                                   `form:"date_from" json:"date_from"`
+	DateTo   time.Time                                   `form:"date_to" json:"date_to"`
+	GroupBy  *GetProjectUUIDReportCycleTimeParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetProjectUUIDReportCycleTimeParamsGroupBy defines parameters for GetProjectUUIDReportCycleTime.
+type GetProjectUUIDReportCycleTimeParamsGroupBy string
+
 // PatchProjectUUIDStatusEntityUUIDJSONBody defines parameters for PatchProjectUUIDStatusEntityUUID.
 type PatchProjectUUIDStatusEntityUUIDJSONBody struct {
 	Color       string `json:"color" validate:"color"`
@@ -394,6 +540,29 @@ type PatchProjectUUIDStatusEntityUUIDJSONBody struct {
 	Name        string `json:"name" validate:"trim,min=1,max=50"`
 }
 
+// GetProjectUUIDTimelineParams defines parameters for GetProjectUUIDTimeline.
+type GetProjectUUIDTimelineParams struct {
+	EpicUuid *openapi_types.UUID `form:"epic_uuid,omitempty" json:"epic_uuid,omitempty"`
+}
+
+// GetProjectUUIDTrashParams defines parameters for GetProjectUUIDTrash.
+type GetProjectUUIDTrashParams struct {
+	Type   *GetProjectUUIDTrashParamsType `form:"type,omitempty" json:"type,omitempty"`
+	Offset *int                           `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int                           `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetProjectUUIDTrashParamsType defines parameters for GetProjectUUIDTrash.
+type GetProjectUUIDTrashParamsType string
+
+// PostProjectUUIDTrashEntityUUIDRestoreParams defines parameters for PostProjectUUIDTrashEntityUUIDRestore.
+type PostProjectUUIDTrashEntityUUIDRestoreParams struct {
+	Type PostProjectUUIDTrashEntityUUIDRestoreParamsType `form:"type" json:"type"`
+}
+
+// PostProjectUUIDTrashEntityUUIDRestoreParamsType defines parameters for PostProjectUUIDTrashEntityUUIDRestore.
+type PostProjectUUIDTrashEntityUUIDRestoreParamsType string
+
 // GetTagParams defines parameters for GetTag.
 type GetTagParams struct {
 	CompanyUuid openapi_types.UUID `form:"company_uuid" json:"company_uuid"`
@@ -489,6 +658,12 @@ type PostProjectJSONRequestBody = ProjectCreateRequest
 // PatchProjectUUIDJSONRequestBody defines body for PatchProjectUUID for application/json ContentType.
 type PatchProjectUUIDJSONRequestBody = ProjectRequestParams
 
+// PostProjectUUIDAutomationJSONRequestBody defines body for PostProjectUUIDAutomation for application/json ContentType.
+type PostProjectUUIDAutomationJSONRequestBody = AutomationRuleBody
+
+// PutProjectUUIDAutomationEntityUUIDJSONRequestBody defines body for PutProjectUUIDAutomationEntityUUID for application/json ContentType.
+type PutProjectUUIDAutomationEntityUUIDJSONRequestBody = AutomationRuleBody
+
 // PostProjectUUIDCatalogJSONRequestBody defines body for PostProjectUUIDCatalog for application/json ContentType.
 type PostProjectUUIDCatalogJSONRequestBody PostProjectUUIDCatalogJSONBody
 
@@ -654,6 +829,9 @@ type ServerInterface interface {
 	// (DELETE /federation/{UUID}/user/{userUUID})
 	DeleteFederationUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error
 
+	// (GET /federation/{UUID}/workload)
+	GetFederationUUIDWorkload(ctx echo.Context, uUID Uuid, params GetFederationUUIDWorkloadParams) error
+
 	// (DELETE /group/{UUID}/user)
 	DeleteGroupUUIDUser(ctx echo.Context, uUID Uuid) error
 
@@ -705,6 +883,27 @@ type ServerInterface interface {
 	// (PATCH /project/{UUID})
 	PatchProjectUUID(ctx echo.Context, uUID Uuid) error
 
+	// (GET /project/{UUID}/automation)
+	GetProjectUUIDAutomation(ctx echo.Context, uUID Uuid) error
+
+	// (POST /project/{UUID}/automation)
+	PostProjectUUIDAutomation(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /project/{UUID}/automation/{entityUUID})
+	DeleteProjectUUIDAutomationEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PUT /project/{UUID}/automation/{entityUUID})
+	PutProjectUUIDAutomationEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /project/{UUID}/automation/{entityUUID}/runs)
+	GetProjectUUIDAutomationEntityUUIDRuns(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /project/{UUID}/burndown)
+	GetProjectUUIDBurndown(ctx echo.Context, uUID Uuid, params GetProjectUUIDBurndownParams) error
+
+	// (GET /project/{UUID}/burnup)
+	GetProjectUUIDBurnup(ctx echo.Context, uUID Uuid, params GetProjectUUIDBurnupParams) error
+
 	// (GET /project/{UUID}/catalog)
 	GetProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error
 
@@ -726,15 +925,24 @@ type ServerInterface interface {
 	// (POST /project/{UUID}/field/{entityUUID})
 	PostProjectUUIDFieldEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (GET /project/{UUID}/graph)
+	GetProjectUUIDGraph(ctx echo.Context, uUID Uuid) error
+
 	// (PATCH /project/{UUID}/graph)
 	PatchProjectUUIDGraph(ctx echo.Context, uUID Uuid) error
 
+	// (GET /project/{UUID}/graph/export)
+	GetProjectUUIDGraphExport(ctx echo.Context, uUID Uuid, params GetProjectUUIDGraphExportParams) error
+
 	// (PATCH /project/{UUID}/name)
 	PatchProjectUUIDName(ctx echo.Context, uUID Uuid) error
 
 	// (PATCH /project/{UUID}/options)
 	PatchProjectUUIDOptions(ctx echo.Context, uUID Uuid) error
 
+	// (GET /project/{UUID}/report/cycle-time)
+	GetProjectUUIDReportCycleTime(ctx echo.Context, uUID Uuid, params GetProjectUUIDReportCycleTimeParams) error
+
 	// (GET /project/{UUID}/status)
 	GetProjectUUIDStatus(ctx echo.Context, uUID Uuid) error
 
@@ -747,6 +955,15 @@ type ServerInterface interface {
 	// (PATCH /project/{UUID}/status/{entityUUID})
 	PatchProjectUUIDStatusEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (GET /project/{UUID}/timeline)
+	GetProjectUUIDTimeline(ctx echo.Context, uUID Uuid, params GetProjectUUIDTimelineParams) error
+
+	// (GET /project/{UUID}/trash)
+	GetProjectUUIDTrash(ctx echo.Context, uUID Uuid, params GetProjectUUIDTrashParams) error
+
+	// (POST /project/{UUID}/trash/{entityUUID}/restore)
+	PostProjectUUIDTrashEntityUUIDRestore(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params PostProjectUUIDTrashEntityUUIDRestoreParams) error
+
 	// (POST /project/{UUID}/user)
 	PostProjectUUIDUser(ctx echo.Context, uUID Uuid) error
 
@@ -1680,6 +1897,40 @@ func (w *ServerInterfaceWrapper) DeleteFederationUUIDUserUserUUID(ctx echo.Conte
 	return err
 }
 
+// GetFederationUUIDWorkload converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDWorkload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederationUUIDWorkloadParams
+	// ------------- Optional query parameter "company_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_uuid", ctx.QueryParams(), &params.GroupUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_uuid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDWorkload(ctx, uUID, params)
+	return err
+}
+
 // DeleteGroupUUIDUser converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteGroupUUIDUser(ctx echo.Context) error {
 	var err error
@@ -1951,8 +2202,8 @@ func (w *ServerInterfaceWrapper) PatchProjectUUID(ctx echo.Context) error {
 	return err
 }
 
-// GetProjectUUIDCatalog converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
+// GetProjectUUIDAutomation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDAutomation(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1965,12 +2216,12 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDCatalog(ctx, uUID)
+	err = w.Handler.GetProjectUUIDAutomation(ctx, uUID)
 	return err
 }
 
-// PostProjectUUIDCatalog converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error {
+// PostProjectUUIDAutomation converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDAutomation(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1983,12 +2234,12 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDCatalog(ctx, uUID)
+	err = w.Handler.PostProjectUUIDAutomation(ctx, uUID)
 	return err
 }
 
-// GetProjectUUIDCatalogEntityName converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Context) error {
+// DeleteProjectUUIDAutomationEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDAutomationEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1998,23 +2249,23 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Contex
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityName" -------------
-	var entityName EntityName
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDCatalogEntityName(ctx, uUID, entityName)
+	err = w.Handler.DeleteProjectUUIDAutomationEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// DeleteProjectUUIDCatalogEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context) error {
+// PutProjectUUIDAutomationEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutProjectUUIDAutomationEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2035,12 +2286,12 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Con
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDCatalogEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PutProjectUUIDAutomationEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchProjectUUIDDescription converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) error {
+// GetProjectUUIDAutomationEntityUUIDRuns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDAutomationEntityUUIDRuns(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2050,15 +2301,23 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDDescription(ctx, uUID)
+	err = w.Handler.GetProjectUUIDAutomationEntityUUIDRuns(ctx, uUID, entityUUID)
 	return err
 }
 
-// DeleteProjectUUIDFieldEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context) error {
+// GetProjectUUIDBurndown converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDBurndown(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2068,23 +2327,31 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Conte
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectUUIDBurndownParams
+	// ------------- Required query parameter "date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", ctx.QueryParams(), &params.DateFrom)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_from: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Required query parameter "date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date_to", ctx.QueryParams(), &params.DateTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_to: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetProjectUUIDBurndown(ctx, uUID, params)
 	return err
 }
 
-// PostProjectUUIDFieldEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context) error {
+// GetProjectUUIDBurnup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDBurnup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2094,23 +2361,31 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectUUIDBurnupParams
+	// ------------- Required query parameter "date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", ctx.QueryParams(), &params.DateFrom)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_from: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Required query parameter "date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date_to", ctx.QueryParams(), &params.DateTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_to: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetProjectUUIDBurnup(ctx, uUID, params)
 	return err
 }
 
-// PatchProjectUUIDGraph converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
+// GetProjectUUIDCatalog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDCatalog(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2123,12 +2398,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDGraph(ctx, uUID)
+	err = w.Handler.GetProjectUUIDCatalog(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUIDName converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
+// PostProjectUUIDCatalog converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDCatalog(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2141,12 +2416,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDName(ctx, uUID)
+	err = w.Handler.PostProjectUUIDCatalog(ctx, uUID)
 	return err
 }
 
-// PatchProjectUUIDOptions converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error {
+// GetProjectUUIDCatalogEntityName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDCatalogEntityName(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2156,15 +2431,23 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityName" -------------
+	var entityName EntityName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityName", runtime.ParamLocationPath, ctx.Param("entityName"), &entityName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityName: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDOptions(ctx, uUID)
+	err = w.Handler.GetProjectUUIDCatalogEntityName(ctx, uUID, entityName)
 	return err
 }
 
-// GetProjectUUIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
+// DeleteProjectUUIDCatalogEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDCatalogEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2174,15 +2457,23 @@ func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProjectUUIDStatus(ctx, uUID)
+	err = w.Handler.DeleteProjectUUIDCatalogEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostProjectUUIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
+// PatchProjectUUIDDescription converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDDescription(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2195,12 +2486,12 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDStatus(ctx, uUID)
+	err = w.Handler.PatchProjectUUIDDescription(ctx, uUID)
 	return err
 }
 
-// DeleteProjectUUIDStatusEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+// DeleteProjectUUIDFieldEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDFieldEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2221,12 +2512,12 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Cont
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.DeleteProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PatchProjectUUIDStatusEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+// PostProjectUUIDFieldEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDFieldEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2247,12 +2538,12 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Conte
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PostProjectUUIDFieldEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostProjectUUIDUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
+// GetProjectUUIDGraph converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDGraph(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2265,12 +2556,12 @@ func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProjectUUIDUser(ctx, uUID)
+	err = w.Handler.GetProjectUUIDGraph(ctx, uUID)
 	return err
 }
 
-// DeleteProjectUUIDUserUserUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context) error {
+// PatchProjectUUIDGraph converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -2280,43 +2571,374 @@ func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "userUUID" -------------
-	var userUUID UserUUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProjectUUIDUserUserUUID(ctx, uUID, userUUID)
+	err = w.Handler.PatchProjectUUIDGraph(ctx, uUID)
 	return err
 }
 
-// GetTag converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTag(ctx echo.Context) error {
+// GetProjectUUIDGraphExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDGraphExport(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTagParams
-	// ------------- Required query parameter "company_uuid" -------------
+	var params GetProjectUUIDGraphExportParams
+	// ------------- Required query parameter "format" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTag(ctx, params)
+	err = w.Handler.GetProjectUUIDGraphExport(ctx, uUID, params)
 	return err
 }
 
-// PostTag converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTag(ctx echo.Context) error {
+// PatchProjectUUIDName converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDName(ctx, uUID)
+	return err
+}
+
+// PatchProjectUUIDOptions converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDOptions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDOptions(ctx, uUID)
+	return err
+}
+
+// GetProjectUUIDReportCycleTime converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDReportCycleTime(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectUUIDReportCycleTimeParams
+	// ------------- Required query parameter "date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", ctx.QueryParams(), &params.DateFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_from: %s", err))
+	}
+
+	// ------------- Required query parameter "date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date_to", ctx.QueryParams(), &params.DateTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDReportCycleTime(ctx, uUID, params)
+	return err
+}
+
+// GetProjectUUIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDStatus(ctx, uUID)
+	return err
+}
+
+// PostProjectUUIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDStatus(ctx, uUID)
+	return err
+}
+
+// DeleteProjectUUIDStatusEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PatchProjectUUIDStatusEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectUUIDStatusEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProjectUUIDStatusEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetProjectUUIDTimeline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDTimeline(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectUUIDTimelineParams
+	// ------------- Optional query parameter "epic_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "epic_uuid", ctx.QueryParams(), &params.EpicUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter epic_uuid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDTimeline(ctx, uUID, params)
+	return err
+}
+
+// GetProjectUUIDTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDTrash(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectUUIDTrashParams
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDTrash(ctx, uUID, params)
+	return err
+}
+
+// PostProjectUUIDTrashEntityUUIDRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDTrashEntityUUIDRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProjectUUIDTrashEntityUUIDRestoreParams
+	// ------------- Required query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDTrashEntityUUIDRestore(ctx, uUID, entityUUID, params)
+	return err
+}
+
+// PostProjectUUIDUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectUUIDUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectUUIDUser(ctx, uUID)
+	return err
+}
+
+// DeleteProjectUUIDUserUserUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDUserUserUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID UserUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, ctx.Param("userUUID"), &userUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDUserUserUUID(ctx, uUID, userUUID)
+	return err
+}
+
+// GetTag converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagParams
+	// ------------- Required query parameter "company_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTag(ctx, params)
+	return err
+}
+
+// PostTag converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTag(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2443,6 +3065,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/federation/:UUID/project", wrapper.GetFederationUUIDProject)
 	router.POST(baseURL+"/federation/:UUID/user", wrapper.PostFederationUUIDUser)
 	router.DELETE(baseURL+"/federation/:UUID/user/:userUUID", wrapper.DeleteFederationUUIDUserUserUUID)
+	router.GET(baseURL+"/federation/:UUID/workload", wrapper.GetFederationUUIDWorkload)
 	router.DELETE(baseURL+"/group/:UUID/user", wrapper.DeleteGroupUUIDUser)
 	router.GET(baseURL+"/group/:UUID/user", wrapper.GetGroupUUIDUser)
 	router.POST(baseURL+"/group/:UUID/user", wrapper.PostGroupUUIDUser)
@@ -2460,6 +3083,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/project/:UUID", wrapper.DeleteProjectUUID)
 	router.GET(baseURL+"/project/:UUID", wrapper.GetProjectUUID)
 	router.PATCH(baseURL+"/project/:UUID", wrapper.PatchProjectUUID)
+	router.GET(baseURL+"/project/:UUID/automation", wrapper.GetProjectUUIDAutomation)
+	router.POST(baseURL+"/project/:UUID/automation", wrapper.PostProjectUUIDAutomation)
+	router.DELETE(baseURL+"/project/:UUID/automation/:entityUUID", wrapper.DeleteProjectUUIDAutomationEntityUUID)
+	router.PUT(baseURL+"/project/:UUID/automation/:entityUUID", wrapper.PutProjectUUIDAutomationEntityUUID)
+	router.GET(baseURL+"/project/:UUID/automation/:entityUUID/runs", wrapper.GetProjectUUIDAutomationEntityUUIDRuns)
+	router.GET(baseURL+"/project/:UUID/burndown", wrapper.GetProjectUUIDBurndown)
+	router.GET(baseURL+"/project/:UUID/burnup", wrapper.GetProjectUUIDBurnup)
 	router.GET(baseURL+"/project/:UUID/catalog", wrapper.GetProjectUUIDCatalog)
 	router.POST(baseURL+"/project/:UUID/catalog", wrapper.PostProjectUUIDCatalog)
 	router.GET(baseURL+"/project/:UUID/catalog/:entityName", wrapper.GetProjectUUIDCatalogEntityName)
@@ -2467,13 +3097,19 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PATCH(baseURL+"/project/:UUID/description", wrapper.PatchProjectUUIDDescription)
 	router.DELETE(baseURL+"/project/:UUID/field/:entityUUID", wrapper.DeleteProjectUUIDFieldEntityUUID)
 	router.POST(baseURL+"/project/:UUID/field/:entityUUID", wrapper.PostProjectUUIDFieldEntityUUID)
+	router.GET(baseURL+"/project/:UUID/graph", wrapper.GetProjectUUIDGraph)
 	router.PATCH(baseURL+"/project/:UUID/graph", wrapper.PatchProjectUUIDGraph)
+	router.GET(baseURL+"/project/:UUID/graph/export", wrapper.GetProjectUUIDGraphExport)
 	router.PATCH(baseURL+"/project/:UUID/name", wrapper.PatchProjectUUIDName)
 	router.PATCH(baseURL+"/project/:UUID/options", wrapper.PatchProjectUUIDOptions)
+	router.GET(baseURL+"/project/:UUID/report/cycle-time", wrapper.GetProjectUUIDReportCycleTime)
 	router.GET(baseURL+"/project/:UUID/status", wrapper.GetProjectUUIDStatus)
 	router.POST(baseURL+"/project/:UUID/status", wrapper.PostProjectUUIDStatus)
 	router.DELETE(baseURL+"/project/:UUID/status/:entityUUID", wrapper.DeleteProjectUUIDStatusEntityUUID)
 	router.PATCH(baseURL+"/project/:UUID/status/:entityUUID", wrapper.PatchProjectUUIDStatusEntityUUID)
+	router.GET(baseURL+"/project/:UUID/timeline", wrapper.GetProjectUUIDTimeline)
+	router.GET(baseURL+"/project/:UUID/trash", wrapper.GetProjectUUIDTrash)
+	router.POST(baseURL+"/project/:UUID/trash/:entityUUID/restore", wrapper.PostProjectUUIDTrashEntityUUIDRestore)
 	router.POST(baseURL+"/project/:UUID/user", wrapper.PostProjectUUIDUser)
 	router.DELETE(baseURL+"/project/:UUID/user/:userUUID", wrapper.DeleteProjectUUIDUserUserUUID)
 	router.GET(baseURL+"/tag", wrapper.GetTag)
@@ -3254,6 +3890,27 @@ func (response DeleteFederationUUIDUserUserUUID200Response) VisitDeleteFederatio
 	return nil
 }
 
+type GetFederationUUIDWorkloadRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDWorkloadParams
+}
+
+type GetFederationUUIDWorkloadResponseObject interface {
+	VisitGetFederationUUIDWorkloadResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDWorkload200JSONResponse struct {
+	Count int           `json:"count"`
+	Items []WorkloadDTO `json:"items"`
+}
+
+func (response GetFederationUUIDWorkload200JSONResponse) VisitGetFederationUUIDWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type DeleteGroupUUIDUserRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *DeleteGroupUUIDUserJSONRequestBody
@@ -3551,6 +4208,143 @@ func (response PatchProjectUUID200Response) VisitPatchProjectUUIDResponse(w http
 	return nil
 }
 
+type GetProjectUUIDAutomationRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDAutomationResponseObject interface {
+	VisitGetProjectUUIDAutomationResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDAutomation200JSONResponse struct {
+	Items []AutomationRuleDTO `json:"items"`
+}
+
+func (response GetProjectUUIDAutomation200JSONResponse) VisitGetProjectUUIDAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDAutomationRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProjectUUIDAutomationJSONRequestBody
+}
+
+type PostProjectUUIDAutomationResponseObject interface {
+	VisitPostProjectUUIDAutomationResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDAutomation200JSONResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostProjectUUIDAutomation200JSONResponse) VisitPostProjectUUIDAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectUUIDAutomationEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteProjectUUIDAutomationEntityUUIDResponseObject interface {
+	VisitDeleteProjectUUIDAutomationEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDAutomationEntityUUID200Response struct {
+}
+
+func (response DeleteProjectUUIDAutomationEntityUUID200Response) VisitDeleteProjectUUIDAutomationEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutProjectUUIDAutomationEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutProjectUUIDAutomationEntityUUIDJSONRequestBody
+}
+
+type PutProjectUUIDAutomationEntityUUIDResponseObject interface {
+	VisitPutProjectUUIDAutomationEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutProjectUUIDAutomationEntityUUID200JSONResponse AutomationRuleDTO
+
+func (response PutProjectUUIDAutomationEntityUUID200JSONResponse) VisitPutProjectUUIDAutomationEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDAutomationEntityUUIDRunsRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetProjectUUIDAutomationEntityUUIDRunsResponseObject interface {
+	VisitGetProjectUUIDAutomationEntityUUIDRunsResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDAutomationEntityUUIDRuns200JSONResponse struct {
+	Items []AutomationRunDTO `json:"items"`
+}
+
+func (response GetProjectUUIDAutomationEntityUUIDRuns200JSONResponse) VisitGetProjectUUIDAutomationEntityUUIDRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDBurndownRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProjectUUIDBurndownParams
+}
+
+type GetProjectUUIDBurndownResponseObject interface {
+	VisitGetProjectUUIDBurndownResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDBurndown200JSONResponse struct {
+	Items []BurndownPointDTO `json:"items"`
+	Unit  string             `json:"unit"`
+}
+
+func (response GetProjectUUIDBurndown200JSONResponse) VisitGetProjectUUIDBurndownResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDBurnupRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProjectUUIDBurnupParams
+}
+
+type GetProjectUUIDBurnupResponseObject interface {
+	VisitGetProjectUUIDBurnupResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDBurnup200JSONResponse struct {
+	Items []BurnupPointDTO `json:"items"`
+	Unit  string           `json:"unit"`
+}
+
+func (response GetProjectUUIDBurnup200JSONResponse) VisitGetProjectUUIDBurnupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetProjectUUIDCatalogRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
@@ -3681,6 +4475,26 @@ func (response PostProjectUUIDFieldEntityUUID200Response) VisitPostProjectUUIDFi
 	return nil
 }
 
+type GetProjectUUIDGraphRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDGraphResponseObject interface {
+	VisitGetProjectUUIDGraphResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDGraph200JSONResponse struct {
+	Graph  map[string][]string `json:"graph"`
+	Issues []StatusGraphIssue  `json:"issues"`
+}
+
+func (response GetProjectUUIDGraph200JSONResponse) VisitGetProjectUUIDGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchProjectUUIDGraphRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchProjectUUIDGraphJSONRequestBody
@@ -3699,6 +4513,25 @@ func (response PatchProjectUUIDGraph200JSONResponse) VisitPatchProjectUUIDGraphR
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetProjectUUIDGraphExportRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProjectUUIDGraphExportParams
+}
+
+type GetProjectUUIDGraphExportResponseObject interface {
+	VisitGetProjectUUIDGraphExportResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDGraphExport200TextResponse string
+
+func (response GetProjectUUIDGraphExport200TextResponse) VisitGetProjectUUIDGraphExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
 type PatchProjectUUIDNameRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchProjectUUIDNameJSONRequestBody
@@ -3733,6 +4566,24 @@ func (response PatchProjectUUIDOptions200Response) VisitPatchProjectUUIDOptionsR
 	return nil
 }
 
+type GetProjectUUIDReportCycleTimeRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProjectUUIDReportCycleTimeParams
+}
+
+type GetProjectUUIDReportCycleTimeResponseObject interface {
+	VisitGetProjectUUIDReportCycleTimeResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDReportCycleTime200JSONResponse CycleTimeReportDTO
+
+func (response GetProjectUUIDReportCycleTime200JSONResponse) VisitGetProjectUUIDReportCycleTimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetProjectUUIDStatusRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
@@ -3806,6 +4657,64 @@ func (response PatchProjectUUIDStatusEntityUUID200Response) VisitPatchProjectUUI
 	return nil
 }
 
+type GetProjectUUIDTimelineRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProjectUUIDTimelineParams
+}
+
+type GetProjectUUIDTimelineResponseObject interface {
+	VisitGetProjectUUIDTimelineResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDTimeline200JSONResponse TimelineDTO
+
+func (response GetProjectUUIDTimeline200JSONResponse) VisitGetProjectUUIDTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectUUIDTrashRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProjectUUIDTrashParams
+}
+
+type GetProjectUUIDTrashResponseObject interface {
+	VisitGetProjectUUIDTrashResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDTrash200JSONResponse struct {
+	Count int            `json:"count"`
+	Items []TrashItemDTO `json:"items"`
+}
+
+func (response GetProjectUUIDTrash200JSONResponse) VisitGetProjectUUIDTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectUUIDTrashEntityUUIDRestoreRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     PostProjectUUIDTrashEntityUUIDRestoreParams
+}
+
+type PostProjectUUIDTrashEntityUUIDRestoreResponseObject interface {
+	VisitPostProjectUUIDTrashEntityUUIDRestoreResponse(w http.ResponseWriter) error
+}
+
+type PostProjectUUIDTrashEntityUUIDRestore200JSONResponse TrashItemDTO
+
+func (response PostProjectUUIDTrashEntityUUIDRestore200JSONResponse) VisitPostProjectUUIDTrashEntityUUIDRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PostProjectUUIDUserRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PostProjectUUIDUserJSONRequestBody
@@ -4060,6 +4969,9 @@ type StrictServerInterface interface {
 	// (DELETE /federation/{UUID}/user/{userUUID})
 	DeleteFederationUUIDUserUserUUID(ctx context.Context, request DeleteFederationUUIDUserUserUUIDRequestObject) (DeleteFederationUUIDUserUserUUIDResponseObject, error)
 
+	// (GET /federation/{UUID}/workload)
+	GetFederationUUIDWorkload(ctx context.Context, request GetFederationUUIDWorkloadRequestObject) (GetFederationUUIDWorkloadResponseObject, error)
+
 	// (DELETE /group/{UUID}/user)
 	DeleteGroupUUIDUser(ctx context.Context, request DeleteGroupUUIDUserRequestObject) (DeleteGroupUUIDUserResponseObject, error)
 
@@ -4111,6 +5023,27 @@ type StrictServerInterface interface {
 	// (PATCH /project/{UUID})
 	PatchProjectUUID(ctx context.Context, request PatchProjectUUIDRequestObject) (PatchProjectUUIDResponseObject, error)
 
+	// (GET /project/{UUID}/automation)
+	GetProjectUUIDAutomation(ctx context.Context, request GetProjectUUIDAutomationRequestObject) (GetProjectUUIDAutomationResponseObject, error)
+
+	// (POST /project/{UUID}/automation)
+	PostProjectUUIDAutomation(ctx context.Context, request PostProjectUUIDAutomationRequestObject) (PostProjectUUIDAutomationResponseObject, error)
+
+	// (DELETE /project/{UUID}/automation/{entityUUID})
+	DeleteProjectUUIDAutomationEntityUUID(ctx context.Context, request DeleteProjectUUIDAutomationEntityUUIDRequestObject) (DeleteProjectUUIDAutomationEntityUUIDResponseObject, error)
+
+	// (PUT /project/{UUID}/automation/{entityUUID})
+	PutProjectUUIDAutomationEntityUUID(ctx context.Context, request PutProjectUUIDAutomationEntityUUIDRequestObject) (PutProjectUUIDAutomationEntityUUIDResponseObject, error)
+
+	// (GET /project/{UUID}/automation/{entityUUID}/runs)
+	GetProjectUUIDAutomationEntityUUIDRuns(ctx context.Context, request GetProjectUUIDAutomationEntityUUIDRunsRequestObject) (GetProjectUUIDAutomationEntityUUIDRunsResponseObject, error)
+
+	// (GET /project/{UUID}/burndown)
+	GetProjectUUIDBurndown(ctx context.Context, request GetProjectUUIDBurndownRequestObject) (GetProjectUUIDBurndownResponseObject, error)
+
+	// (GET /project/{UUID}/burnup)
+	GetProjectUUIDBurnup(ctx context.Context, request GetProjectUUIDBurnupRequestObject) (GetProjectUUIDBurnupResponseObject, error)
+
 	// (GET /project/{UUID}/catalog)
 	GetProjectUUIDCatalog(ctx context.Context, request GetProjectUUIDCatalogRequestObject) (GetProjectUUIDCatalogResponseObject, error)
 
@@ -4132,15 +5065,24 @@ type StrictServerInterface interface {
 	// (POST /project/{UUID}/field/{entityUUID})
 	PostProjectUUIDFieldEntityUUID(ctx context.Context, request PostProjectUUIDFieldEntityUUIDRequestObject) (PostProjectUUIDFieldEntityUUIDResponseObject, error)
 
+	// (GET /project/{UUID}/graph)
+	GetProjectUUIDGraph(ctx context.Context, request GetProjectUUIDGraphRequestObject) (GetProjectUUIDGraphResponseObject, error)
+
 	// (PATCH /project/{UUID}/graph)
 	PatchProjectUUIDGraph(ctx context.Context, request PatchProjectUUIDGraphRequestObject) (PatchProjectUUIDGraphResponseObject, error)
 
+	// (GET /project/{UUID}/graph/export)
+	GetProjectUUIDGraphExport(ctx context.Context, request GetProjectUUIDGraphExportRequestObject) (GetProjectUUIDGraphExportResponseObject, error)
+
 	// (PATCH /project/{UUID}/name)
 	PatchProjectUUIDName(ctx context.Context, request PatchProjectUUIDNameRequestObject) (PatchProjectUUIDNameResponseObject, error)
 
 	// (PATCH /project/{UUID}/options)
 	PatchProjectUUIDOptions(ctx context.Context, request PatchProjectUUIDOptionsRequestObject) (PatchProjectUUIDOptionsResponseObject, error)
 
+	// (GET /project/{UUID}/report/cycle-time)
+	GetProjectUUIDReportCycleTime(ctx context.Context, request GetProjectUUIDReportCycleTimeRequestObject) (GetProjectUUIDReportCycleTimeResponseObject, error)
+
 	// (GET /project/{UUID}/status)
 	GetProjectUUIDStatus(ctx context.Context, request GetProjectUUIDStatusRequestObject) (GetProjectUUIDStatusResponseObject, error)
 
@@ -4153,6 +5095,15 @@ type StrictServerInterface interface {
 	// (PATCH /project/{UUID}/status/{entityUUID})
 	PatchProjectUUIDStatusEntityUUID(ctx context.Context, request PatchProjectUUIDStatusEntityUUIDRequestObject) (PatchProjectUUIDStatusEntityUUIDResponseObject, error)
 
+	// (GET /project/{UUID}/timeline)
+	GetProjectUUIDTimeline(ctx context.Context, request GetProjectUUIDTimelineRequestObject) (GetProjectUUIDTimelineResponseObject, error)
+
+	// (GET /project/{UUID}/trash)
+	GetProjectUUIDTrash(ctx context.Context, request GetProjectUUIDTrashRequestObject) (GetProjectUUIDTrashResponseObject, error)
+
+	// (POST /project/{UUID}/trash/{entityUUID}/restore)
+	PostProjectUUIDTrashEntityUUIDRestore(ctx context.Context, request PostProjectUUIDTrashEntityUUIDRestoreRequestObject) (PostProjectUUIDTrashEntityUUIDRestoreResponseObject, error)
+
 	// (POST /project/{UUID}/user)
 	PostProjectUUIDUser(ctx context.Context, request PostProjectUUIDUserRequestObject) (PostProjectUUIDUserResponseObject, error)
 
@@ -5365,6 +6316,32 @@ func (sh *strictHandler) DeleteFederationUUIDUserUserUUID(ctx echo.Context, uUID
 	return nil
 }
 
+// GetFederationUUIDWorkload operation middleware
+func (sh *strictHandler) GetFederationUUIDWorkload(ctx echo.Context, uUID Uuid, params GetFederationUUIDWorkloadParams) error {
+	var request GetFederationUUIDWorkloadRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFederationUUIDWorkload(ctx.Request().Context(), request.(GetFederationUUIDWorkloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFederationUUIDWorkload")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetFederationUUIDWorkloadResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDWorkloadResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteGroupUUIDUser operation middleware
 func (sh *strictHandler) DeleteGroupUUIDUser(ctx echo.Context, uUID Uuid) error {
 	var request DeleteGroupUUIDUserRequestObject
@@ -5828,6 +6805,198 @@ func (sh *strictHandler) PatchProjectUUID(ctx echo.Context, uUID Uuid) error {
 	return nil
 }
 
+// GetProjectUUIDAutomation operation middleware
+func (sh *strictHandler) GetProjectUUIDAutomation(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDAutomationRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDAutomation(ctx.Request().Context(), request.(GetProjectUUIDAutomationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDAutomation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDAutomationResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDAutomationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProjectUUIDAutomation operation middleware
+func (sh *strictHandler) PostProjectUUIDAutomation(ctx echo.Context, uUID Uuid) error {
+	var request PostProjectUUIDAutomationRequestObject
+
+	request.UUID = uUID
+
+	var body PostProjectUUIDAutomationJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProjectUUIDAutomation(ctx.Request().Context(), request.(PostProjectUUIDAutomationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProjectUUIDAutomation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProjectUUIDAutomationResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDAutomationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteProjectUUIDAutomationEntityUUID operation middleware
+func (sh *strictHandler) DeleteProjectUUIDAutomationEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteProjectUUIDAutomationEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProjectUUIDAutomationEntityUUID(ctx.Request().Context(), request.(DeleteProjectUUIDAutomationEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProjectUUIDAutomationEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteProjectUUIDAutomationEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDAutomationEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutProjectUUIDAutomationEntityUUID operation middleware
+func (sh *strictHandler) PutProjectUUIDAutomationEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutProjectUUIDAutomationEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PutProjectUUIDAutomationEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProjectUUIDAutomationEntityUUID(ctx.Request().Context(), request.(PutProjectUUIDAutomationEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProjectUUIDAutomationEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutProjectUUIDAutomationEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutProjectUUIDAutomationEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProjectUUIDAutomationEntityUUIDRuns operation middleware
+func (sh *strictHandler) GetProjectUUIDAutomationEntityUUIDRuns(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetProjectUUIDAutomationEntityUUIDRunsRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDAutomationEntityUUIDRuns(ctx.Request().Context(), request.(GetProjectUUIDAutomationEntityUUIDRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDAutomationEntityUUIDRuns")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDAutomationEntityUUIDRunsResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDAutomationEntityUUIDRunsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProjectUUIDBurndown operation middleware
+func (sh *strictHandler) GetProjectUUIDBurndown(ctx echo.Context, uUID Uuid, params GetProjectUUIDBurndownParams) error {
+	var request GetProjectUUIDBurndownRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDBurndown(ctx.Request().Context(), request.(GetProjectUUIDBurndownRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDBurndown")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDBurndownResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDBurndownResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProjectUUIDBurnup operation middleware
+func (sh *strictHandler) GetProjectUUIDBurnup(ctx echo.Context, uUID Uuid, params GetProjectUUIDBurnupParams) error {
+	var request GetProjectUUIDBurnupRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDBurnup(ctx.Request().Context(), request.(GetProjectUUIDBurnupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDBurnup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDBurnupResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDBurnupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetProjectUUIDCatalog operation middleware
 func (sh *strictHandler) GetProjectUUIDCatalog(ctx echo.Context, uUID Uuid) error {
 	var request GetProjectUUIDCatalogRequestObject
@@ -6025,6 +7194,31 @@ func (sh *strictHandler) PostProjectUUIDFieldEntityUUID(ctx echo.Context, uUID U
 	return nil
 }
 
+// GetProjectUUIDGraph operation middleware
+func (sh *strictHandler) GetProjectUUIDGraph(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDGraphRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDGraph(ctx.Request().Context(), request.(GetProjectUUIDGraphRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDGraph")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDGraphResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDGraphResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchProjectUUIDGraph operation middleware
 func (sh *strictHandler) PatchProjectUUIDGraph(ctx echo.Context, uUID Uuid) error {
 	var request PatchProjectUUIDGraphRequestObject
@@ -6056,6 +7250,32 @@ func (sh *strictHandler) PatchProjectUUIDGraph(ctx echo.Context, uUID Uuid) erro
 	return nil
 }
 
+// GetProjectUUIDGraphExport operation middleware
+func (sh *strictHandler) GetProjectUUIDGraphExport(ctx echo.Context, uUID Uuid, params GetProjectUUIDGraphExportParams) error {
+	var request GetProjectUUIDGraphExportRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDGraphExport(ctx.Request().Context(), request.(GetProjectUUIDGraphExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDGraphExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDGraphExportResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDGraphExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchProjectUUIDName operation middleware
 func (sh *strictHandler) PatchProjectUUIDName(ctx echo.Context, uUID Uuid) error {
 	var request PatchProjectUUIDNameRequestObject
@@ -6118,6 +7338,32 @@ func (sh *strictHandler) PatchProjectUUIDOptions(ctx echo.Context, uUID Uuid) er
 	return nil
 }
 
+// GetProjectUUIDReportCycleTime operation middleware
+func (sh *strictHandler) GetProjectUUIDReportCycleTime(ctx echo.Context, uUID Uuid, params GetProjectUUIDReportCycleTimeParams) error {
+	var request GetProjectUUIDReportCycleTimeRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDReportCycleTime(ctx.Request().Context(), request.(GetProjectUUIDReportCycleTimeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDReportCycleTime")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDReportCycleTimeResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDReportCycleTimeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetProjectUUIDStatus operation middleware
 func (sh *strictHandler) GetProjectUUIDStatus(ctx echo.Context, uUID Uuid) error {
 	var request GetProjectUUIDStatusRequestObject
@@ -6232,6 +7478,85 @@ func (sh *strictHandler) PatchProjectUUIDStatusEntityUUID(ctx echo.Context, uUID
 	return nil
 }
 
+// GetProjectUUIDTimeline operation middleware
+func (sh *strictHandler) GetProjectUUIDTimeline(ctx echo.Context, uUID Uuid, params GetProjectUUIDTimelineParams) error {
+	var request GetProjectUUIDTimelineRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDTimeline(ctx.Request().Context(), request.(GetProjectUUIDTimelineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDTimeline")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDTimelineResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDTimelineResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProjectUUIDTrash operation middleware
+func (sh *strictHandler) GetProjectUUIDTrash(ctx echo.Context, uUID Uuid, params GetProjectUUIDTrashParams) error {
+	var request GetProjectUUIDTrashRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDTrash(ctx.Request().Context(), request.(GetProjectUUIDTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDTrash")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDTrashResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDTrashResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProjectUUIDTrashEntityUUIDRestore operation middleware
+func (sh *strictHandler) PostProjectUUIDTrashEntityUUIDRestore(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params PostProjectUUIDTrashEntityUUIDRestoreParams) error {
+	var request PostProjectUUIDTrashEntityUUIDRestoreRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProjectUUIDTrashEntityUUIDRestore(ctx.Request().Context(), request.(PostProjectUUIDTrashEntityUUIDRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProjectUUIDTrashEntityUUIDRestore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProjectUUIDTrashEntityUUIDRestoreResponseObject); ok {
+		return validResponse.VisitPostProjectUUIDTrashEntityUUIDRestoreResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostProjectUUIDUser operation middleware
 func (sh *strictHandler) PostProjectUUIDUser(ctx echo.Context, uUID Uuid) error {
 	var request PostProjectUUIDUserRequestObject
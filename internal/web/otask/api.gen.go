@@ -24,9 +24,63 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for GetTaskParamsTotal.
+const (
+	GetTaskParamsTotalEstimated GetTaskParamsTotal = "estimated"
+	GetTaskParamsTotalExact     GetTaskParamsTotal = "exact"
+	GetTaskParamsTotalNone      GetTaskParamsTotal = "none"
+)
+
+// Defines values for PostTaskBulkJSONBodyOperation.
+const (
+	AddTags     PostTaskBulkJSONBodyOperation = "add-tags"
+	Delete      PostTaskBulkJSONBodyOperation = "delete"
+	MoveProject PostTaskBulkJSONBodyOperation = "move-project"
+	RemoveTags  PostTaskBulkJSONBodyOperation = "remove-tags"
+	SetStatus   PostTaskBulkJSONBodyOperation = "set-status"
+	SetTeam     PostTaskBulkJSONBodyOperation = "set-team"
+)
+
+// Defines values for GetTaskExportParamsFormat.
+const (
+	Csv    GetTaskExportParamsFormat = "csv"
+	Ndjson GetTaskExportParamsFormat = "ndjson"
+	Xlsx   GetTaskExportParamsFormat = "xlsx"
+)
+
+// Defines values for PostTaskUUIDMergeJSONBodyPolicy.
+const (
+	Fail   PostTaskUUIDMergeJSONBodyPolicy = "fail"
+	Source PostTaskUUIDMergeJSONBodyPolicy = "source"
+	Target PostTaskUUIDMergeJSONBodyPolicy = "target"
+)
+
+// Defines values for GetViewUUIDTaskParamsTotal.
+const (
+	GetViewUUIDTaskParamsTotalEstimated GetViewUUIDTaskParamsTotal = "estimated"
+	GetViewUUIDTaskParamsTotalExact     GetViewUUIDTaskParamsTotal = "exact"
+	GetViewUUIDTaskParamsTotalNone      GetViewUUIDTaskParamsTotal = "none"
+)
+
 // ActivityDTO defines model for ActivityDTO.
 type ActivityDTO = dto.ActivityDTO
 
+// BoardColumnDTO defines model for BoardColumnDTO.
+type BoardColumnDTO = dto.BoardColumnDTO
+
+// BulkResultDTO defines model for BulkResultDTO.
+type BulkResultDTO = dto.BulkResultDTO
+
+// ChecklistItemBody defines model for ChecklistItemBody.
+type ChecklistItemBody struct {
+	AssignedTo *string    `json:"assigned_to,omitempty"`
+	DueAt      *time.Time `json:"due_at,omitempty"`
+	Text       string     `json:"text"`
+}
+
+// ChecklistItemDTO defines model for ChecklistItemDTO.
+type ChecklistItemDTO = dto.ChecklistItemDTO
+
 // CommentDTO defines model for CommentDTO.
 type CommentDTO = dto.CommentDTO
 
@@ -35,6 +89,31 @@ type NameRequest struct {
 	Name string `json:"name" validate:"trim,name,min=0,max=100"`
 }
 
+// RecurringTaskCreateRequest defines model for RecurringTaskCreateRequest.
+type RecurringTaskCreateRequest struct {
+	CoworkersBy []string               `json:"coworkers_by" validate:"dive,email"`
+	Description string                 `json:"description" validate:"trim,max=5000"`
+	Fields      map[string]interface{} `json:"fields"`
+
+	// FinishIn minutes from occurrence to finish_to
+	FinishIn      *int               `json:"finish_in,omitempty" validate:"omitempty,gte=0"`
+	Icon          string             `json:"icon" validate:"trim,max=50"`
+	ImplementBy   string             `json:"implement_by" validate:"omitempty,email"`
+	ManagedBy     string             `json:"managed_by" validate:"omitempty,email"`
+	Name          string             `json:"name" validate:"trim,name,min=3,max=200"`
+	Path          []string           `json:"path" validate:"dive,uuid"`
+	Priority      int                `json:"priority" validate:"gte=0,lte=30"`
+	ProjectUuid   openapi_types.UUID `json:"project_uuid" validate:"uuid"`
+	ResponsibleBy string             `json:"responsible_by" validate:"omitempty,email"`
+
+	// Schedule cron expression (0 9 * * 1-5) or RRULE (FREQ=WEEKLY;BYDAY=MO;BYHOUR=9)
+	Schedule string   `json:"schedule" validate:"trim,min=5,max=255"`
+	Tags     []string `json:"tags" validate:"dive,trim,name,max=40"`
+}
+
+// RecurringTaskDTO defines model for RecurringTaskDTO.
+type RecurringTaskDTO = dto.RecurringTaskDTO
+
 // StatusRequest defines model for StatusRequest.
 type StatusRequest struct {
 	Comment string `json:"comment" validate:"trim,min=0,max=300"`
@@ -43,20 +122,26 @@ type StatusRequest struct {
 
 // TaskCreateRequest defines model for TaskCreateRequest.
 type TaskCreateRequest struct {
-	CoworkersBy   []string               `json:"coworkers_by" validate:"dive,email"`
-	Description   string                 `json:"description" validate:"trim,max=5000"`
-	Fields        map[string]interface{} `json:"fields"`
-	FinishTo      *time.Time             `json:"finish_to,omitempty"`
-	Icon          string                 `json:"icon" validate:"trim,max=50"`
-	ImplementBy   string                 `json:"implement_by" validate:"omitempty,email"`
-	ManagedBy     string                 `json:"managed_by" validate:"omitempty,email"`
-	Name          string                 `json:"name" validate:"trim,name,min=3,max=200"`
-	Path          []string               `json:"path" validate:"dive,uuid"`
-	Priority      int                    `json:"priority" validate:"gte=0,lte=30"`
-	ProjectUuid   openapi_types.UUID     `json:"project_uuid" validate:"uuid"`
-	ResponsibleBy string                 `json:"responsible_by" validate:"omitempty,email"`
-	Tags          []string               `json:"tags" validate:"dive,trim,name,max=40"`
-	TaskEntities  []domain.TaskEntity    `json:"task_entities"`
+	CoworkersBy []string `json:"coworkers_by" validate:"dive,email"`
+	Description string   `json:"description" validate:"trim,max=5000"`
+
+	// Estimate points or hours by estimate_unit of the project
+	Estimate *float64               `json:"estimate,omitempty"`
+	Fields   map[string]interface{} `json:"fields"`
+
+	// FinishTo can not be before start_at
+	FinishTo      *time.Time          `json:"finish_to,omitempty"`
+	Icon          string              `json:"icon" validate:"trim,max=50"`
+	ImplementBy   string              `json:"implement_by" validate:"omitempty,email"`
+	ManagedBy     string              `json:"managed_by" validate:"omitempty,email"`
+	Name          string              `json:"name" validate:"trim,name,min=3,max=200"`
+	Path          []string            `json:"path" validate:"dive,uuid"`
+	Priority      int                 `json:"priority" validate:"gte=0,lte=30"`
+	ProjectUuid   openapi_types.UUID  `json:"project_uuid" validate:"uuid"`
+	ResponsibleBy string              `json:"responsible_by" validate:"omitempty,email"`
+	StartAt       *time.Time          `json:"start_at,omitempty"`
+	Tags          []string            `json:"tags" validate:"dive,trim,name,max=40"`
+	TaskEntities  []domain.TaskEntity `json:"task_entities"`
 }
 
 // TaskDTO defines model for TaskDTO.
@@ -65,15 +150,68 @@ type TaskDTO = dto.TaskDTO
 // TaskDTOs defines model for TaskDTOs.
 type TaskDTOs = dto.TaskDTOs
 
+// TaskExportDTO defines model for TaskExportDTO.
+type TaskExportDTO = dto.TaskExportDTO
+
+// TaskImportDTO defines model for TaskImportDTO.
+type TaskImportDTO = dto.TaskImportDTO
+
+// TaskLinkDTO defines model for TaskLinkDTO.
+type TaskLinkDTO = dto.TaskLinkDTO
+
 // TaskPutRequest defines model for TaskPutRequest.
 type TaskPutRequest struct {
-	Description *string                 `json:"description,omitempty" validate:"trim,max=5000"`
-	Fields      *map[string]interface{} `json:"fields,omitempty"`
-	FinishTo    *time.Time              `json:"finish_to,omitempty"`
-	Icon        *string                 `json:"icon,omitempty" validate:"omitempty,trim,lte=20"`
-	ManagedBy   *string                 `json:"managed_by,omitempty" validate:"omitempty,email"`
-	Priority    *int                    `json:"priority,omitempty" validate:"gte=0,lte=30"`
-	Tags        *[]string               `json:"tags,omitempty" validate:"dive,trim,name,max=40"`
+	Description *string `json:"description,omitempty" validate:"trim,max=5000"`
+
+	// Estimate points or hours by estimate_unit of the project
+	Estimate *float64                `json:"estimate,omitempty"`
+	Fields   *map[string]interface{} `json:"fields,omitempty"`
+
+	// FinishTo can not be before start_at
+	FinishTo  *time.Time `json:"finish_to,omitempty"`
+	Icon      *string    `json:"icon,omitempty" validate:"omitempty,trim,lte=20"`
+	ManagedBy *string    `json:"managed_by,omitempty" validate:"omitempty,email"`
+	Priority  *int       `json:"priority,omitempty" validate:"gte=0,lte=30"`
+	StartAt   *time.Time `json:"start_at,omitempty"`
+	Tags      *[]string  `json:"tags,omitempty" validate:"dive,trim,name,max=40"`
+}
+
+// TaskStateChange defines model for TaskStateChange.
+type TaskStateChange = domain.TaskStateChange
+
+// TaskViewCreateRequest defines model for TaskViewCreateRequest.
+type TaskViewCreateRequest struct {
+	By          *string            `json:"by,omitempty" validate:"omitempty,oneof=asc desc"`
+	Columns     *[]string          `json:"columns,omitempty" validate:"omitempty,dive,min=1,max=50"`
+	Filter      TaskViewFilter     `json:"filter"`
+	IsShared    *bool              `json:"is_shared,omitempty"`
+	Name        string             `json:"name" validate:"trim,min=1,max=100"`
+	Order       *string            `json:"order,omitempty" validate:"omitempty,trim,min=1,max=30"`
+	ProjectUuid openapi_types.UUID `json:"project_uuid"`
+}
+
+// TaskViewDTO defines model for TaskViewDTO.
+type TaskViewDTO = dto.TaskViewDTO
+
+// TaskViewFilter defines model for TaskViewFilter.
+type TaskViewFilter = domain.TaskViewFilter
+
+// TaskViewUpdateRequest defines model for TaskViewUpdateRequest.
+type TaskViewUpdateRequest struct {
+	By       *string        `json:"by,omitempty" validate:"omitempty,oneof=asc desc"`
+	Columns  *[]string      `json:"columns,omitempty" validate:"omitempty,dive,min=1,max=50"`
+	Filter   TaskViewFilter `json:"filter"`
+	IsShared *bool          `json:"is_shared,omitempty"`
+	Name     string         `json:"name" validate:"trim,min=1,max=100"`
+	Order    *string        `json:"order,omitempty" validate:"omitempty,trim,min=1,max=30"`
+}
+
+// TimelineTaskDTO defines model for TimelineTaskDTO.
+type TimelineTaskDTO = dto.TimelineTaskDTO
+
+// UUIDResponse defines model for UUIDResponse.
+type UUIDResponse struct {
+	Uuid openapi_types.UUID `json:"uuid"`
 }
 
 // UploadDTO defines model for UploadDTO.
@@ -82,6 +220,12 @@ type UploadDTO = dto.UploadDTO
 // UserDTO defines model for UserDTO.
 type UserDTO = dto.UserDTO
 
+// WorklogDTO defines model for WorklogDTO.
+type WorklogDTO = dto.WorklogDTO
+
+// WorklogReportItemDTO defines model for WorklogReportItemDTO.
+type WorklogReportItemDTO = dto.WorklogReportItemDTO
+
 // EntityUUID defines model for entityUUID.
 type EntityUUID = openapi_types.UUID
 
@@ -91,6 +235,52 @@ type FileUUID = openapi_types.UUID
 // Uuid defines model for uuid.
 type Uuid = openapi_types.UUID
 
+// GetBoardParams defines parameters for GetBoard.
+type GetBoardParams struct {
+	ProjectUuid openapi_types.UUID `form:"project_uuid" json:"project_uuid"`
+
+	// Status Return only one column
+	Status *int `form:"status,omitempty" json:"status,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Cards per column
+	Limit        *int      `form:"limit,omitempty" json:"limit,omitempty"`
+	IsMy         *bool     `form:"is_my,omitempty" json:"is_my,omitempty"`
+	Participated *[]string `form:"participated,omitempty" json:"participated,omitempty"`
+	Tags         *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// PostImportMultipartBody defines parameters for PostImport.
+type PostImportMultipartBody struct {
+	File *openapi_types.File `json:"file,omitempty"`
+}
+
+// PostImportParams defines parameters for PostImport.
+type PostImportParams struct {
+	ProjectUuid openapi_types.UUID `form:"project_uuid" json:"project_uuid"`
+}
+
+// PostImportUUIDCheckJSONBody defines parameters for PostImportUUIDCheck.
+type PostImportUUIDCheckJSONBody struct {
+	// Mapping Column index -> task attribute or fields.<hash>
+	Mapping map[string]string `json:"mapping"`
+}
+
+// GetRecurringParams defines parameters for GetRecurring.
+type GetRecurringParams struct {
+	ProjectUuid *openapi_types.UUID `form:"project_uuid,omitempty" json:"project_uuid,omitempty"`
+}
+
+// PatchRecurringUUIDPauseJSONBody defines parameters for PatchRecurringUUIDPause.
+type PatchRecurringUUIDPauseJSONBody struct {
+	Paused bool `json:"paused"`
+}
+
+// GetRecurringUUIDPreviewParams defines parameters for GetRecurringUUIDPreview.
+type GetRecurringUUIDPreviewParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetTaskParams defines parameters for GetTask.
 type GetTaskParams struct {
 	Offset         *int               `form:"offset,omitempty" json:"offset,omitempty"`
@@ -104,11 +294,86 @@ type GetTaskParams struct {
 	Tags           *[]string          `form:"tags,omitempty" json:"tags,omitempty"`
 	Path           *string            `form:"path,omitempty" json:"path,omitempty"`
 	Name           *string            `form:"name,omitempty" json:"name,omitempty"`
-	Fields         *string            `form:"fields,omitempty" json:"fields,omitempty"`
-	Order          *string            `form:"order,omitempty" json:"order,omitempty"`
-	By             *string            `form:"by,omitempty" json:"by,omitempty"`
-	Format         *string            `form:"format,omitempty" json:"format,omitempty"`
-}
+
+	// Search Full-text search over name, description and comments
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Filter Typed filter by custom fields, json: {"field": hash, "op": "gt", "value": 1000} or {"and"|"or": [...]}. Operators depend on the field type: eq, ne, gt, gte, lt, lte, between, in, contains, has_any, has_all, empty, not_empty
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+	Order  *string `form:"order,omitempty" json:"order,omitempty"`
+	By     *string `form:"by,omitempty" json:"by,omitempty"`
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+
+	// Cursor Token of the next page from next_cursor, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Total exact (default for offset), estimated by the planner or none (default for cursor, total is -1)
+	Total *GetTaskParamsTotal `form:"total,omitempty" json:"total,omitempty"`
+}
+
+// GetTaskParamsTotal defines parameters for GetTask.
+type GetTaskParamsTotal string
+
+// PostTaskBulkJSONBody defines parameters for PostTaskBulk.
+type PostTaskBulkJSONBody struct {
+	Comment     *string   `json:"comment,omitempty" validate:"omitempty,max=1000"`
+	CoworkersBy *[]string `json:"coworkers_by,omitempty"`
+
+	// Filter Used when uuids are empty
+	Filter *struct {
+		FederationUuid openapi_types.UUID `json:"federation_uuid"`
+		IsEpic         *bool              `json:"is_epic,omitempty"`
+		IsMy           *bool              `json:"is_my,omitempty"`
+		Name           *string            `json:"name,omitempty"`
+		Participated   *[]string          `json:"participated,omitempty"`
+		Path           *string            `json:"path,omitempty"`
+		ProjectUuid    openapi_types.UUID `json:"project_uuid"`
+		Search         *string            `json:"search,omitempty"`
+		Status         *int               `json:"status,omitempty"`
+		Tags           *[]string          `json:"tags,omitempty"`
+	} `json:"filter,omitempty"`
+	ImplementBy *string                       `json:"implement_by,omitempty"`
+	ManagedBy   *string                       `json:"managed_by,omitempty"`
+	Operation   PostTaskBulkJSONBodyOperation `json:"operation"`
+
+	// ProjectUuid For move-project
+	ProjectUuid   *openapi_types.UUID `json:"project_uuid,omitempty"`
+	ResponsibleBy *string             `json:"responsible_by,omitempty"`
+
+	// Status For set-status and move-project
+	Status *int `json:"status,omitempty" validate:"omitempty,min=0,max=100"`
+
+	// Tags For add-tags and remove-tags
+	Tags      *[]string             `json:"tags,omitempty"`
+	Uuids     *[]openapi_types.UUID `json:"uuids,omitempty"`
+	WatchedBy *[]string             `json:"watched_by,omitempty"`
+}
+
+// PostTaskBulkJSONBodyOperation defines parameters for PostTaskBulk.
+type PostTaskBulkJSONBodyOperation string
+
+// GetTaskExportParams defines parameters for GetTaskExport.
+type GetTaskExportParams struct {
+	ProjectUuid  openapi_types.UUID        `form:"project_uuid" json:"project_uuid"`
+	Format       GetTaskExportParamsFormat `form:"format" json:"format"`
+	Background   *bool                     `form:"background,omitempty" json:"background,omitempty"`
+	IsMy         *bool                     `form:"is_my,omitempty" json:"is_my,omitempty"`
+	Status       *int                      `form:"status,omitempty" json:"status,omitempty"`
+	IsEpic       *bool                     `form:"is_epic,omitempty" json:"is_epic,omitempty"`
+	Participated *[]string                 `form:"participated,omitempty" json:"participated,omitempty"`
+	Tags         *[]string                 `form:"tags,omitempty" json:"tags,omitempty"`
+	Path         *string                   `form:"path,omitempty" json:"path,omitempty"`
+	Name         *string                   `form:"name,omitempty" json:"name,omitempty"`
+	Search       *string                   `form:"search,omitempty" json:"search,omitempty"`
+	Fields       *string                   `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Filter Typed filter by custom fields, json: {"field": hash, "op": "gt", "value": 1000} or {"and"|"or": [...]}. Operators depend on the field type: eq, ne, gt, gte, lt, lte, between, in, contains, has_any, has_all, empty, not_empty
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// GetTaskExportParamsFormat defines parameters for GetTaskExport.
+type GetTaskExportParamsFormat string
 
 // GetTaskUUIDActivityParams defines parameters for GetTaskUUIDActivity.
 type GetTaskUUIDActivityParams struct {
@@ -116,6 +381,39 @@ type GetTaskUUIDActivityParams struct {
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// PatchTaskUUIDBoardJSONBody defines parameters for PatchTaskUUIDBoard.
+type PatchTaskUUIDBoardJSONBody struct {
+	// AfterUuid Card above
+	AfterUuid *openapi_types.UUID `json:"after_uuid,omitempty"`
+
+	// BeforeUuid Card below, used when after_uuid is empty
+	BeforeUuid *openapi_types.UUID `json:"before_uuid,omitempty"`
+	Comment    *string             `json:"comment,omitempty" validate:"max=1000"`
+	Status     int                 `json:"status" validate:"min=0,max=100"`
+}
+
+// PatchTaskUUIDChecklistEntityUUIDDoneJSONBody defines parameters for PatchTaskUUIDChecklistEntityUUIDDone.
+type PatchTaskUUIDChecklistEntityUUIDDoneJSONBody struct {
+	Done bool `json:"done"`
+}
+
+// PatchTaskUUIDChecklistEntityUUIDMoveJSONBody defines parameters for PatchTaskUUIDChecklistEntityUUIDMove.
+type PatchTaskUUIDChecklistEntityUUIDMoveJSONBody struct {
+	AfterUuid *openapi_types.UUID `json:"after_uuid,omitempty"`
+}
+
+// PostTaskUUIDCloneJSONBody defines parameters for PostTaskUUIDClone.
+type PostTaskUUIDCloneJSONBody struct {
+	Files *bool `json:"files,omitempty"`
+
+	// ParentUuid Parent of the copy in the target project
+	ParentUuid *openapi_types.UUID `json:"parent_uuid,omitempty"`
+
+	// ProjectUuid Target project, the project of the task by default
+	ProjectUuid *openapi_types.UUID `json:"project_uuid,omitempty"`
+	Subtasks    *bool               `json:"subtasks,omitempty"`
+}
+
 // PostTaskUUIDCommentMultipartBody defines parameters for PostTaskUUIDComment.
 type PostTaskUUIDCommentMultipartBody struct {
 	Comment   *string             `json:"comment,omitempty"`
@@ -132,6 +430,35 @@ type PatchTaskUUIDCommentEntityUUIDMultipartBody struct {
 	ReplyUuid *openapi_types.UUID `json:"reply_uuid,omitempty"`
 }
 
+// GetTaskUUIDHistoryDiffParams defines parameters for GetTaskUUIDHistoryDiff.
+type GetTaskUUIDHistoryDiffParams struct {
+	DateFrom time.Time `form:"date_from" json:"date_from"`
+	DateTo   time.Time `form:"date_to" json:"date_to"`
+}
+
+// GetTaskUUIDHistoryStateParams defines parameters for GetTaskUUIDHistoryState.
+type GetTaskUUIDHistoryStateParams struct {
+	At time.Time `form:"at" json:"at"`
+}
+
+// PostTaskUUIDLinkJSONBody defines parameters for PostTaskUUIDLink.
+type PostTaskUUIDLinkJSONBody struct {
+	TaskUuid openapi_types.UUID `json:"task_uuid"`
+
+	// Type blocks, relates-to, duplicates
+	Type string `json:"type" validate:"oneof=blocks relates-to duplicates"`
+}
+
+// PostTaskUUIDMergeJSONBody defines parameters for PostTaskUUIDMerge.
+type PostTaskUUIDMergeJSONBody struct {
+	// Policy Custom fields filled in both tasks keep value of the target, of the source or fail the merge
+	Policy     *PostTaskUUIDMergeJSONBodyPolicy `json:"policy,omitempty"`
+	SourceUuid openapi_types.UUID               `json:"source_uuid"`
+}
+
+// PostTaskUUIDMergeJSONBodyPolicy defines parameters for PostTaskUUIDMerge.
+type PostTaskUUIDMergeJSONBodyPolicy string
+
 // PatchTaskUUIDParentJSONBody defines parameters for PatchTaskUUIDParent.
 type PatchTaskUUIDParentJSONBody struct {
 	Uuid *openapi_types.UUID `json:"uuid,omitempty" validate:"omitempty,uuid"`
@@ -144,6 +471,14 @@ type PatchTaskUUIDProjectJSONBody struct {
 	Uuid    openapi_types.UUID `json:"uuid" validate:"uuid"`
 }
 
+// PatchTaskUUIDScheduleJSONBody defines parameters for PatchTaskUUIDSchedule.
+type PatchTaskUUIDScheduleJSONBody struct {
+	Cascade *bool `json:"cascade,omitempty"`
+
+	// Shift minutes, negative moves dates back
+	Shift int `json:"shift"`
+}
+
 // PatchTaskUUIDTeamJSONBody defines parameters for PatchTaskUUIDTeam.
 type PatchTaskUUIDTeamJSONBody struct {
 	CoworkersBy   *[]string `json:"coworkers_by,omitempty" validate:"omitempty,dive,email"`
@@ -163,18 +498,99 @@ type PostTaskUUIDUploadEntityUUIDRenameJSONBody struct {
 	Name string `json:"name" validate:"trim,min=1,max=50"`
 }
 
+// PostTaskUUIDWorklogJSONBody defines parameters for PostTaskUUIDWorklog.
+type PostTaskUUIDWorklogJSONBody struct {
+	Comment *string `json:"comment,omitempty" validate:"max=1000"`
+
+	// Duration seconds
+	Duration  int       `json:"duration" validate:"min=1,max=86400"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// PostTimerStopJSONBody defines parameters for PostTimerStop.
+type PostTimerStopJSONBody struct {
+	Comment *string `json:"comment,omitempty" validate:"max=1000"`
+}
+
+// GetViewParams defines parameters for GetView.
+type GetViewParams struct {
+	ProjectUuid openapi_types.UUID `form:"project_uuid" json:"project_uuid"`
+}
+
+// GetViewUUIDTaskParams defines parameters for GetViewUUIDTask.
+type GetViewUUIDTaskParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Token of the next page from next_cursor, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Total exact (default for offset), estimated by the planner or none (default for cursor, total is -1)
+	Total *GetViewUUIDTaskParamsTotal `form:"total,omitempty" json:"total,omitempty"`
+}
+
+// GetViewUUIDTaskParamsTotal defines parameters for GetViewUUIDTask.
+type GetViewUUIDTaskParamsTotal string
+
+// GetWorklogReportParams defines parameters for GetWorklogReport.
+type GetWorklogReportParams struct {
+	FederationUuid openapi_types.UUID  `form:"federation_uuid" json:"federation_uuid"`
+	ProjectUuid    *openapi_types.UUID `form:"project_uuid,omitempty" json:"project_uuid,omitempty"`
+	DateFrom       time.Time           `form:"date_from" json:"date_from"`
+	DateTo         time.Time           `form:"date_to" json:"date_to"`
+}
+
+// PostImportMultipartRequestBody defines body for PostImport for multipart/form-data ContentType.
+type PostImportMultipartRequestBody PostImportMultipartBody
+
+// PostImportUUIDCheckJSONRequestBody defines body for PostImportUUIDCheck for application/json ContentType.
+type PostImportUUIDCheckJSONRequestBody PostImportUUIDCheckJSONBody
+
+// PostRecurringJSONRequestBody defines body for PostRecurring for application/json ContentType.
+type PostRecurringJSONRequestBody = RecurringTaskCreateRequest
+
+// PatchRecurringUUIDPauseJSONRequestBody defines body for PatchRecurringUUIDPause for application/json ContentType.
+type PatchRecurringUUIDPauseJSONRequestBody PatchRecurringUUIDPauseJSONBody
+
 // PostTaskJSONRequestBody defines body for PostTask for application/json ContentType.
 type PostTaskJSONRequestBody = TaskCreateRequest
 
+// PostTaskBulkJSONRequestBody defines body for PostTaskBulk for application/json ContentType.
+type PostTaskBulkJSONRequestBody PostTaskBulkJSONBody
+
 // PutTaskUUIDJSONRequestBody defines body for PutTaskUUID for application/json ContentType.
 type PutTaskUUIDJSONRequestBody = TaskPutRequest
 
+// PatchTaskUUIDBoardJSONRequestBody defines body for PatchTaskUUIDBoard for application/json ContentType.
+type PatchTaskUUIDBoardJSONRequestBody PatchTaskUUIDBoardJSONBody
+
+// PostTaskUUIDChecklistJSONRequestBody defines body for PostTaskUUIDChecklist for application/json ContentType.
+type PostTaskUUIDChecklistJSONRequestBody = ChecklistItemBody
+
+// PutTaskUUIDChecklistEntityUUIDJSONRequestBody defines body for PutTaskUUIDChecklistEntityUUID for application/json ContentType.
+type PutTaskUUIDChecklistEntityUUIDJSONRequestBody = ChecklistItemBody
+
+// PatchTaskUUIDChecklistEntityUUIDDoneJSONRequestBody defines body for PatchTaskUUIDChecklistEntityUUIDDone for application/json ContentType.
+type PatchTaskUUIDChecklistEntityUUIDDoneJSONRequestBody PatchTaskUUIDChecklistEntityUUIDDoneJSONBody
+
+// PatchTaskUUIDChecklistEntityUUIDMoveJSONRequestBody defines body for PatchTaskUUIDChecklistEntityUUIDMove for application/json ContentType.
+type PatchTaskUUIDChecklistEntityUUIDMoveJSONRequestBody PatchTaskUUIDChecklistEntityUUIDMoveJSONBody
+
+// PostTaskUUIDCloneJSONRequestBody defines body for PostTaskUUIDClone for application/json ContentType.
+type PostTaskUUIDCloneJSONRequestBody PostTaskUUIDCloneJSONBody
+
 // PostTaskUUIDCommentMultipartRequestBody defines body for PostTaskUUIDComment for multipart/form-data ContentType.
 type PostTaskUUIDCommentMultipartRequestBody PostTaskUUIDCommentMultipartBody
 
 // PatchTaskUUIDCommentEntityUUIDMultipartRequestBody defines body for PatchTaskUUIDCommentEntityUUID for multipart/form-data ContentType.
 type PatchTaskUUIDCommentEntityUUIDMultipartRequestBody PatchTaskUUIDCommentEntityUUIDMultipartBody
 
+// PostTaskUUIDLinkJSONRequestBody defines body for PostTaskUUIDLink for application/json ContentType.
+type PostTaskUUIDLinkJSONRequestBody PostTaskUUIDLinkJSONBody
+
+// PostTaskUUIDMergeJSONRequestBody defines body for PostTaskUUIDMerge for application/json ContentType.
+type PostTaskUUIDMergeJSONRequestBody PostTaskUUIDMergeJSONBody
+
 // PatchTaskUUIDNameJSONRequestBody defines body for PatchTaskUUIDName for application/json ContentType.
 type PatchTaskUUIDNameJSONRequestBody = NameRequest
 
@@ -184,6 +600,9 @@ type PatchTaskUUIDParentJSONRequestBody PatchTaskUUIDParentJSONBody
 // PatchTaskUUIDProjectJSONRequestBody defines body for PatchTaskUUIDProject for application/json ContentType.
 type PatchTaskUUIDProjectJSONRequestBody PatchTaskUUIDProjectJSONBody
 
+// PatchTaskUUIDScheduleJSONRequestBody defines body for PatchTaskUUIDSchedule for application/json ContentType.
+type PatchTaskUUIDScheduleJSONRequestBody PatchTaskUUIDScheduleJSONBody
+
 // PatchTaskUUIDStatusJSONRequestBody defines body for PatchTaskUUIDStatus for application/json ContentType.
 type PatchTaskUUIDStatusJSONRequestBody = StatusRequest
 
@@ -196,15 +615,69 @@ type PatchTaskUUIDUploadMultipartRequestBody PatchTaskUUIDUploadMultipartBody
 // PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody defines body for PostTaskUUIDUploadEntityUUIDRename for application/json ContentType.
 type PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody PostTaskUUIDUploadEntityUUIDRenameJSONBody
 
+// PostTaskUUIDWorklogJSONRequestBody defines body for PostTaskUUIDWorklog for application/json ContentType.
+type PostTaskUUIDWorklogJSONRequestBody PostTaskUUIDWorklogJSONBody
+
+// PostTimerStopJSONRequestBody defines body for PostTimerStop for application/json ContentType.
+type PostTimerStopJSONRequestBody PostTimerStopJSONBody
+
+// PostViewJSONRequestBody defines body for PostView for application/json ContentType.
+type PostViewJSONRequestBody = TaskViewCreateRequest
+
+// PutViewUUIDJSONRequestBody defines body for PutViewUUID for application/json ContentType.
+type PutViewUUIDJSONRequestBody = TaskViewUpdateRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /board)
+	GetBoard(ctx echo.Context, params GetBoardParams) error
+
+	// (POST /import)
+	PostImport(ctx echo.Context, params PostImportParams) error
+
+	// (GET /import/{UUID})
+	GetImportUUID(ctx echo.Context, uUID Uuid) error
+
+	// (POST /import/{UUID}/check)
+	PostImportUUIDCheck(ctx echo.Context, uUID Uuid) error
+
+	// (POST /import/{UUID}/commit)
+	PostImportUUIDCommit(ctx echo.Context, uUID Uuid) error
+
+	// (GET /recurring)
+	GetRecurring(ctx echo.Context, params GetRecurringParams) error
+
+	// (POST /recurring)
+	PostRecurring(ctx echo.Context) error
+
+	// (DELETE /recurring/{UUID})
+	DeleteRecurringUUID(ctx echo.Context, uUID Uuid) error
+
+	// (GET /recurring/{UUID})
+	GetRecurringUUID(ctx echo.Context, uUID Uuid) error
+
+	// (PATCH /recurring/{UUID}/pause)
+	PatchRecurringUUIDPause(ctx echo.Context, uUID Uuid) error
+
+	// (GET /recurring/{UUID}/preview)
+	GetRecurringUUIDPreview(ctx echo.Context, uUID Uuid, params GetRecurringUUIDPreviewParams) error
+
 	// (GET /task)
 	GetTask(ctx echo.Context, params GetTaskParams) error
 
 	// (POST /task)
 	PostTask(ctx echo.Context) error
 
+	// (POST /task/bulk)
+	PostTaskBulk(ctx echo.Context) error
+
+	// (GET /task/export)
+	GetTaskExport(ctx echo.Context, params GetTaskExportParams) error
+
+	// (GET /task/export/{UUID})
+	GetTaskExportUUID(ctx echo.Context, uUID Uuid) error
+
 	// (DELETE /task/{UUID})
 	DeleteTaskUUID(ctx echo.Context, uUID Uuid) error
 
@@ -217,6 +690,33 @@ type ServerInterface interface {
 	// (GET /task/{UUID}/activity)
 	GetTaskUUIDActivity(ctx echo.Context, uUID Uuid, params GetTaskUUIDActivityParams) error
 
+	// (PATCH /task/{UUID}/board)
+	PatchTaskUUIDBoard(ctx echo.Context, uUID Uuid) error
+
+	// (GET /task/{UUID}/checklist)
+	GetTaskUUIDChecklist(ctx echo.Context, uUID Uuid) error
+
+	// (POST /task/{UUID}/checklist)
+	PostTaskUUIDChecklist(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /task/{UUID}/checklist/{entityUUID})
+	DeleteTaskUUIDChecklistEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PUT /task/{UUID}/checklist/{entityUUID})
+	PutTaskUUIDChecklistEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/checklist/{entityUUID}/convert)
+	PostTaskUUIDChecklistEntityUUIDConvert(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PATCH /task/{UUID}/checklist/{entityUUID}/done)
+	PatchTaskUUIDChecklistEntityUUIDDone(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (PATCH /task/{UUID}/checklist/{entityUUID}/move)
+	PatchTaskUUIDChecklistEntityUUIDMove(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/clone)
+	PostTaskUUIDClone(ctx echo.Context, uUID Uuid) error
+
 	// (GET /task/{UUID}/comment)
 	GetTaskUUIDComment(ctx echo.Context, uUID Uuid) error
 
@@ -238,6 +738,24 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
 	PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (GET /task/{UUID}/history/diff)
+	GetTaskUUIDHistoryDiff(ctx echo.Context, uUID Uuid, params GetTaskUUIDHistoryDiffParams) error
+
+	// (GET /task/{UUID}/history/state)
+	GetTaskUUIDHistoryState(ctx echo.Context, uUID Uuid, params GetTaskUUIDHistoryStateParams) error
+
+	// (GET /task/{UUID}/link)
+	GetTaskUUIDLink(ctx echo.Context, uUID Uuid) error
+
+	// (POST /task/{UUID}/link)
+	PostTaskUUIDLink(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /task/{UUID}/link/{entityUUID})
+	DeleteTaskUUIDLinkEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/merge)
+	PostTaskUUIDMerge(ctx echo.Context, uUID Uuid) error
+
 	// (PATCH /task/{UUID}/name)
 	PatchTaskUUIDName(ctx echo.Context, uUID Uuid) error
 
@@ -247,6 +765,9 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/project)
 	PatchTaskUUIDProject(ctx echo.Context, uUID Uuid) error
 
+	// (PATCH /task/{UUID}/schedule)
+	PatchTaskUUIDSchedule(ctx echo.Context, uUID Uuid) error
+
 	// (PATCH /task/{UUID}/status)
 	PatchTaskUUIDStatus(ctx echo.Context, uUID Uuid) error
 
@@ -256,6 +777,9 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/team)
 	PatchTaskUUIDTeam(ctx echo.Context, uUID Uuid) error
 
+	// (POST /task/{UUID}/timer)
+	PostTaskUUIDTimer(ctx echo.Context, uUID Uuid) error
+
 	// (GET /task/{UUID}/upload)
 	GetTaskUUIDUpload(ctx echo.Context, uUID Uuid) error
 
@@ -270,6 +794,42 @@ type ServerInterface interface {
 
 	// (POST /task/{UUID}/upload/{entityUUID}/rename)
 	PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /task/{UUID}/worklog)
+	GetTaskUUIDWorklog(ctx echo.Context, uUID Uuid) error
+
+	// (POST /task/{UUID}/worklog)
+	PostTaskUUIDWorklog(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /task/{UUID}/worklog/{entityUUID})
+	DeleteTaskUUIDWorklogEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /timer)
+	GetTimer(ctx echo.Context) error
+
+	// (POST /timer/stop)
+	PostTimerStop(ctx echo.Context) error
+
+	// (GET /view)
+	GetView(ctx echo.Context, params GetViewParams) error
+
+	// (POST /view)
+	PostView(ctx echo.Context) error
+
+	// (DELETE /view/{UUID})
+	DeleteViewUUID(ctx echo.Context, uUID Uuid) error
+
+	// (GET /view/{UUID})
+	GetViewUUID(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /view/{UUID})
+	PutViewUUID(ctx echo.Context, uUID Uuid) error
+
+	// (GET /view/{UUID}/task)
+	GetViewUUIDTask(ctx echo.Context, uUID Uuid, params GetViewUUIDTaskParams) error
+
+	// (GET /worklog/report)
+	GetWorklogReport(ctx echo.Context, params GetWorklogReportParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -277,33 +837,19 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// GetTask converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTask(ctx echo.Context) error {
+// GetBoard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBoard(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTaskParams
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
-	// ------------- Optional query parameter "is_my" -------------
+	var params GetBoardParams
+	// ------------- Required query parameter "project_uuid" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "is_my", ctx.QueryParams(), &params.IsMy)
+	err = runtime.BindQueryParameter("form", true, true, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_my: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
 	}
 
 	// ------------- Optional query parameter "status" -------------
@@ -313,25 +859,25 @@ func (w *ServerInterfaceWrapper) GetTask(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
 	}
 
-	// ------------- Optional query parameter "is_epic" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "is_epic", ctx.QueryParams(), &params.IsEpic)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_epic: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Required query parameter "project_uuid" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "federation_uuid" -------------
+	// ------------- Optional query parameter "is_my" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "federation_uuid", ctx.QueryParams(), &params.FederationUuid)
+	err = runtime.BindQueryParameter("form", true, false, "is_my", ctx.QueryParams(), &params.IsMy)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federation_uuid: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_my: %s", err))
 	}
 
 	// ------------- Optional query parameter "participated" -------------
@@ -348,66 +894,51 @@ func (w *ServerInterfaceWrapper) GetTask(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
 	}
 
-	// ------------- Optional query parameter "path" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "path", ctx.QueryParams(), &params.Path)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBoard(ctx, params)
+	return err
+}
 
-	// ------------- Optional query parameter "name" -------------
+// PostImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostImport(ctx echo.Context) error {
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "fields" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostImportParams
+	// ------------- Required query parameter "project_uuid" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	err = runtime.BindQueryParameter("form", true, true, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
 	}
 
-	// ------------- Optional query parameter "order" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostImport(ctx, params)
+	return err
+}
 
-	// ------------- Optional query parameter "by" -------------
+// GetImportUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetImportUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	err = runtime.BindQueryParameter("form", true, false, "by", ctx.QueryParams(), &params.By)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter by: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Optional query parameter "format" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTask(ctx, params)
+	err = w.Handler.GetImportUUID(ctx, uUID)
 	return err
 }
 
-// PostTask converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTask(ctx echo.Context) error {
-	var err error
-
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTask(ctx)
-	return err
-}
-
-// DeleteTaskUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTaskUUID(ctx echo.Context) error {
+// PostImportUUIDCheck converts echo context to params.
+func (w *ServerInterfaceWrapper) PostImportUUIDCheck(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -420,12 +951,12 @@ func (w *ServerInterfaceWrapper) DeleteTaskUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTaskUUID(ctx, uUID)
+	err = w.Handler.PostImportUUIDCheck(ctx, uUID)
 	return err
 }
 
-// GetTaskUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTaskUUID(ctx echo.Context) error {
+// PostImportUUIDCommit converts echo context to params.
+func (w *ServerInterfaceWrapper) PostImportUUIDCommit(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -438,30 +969,43 @@ func (w *ServerInterfaceWrapper) GetTaskUUID(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTaskUUID(ctx, uUID)
+	err = w.Handler.PostImportUUIDCommit(ctx, uUID)
 	return err
 }
 
-// PutTaskUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PutTaskUUID(ctx echo.Context) error {
+// GetRecurring converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecurring(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRecurringParams
+	// ------------- Optional query parameter "project_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
 	}
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRecurring(ctx, params)
+	return err
+}
+
+// PostRecurring converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRecurring(ctx echo.Context) error {
+	var err error
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutTaskUUID(ctx, uUID)
+	err = w.Handler.PostRecurring(ctx)
 	return err
 }
 
-// GetTaskUUIDActivity converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTaskUUIDActivity(ctx echo.Context) error {
+// DeleteRecurringUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRecurringUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -473,29 +1017,13 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDActivity(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTaskUUIDActivityParams
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTaskUUIDActivity(ctx, uUID, params)
+	err = w.Handler.DeleteRecurringUUID(ctx, uUID)
 	return err
 }
 
-// GetTaskUUIDComment converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTaskUUIDComment(ctx echo.Context) error {
+// GetRecurringUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecurringUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -508,12 +1036,12 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDComment(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTaskUUIDComment(ctx, uUID)
+	err = w.Handler.GetRecurringUUID(ctx, uUID)
 	return err
 }
 
-// PostTaskUUIDComment converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTaskUUIDComment(ctx echo.Context) error {
+// PatchRecurringUUIDPause converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchRecurringUUIDPause(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -526,12 +1054,12 @@ func (w *ServerInterfaceWrapper) PostTaskUUIDComment(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTaskUUIDComment(ctx, uUID)
+	err = w.Handler.PatchRecurringUUIDPause(ctx, uUID)
 	return err
 }
 
-// DeleteTaskUUIDCommentEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTaskUUIDCommentEntityUUID(ctx echo.Context) error {
+// GetRecurringUUIDPreview converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecurringUUIDPreview(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -541,209 +1069,298 @@ func (w *ServerInterfaceWrapper) DeleteTaskUUIDCommentEntityUUID(ctx echo.Contex
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRecurringUUIDPreviewParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTaskUUIDCommentEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.GetRecurringUUIDPreview(ctx, uUID, params)
 	return err
 }
 
-// PatchTaskUUIDCommentEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUID(ctx echo.Context) error {
+// GetTask converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTask(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "is_my" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDCommentEntityUUID(ctx, uUID, entityUUID)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "is_my", ctx.QueryParams(), &params.IsMy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_my: %s", err))
+	}
 
-// DeleteTaskUUIDCommentEntityUUIDFileFileUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	// ------------- Optional query parameter "is_epic" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	err = runtime.BindQueryParameter("form", true, false, "is_epic", ctx.QueryParams(), &params.IsEpic)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_epic: %s", err))
 	}
 
-	// ------------- Path parameter "fileUUID" -------------
-	var fileUUID FileUUID
+	// ------------- Required query parameter "project_uuid" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "fileUUID", runtime.ParamLocationPath, ctx.Param("fileUUID"), &fileUUID)
+	err = runtime.BindQueryParameter("form", true, true, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fileUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Required query parameter "federation_uuid" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx, uUID, entityUUID, fileUUID)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, true, "federation_uuid", ctx.QueryParams(), &params.FederationUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federation_uuid: %s", err))
+	}
 
-// PatchTaskUUIDCommentEntityUUIDLike converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDLike(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// ------------- Optional query parameter "participated" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindQueryParameter("form", true, false, "participated", ctx.QueryParams(), &params.Participated)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participated: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	// ------------- Optional query parameter "tags" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "path" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDCommentEntityUUIDLike(ctx, uUID, entityUUID)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "path", ctx.QueryParams(), &params.Path)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err))
+	}
 
-// PatchTaskUUIDCommentEntityUUIDPin converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// ------------- Optional query parameter "name" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	// ------------- Optional query parameter "search" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "fields" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDCommentEntityUUIDPin(ctx, uUID, entityUUID)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
 
-// PatchTaskUUIDName converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDName(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// ------------- Optional query parameter "filter" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "order" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDName(ctx, uUID)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
 
-// PatchTaskUUIDParent converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDParent(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// ------------- Optional query parameter "by" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindQueryParameter("form", true, false, "by", ctx.QueryParams(), &params.By)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter by: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "format" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDParent(ctx, uUID)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
 
-// PatchTaskUUIDProject converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDProject(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
+	// ------------- Optional query parameter "cursor" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "total", ctx.QueryParams(), &params.Total)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter total: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDProject(ctx, uUID)
+	err = w.Handler.GetTask(ctx, params)
 	return err
 }
 
-// PatchTaskUUIDStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDStatus(ctx echo.Context) error {
+// PostTask converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTask(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
-	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDStatus(ctx, uUID)
+	err = w.Handler.PostTask(ctx)
 	return err
 }
 
-// DeleteTaskUUIDStopEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTaskUUIDStopEntityUUID(ctx echo.Context) error {
+// PostTaskBulk converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskBulk(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "UUID" -------------
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskBulk(ctx)
+	return err
+}
+
+// GetTaskExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskExport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskExportParams
+	// ------------- Required query parameter "project_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
+	}
+
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "background" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "background", ctx.QueryParams(), &params.Background)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter background: %s", err))
+	}
+
+	// ------------- Optional query parameter "is_my" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_my", ctx.QueryParams(), &params.IsMy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_my: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "is_epic" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_epic", ctx.QueryParams(), &params.IsEpic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_epic: %s", err))
+	}
+
+	// ------------- Optional query parameter "participated" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "participated", ctx.QueryParams(), &params.Participated)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participated: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", ctx.QueryParams(), &params.Path)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskExport(ctx, params)
+	return err
+}
+
+// GetTaskExportUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskExportUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
 
 	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
@@ -751,23 +1368,33 @@ func (w *ServerInterfaceWrapper) DeleteTaskUUIDStopEntityUUID(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskExportUUID(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTaskUUIDStopEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.DeleteTaskUUID(ctx, uUID)
 	return err
 }
 
-// PatchTaskUUIDTeam converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDTeam(ctx echo.Context) error {
+// GetTaskUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -780,12 +1407,12 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDTeam(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDTeam(ctx, uUID)
+	err = w.Handler.GetTaskUUID(ctx, uUID)
 	return err
 }
 
-// GetTaskUUIDUpload converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTaskUUIDUpload(ctx echo.Context) error {
+// PutTaskUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTaskUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -798,12 +1425,12 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDUpload(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTaskUUIDUpload(ctx, uUID)
+	err = w.Handler.PutTaskUUID(ctx, uUID)
 	return err
 }
 
-// PatchTaskUUIDUpload converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTaskUUIDUpload(ctx echo.Context) error {
+// GetTaskUUIDActivity converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDActivity(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -815,13 +1442,83 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDUpload(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskUUIDActivityParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTaskUUIDUpload(ctx, uUID)
+	err = w.Handler.GetTaskUUIDActivity(ctx, uUID, params)
 	return err
 }
 
-// DeleteTaskUUIDUploadEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context) error {
+// PatchTaskUUIDBoard converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDBoard(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDBoard(ctx, uUID)
+	return err
+}
+
+// GetTaskUUIDChecklist converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDChecklist(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDChecklist(ctx, uUID)
+	return err
+}
+
+// PostTaskUUIDChecklist converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDChecklist(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDChecklist(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDChecklistEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDChecklistEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -842,12 +1539,12 @@ func (w *ServerInterfaceWrapper) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTaskUUIDUploadEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.DeleteTaskUUIDChecklistEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// GetTaskUUIDUploadEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTaskUUIDUploadEntityUUID(ctx echo.Context) error {
+// PutTaskUUIDChecklistEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTaskUUIDChecklistEntityUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -868,12 +1565,12 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDUploadEntityUUID(ctx echo.Context) e
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTaskUUIDUploadEntityUUID(ctx, uUID, entityUUID)
+	err = w.Handler.PutTaskUUIDChecklistEntityUUID(ctx, uUID, entityUUID)
 	return err
 }
 
-// PostTaskUUIDUploadEntityUUIDRename converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context) error {
+// PostTaskUUIDChecklistEntityUUIDConvert converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDChecklistEntityUUIDConvert(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -894,44 +1591,976 @@ func (w *ServerInterfaceWrapper) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Con
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTaskUUIDUploadEntityUUIDRename(ctx, uUID, entityUUID)
+	err = w.Handler.PostTaskUUIDChecklistEntityUUIDConvert(ctx, uUID, entityUUID)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// PatchTaskUUIDChecklistEntityUUIDDone converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDChecklistEntityUUIDDone(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDChecklistEntityUUIDDone(ctx, uUID, entityUUID)
+	return err
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// PatchTaskUUIDChecklistEntityUUIDMove converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDChecklistEntityUUIDMove(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	router.GET(baseURL+"/task", wrapper.GetTask)
-	router.POST(baseURL+"/task", wrapper.PostTask)
-	router.DELETE(baseURL+"/task/:UUID", wrapper.DeleteTaskUUID)
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDChecklistEntityUUIDMove(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostTaskUUIDClone converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDClone(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDClone(ctx, uUID)
+	return err
+}
+
+// GetTaskUUIDComment converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDComment(ctx, uUID)
+	return err
+}
+
+// PostTaskUUIDComment converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDComment(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDCommentEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDCommentEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDCommentEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PatchTaskUUIDCommentEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDCommentEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// DeleteTaskUUIDCommentEntityUUIDFileFileUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	// ------------- Path parameter "fileUUID" -------------
+	var fileUUID FileUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "fileUUID", runtime.ParamLocationPath, ctx.Param("fileUUID"), &fileUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fileUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx, uUID, entityUUID, fileUUID)
+	return err
+}
+
+// PatchTaskUUIDCommentEntityUUIDLike converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDLike(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDCommentEntityUUIDLike(ctx, uUID, entityUUID)
+	return err
+}
+
+// PatchTaskUUIDCommentEntityUUIDPin converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDCommentEntityUUIDPin(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetTaskUUIDHistoryDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDHistoryDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskUUIDHistoryDiffParams
+	// ------------- Required query parameter "date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", ctx.QueryParams(), &params.DateFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_from: %s", err))
+	}
+
+	// ------------- Required query parameter "date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date_to", ctx.QueryParams(), &params.DateTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDHistoryDiff(ctx, uUID, params)
+	return err
+}
+
+// GetTaskUUIDHistoryState converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDHistoryState(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskUUIDHistoryStateParams
+	// ------------- Required query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "at", ctx.QueryParams(), &params.At)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter at: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDHistoryState(ctx, uUID, params)
+	return err
+}
+
+// GetTaskUUIDLink converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDLink(ctx, uUID)
+	return err
+}
+
+// PostTaskUUIDLink converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDLink(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDLinkEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDLinkEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDLinkEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostTaskUUIDMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDMerge(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDMerge(ctx, uUID)
+	return err
+}
+
+// PatchTaskUUIDName converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDName(ctx, uUID)
+	return err
+}
+
+// PatchTaskUUIDParent converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDParent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDParent(ctx, uUID)
+	return err
+}
+
+// PatchTaskUUIDProject converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDProject(ctx, uUID)
+	return err
+}
+
+// PatchTaskUUIDSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDSchedule(ctx, uUID)
+	return err
+}
+
+// PatchTaskUUIDStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDStatus(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDStopEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDStopEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDStopEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PatchTaskUUIDTeam converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDTeam(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDTeam(ctx, uUID)
+	return err
+}
+
+// PostTaskUUIDTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDTimer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDTimer(ctx, uUID)
+	return err
+}
+
+// GetTaskUUIDUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDUpload(ctx, uUID)
+	return err
+}
+
+// PatchTaskUUIDUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDUpload(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDUploadEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDUploadEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetTaskUUIDUploadEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDUploadEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDUploadEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostTaskUUIDUploadEntityUUIDRename converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDUploadEntityUUIDRename(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetTaskUUIDWorklog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDWorklog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDWorklog(ctx, uUID)
+	return err
+}
+
+// PostTaskUUIDWorklog converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDWorklog(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDWorklog(ctx, uUID)
+	return err
+}
+
+// DeleteTaskUUIDWorklogEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskUUIDWorklogEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskUUIDWorklogEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTimer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTimer(ctx)
+	return err
+}
+
+// PostTimerStop converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTimerStop(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTimerStop(ctx)
+	return err
+}
+
+// GetView converts echo context to params.
+func (w *ServerInterfaceWrapper) GetView(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetViewParams
+	// ------------- Required query parameter "project_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetView(ctx, params)
+	return err
+}
+
+// PostView converts echo context to params.
+func (w *ServerInterfaceWrapper) PostView(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostView(ctx)
+	return err
+}
+
+// DeleteViewUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteViewUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteViewUUID(ctx, uUID)
+	return err
+}
+
+// GetViewUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetViewUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetViewUUID(ctx, uUID)
+	return err
+}
+
+// PutViewUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutViewUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutViewUUID(ctx, uUID)
+	return err
+}
+
+// GetViewUUIDTask converts echo context to params.
+func (w *ServerInterfaceWrapper) GetViewUUIDTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetViewUUIDTaskParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "total", ctx.QueryParams(), &params.Total)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter total: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetViewUUIDTask(ctx, uUID, params)
+	return err
+}
+
+// GetWorklogReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWorklogReport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorklogReportParams
+	// ------------- Required query parameter "federation_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "federation_uuid", ctx.QueryParams(), &params.FederationUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federation_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "project_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
+	}
+
+	// ------------- Required query parameter "date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", ctx.QueryParams(), &params.DateFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_from: %s", err))
+	}
+
+	// ------------- Required query parameter "date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date_to", ctx.QueryParams(), &params.DateTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date_to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWorklogReport(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/board", wrapper.GetBoard)
+	router.POST(baseURL+"/import", wrapper.PostImport)
+	router.GET(baseURL+"/import/:UUID", wrapper.GetImportUUID)
+	router.POST(baseURL+"/import/:UUID/check", wrapper.PostImportUUIDCheck)
+	router.POST(baseURL+"/import/:UUID/commit", wrapper.PostImportUUIDCommit)
+	router.GET(baseURL+"/recurring", wrapper.GetRecurring)
+	router.POST(baseURL+"/recurring", wrapper.PostRecurring)
+	router.DELETE(baseURL+"/recurring/:UUID", wrapper.DeleteRecurringUUID)
+	router.GET(baseURL+"/recurring/:UUID", wrapper.GetRecurringUUID)
+	router.PATCH(baseURL+"/recurring/:UUID/pause", wrapper.PatchRecurringUUIDPause)
+	router.GET(baseURL+"/recurring/:UUID/preview", wrapper.GetRecurringUUIDPreview)
+	router.GET(baseURL+"/task", wrapper.GetTask)
+	router.POST(baseURL+"/task", wrapper.PostTask)
+	router.POST(baseURL+"/task/bulk", wrapper.PostTaskBulk)
+	router.GET(baseURL+"/task/export", wrapper.GetTaskExport)
+	router.GET(baseURL+"/task/export/:UUID", wrapper.GetTaskExportUUID)
+	router.DELETE(baseURL+"/task/:UUID", wrapper.DeleteTaskUUID)
 	router.GET(baseURL+"/task/:UUID", wrapper.GetTaskUUID)
 	router.PUT(baseURL+"/task/:UUID", wrapper.PutTaskUUID)
 	router.GET(baseURL+"/task/:UUID/activity", wrapper.GetTaskUUIDActivity)
+	router.PATCH(baseURL+"/task/:UUID/board", wrapper.PatchTaskUUIDBoard)
+	router.GET(baseURL+"/task/:UUID/checklist", wrapper.GetTaskUUIDChecklist)
+	router.POST(baseURL+"/task/:UUID/checklist", wrapper.PostTaskUUIDChecklist)
+	router.DELETE(baseURL+"/task/:UUID/checklist/:entityUUID", wrapper.DeleteTaskUUIDChecklistEntityUUID)
+	router.PUT(baseURL+"/task/:UUID/checklist/:entityUUID", wrapper.PutTaskUUIDChecklistEntityUUID)
+	router.POST(baseURL+"/task/:UUID/checklist/:entityUUID/convert", wrapper.PostTaskUUIDChecklistEntityUUIDConvert)
+	router.PATCH(baseURL+"/task/:UUID/checklist/:entityUUID/done", wrapper.PatchTaskUUIDChecklistEntityUUIDDone)
+	router.PATCH(baseURL+"/task/:UUID/checklist/:entityUUID/move", wrapper.PatchTaskUUIDChecklistEntityUUIDMove)
+	router.POST(baseURL+"/task/:UUID/clone", wrapper.PostTaskUUIDClone)
 	router.GET(baseURL+"/task/:UUID/comment", wrapper.GetTaskUUIDComment)
 	router.POST(baseURL+"/task/:UUID/comment", wrapper.PostTaskUUIDComment)
 	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID", wrapper.DeleteTaskUUIDCommentEntityUUID)
@@ -939,820 +2568,3083 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID/file/:fileUUID", wrapper.DeleteTaskUUIDCommentEntityUUIDFileFileUUID)
 	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/like", wrapper.PatchTaskUUIDCommentEntityUUIDLike)
 	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/pin", wrapper.PatchTaskUUIDCommentEntityUUIDPin)
+	router.GET(baseURL+"/task/:UUID/history/diff", wrapper.GetTaskUUIDHistoryDiff)
+	router.GET(baseURL+"/task/:UUID/history/state", wrapper.GetTaskUUIDHistoryState)
+	router.GET(baseURL+"/task/:UUID/link", wrapper.GetTaskUUIDLink)
+	router.POST(baseURL+"/task/:UUID/link", wrapper.PostTaskUUIDLink)
+	router.DELETE(baseURL+"/task/:UUID/link/:entityUUID", wrapper.DeleteTaskUUIDLinkEntityUUID)
+	router.POST(baseURL+"/task/:UUID/merge", wrapper.PostTaskUUIDMerge)
 	router.PATCH(baseURL+"/task/:UUID/name", wrapper.PatchTaskUUIDName)
 	router.PATCH(baseURL+"/task/:UUID/parent", wrapper.PatchTaskUUIDParent)
 	router.PATCH(baseURL+"/task/:UUID/project", wrapper.PatchTaskUUIDProject)
+	router.PATCH(baseURL+"/task/:UUID/schedule", wrapper.PatchTaskUUIDSchedule)
 	router.PATCH(baseURL+"/task/:UUID/status", wrapper.PatchTaskUUIDStatus)
 	router.DELETE(baseURL+"/task/:UUID/stop/:entityUUID", wrapper.DeleteTaskUUIDStopEntityUUID)
 	router.PATCH(baseURL+"/task/:UUID/team", wrapper.PatchTaskUUIDTeam)
+	router.POST(baseURL+"/task/:UUID/timer", wrapper.PostTaskUUIDTimer)
 	router.GET(baseURL+"/task/:UUID/upload", wrapper.GetTaskUUIDUpload)
 	router.PATCH(baseURL+"/task/:UUID/upload", wrapper.PatchTaskUUIDUpload)
 	router.DELETE(baseURL+"/task/:UUID/upload/:entityUUID", wrapper.DeleteTaskUUIDUploadEntityUUID)
 	router.GET(baseURL+"/task/:UUID/upload/:entityUUID", wrapper.GetTaskUUIDUploadEntityUUID)
 	router.POST(baseURL+"/task/:UUID/upload/:entityUUID/rename", wrapper.PostTaskUUIDUploadEntityUUIDRename)
+	router.GET(baseURL+"/task/:UUID/worklog", wrapper.GetTaskUUIDWorklog)
+	router.POST(baseURL+"/task/:UUID/worklog", wrapper.PostTaskUUIDWorklog)
+	router.DELETE(baseURL+"/task/:UUID/worklog/:entityUUID", wrapper.DeleteTaskUUIDWorklogEntityUUID)
+	router.GET(baseURL+"/timer", wrapper.GetTimer)
+	router.POST(baseURL+"/timer/stop", wrapper.PostTimerStop)
+	router.GET(baseURL+"/view", wrapper.GetView)
+	router.POST(baseURL+"/view", wrapper.PostView)
+	router.DELETE(baseURL+"/view/:UUID", wrapper.DeleteViewUUID)
+	router.GET(baseURL+"/view/:UUID", wrapper.GetViewUUID)
+	router.PUT(baseURL+"/view/:UUID", wrapper.PutViewUUID)
+	router.GET(baseURL+"/view/:UUID/task", wrapper.GetViewUUIDTask)
+	router.GET(baseURL+"/worklog/report", wrapper.GetWorklogReport)
+
+}
+
+type GetBoardRequestObject struct {
+	Params GetBoardParams
+}
+
+type GetBoardResponseObject interface {
+	VisitGetBoardResponse(w http.ResponseWriter) error
+}
+
+type GetBoard200JSONResponse struct {
+	Columns []BoardColumnDTO `json:"columns"`
+}
+
+func (response GetBoard200JSONResponse) VisitGetBoardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImportRequestObject struct {
+	Params PostImportParams
+	Body   *multipart.Reader
+}
+
+type PostImportResponseObject interface {
+	VisitPostImportResponse(w http.ResponseWriter) error
+}
+
+type PostImport200JSONResponse TaskImportDTO
+
+func (response PostImport200JSONResponse) VisitPostImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetImportUUIDResponseObject interface {
+	VisitGetImportUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetImportUUID200JSONResponse TaskImportDTO
+
+func (response GetImportUUID200JSONResponse) VisitGetImportUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImportUUIDCheckRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostImportUUIDCheckJSONRequestBody
+}
+
+type PostImportUUIDCheckResponseObject interface {
+	VisitPostImportUUIDCheckResponse(w http.ResponseWriter) error
+}
+
+type PostImportUUIDCheck200JSONResponse TaskImportDTO
+
+func (response PostImportUUIDCheck200JSONResponse) VisitPostImportUUIDCheckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImportUUIDCommitRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type PostImportUUIDCommitResponseObject interface {
+	VisitPostImportUUIDCommitResponse(w http.ResponseWriter) error
+}
+
+type PostImportUUIDCommit200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response PostImportUUIDCommit200TexteventStreamResponse) VisitPostImportUUIDCommitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetRecurringRequestObject struct {
+	Params GetRecurringParams
+}
+
+type GetRecurringResponseObject interface {
+	VisitGetRecurringResponse(w http.ResponseWriter) error
+}
+
+type GetRecurring200JSONResponse struct {
+	Count int                `json:"count"`
+	Items []RecurringTaskDTO `json:"items"`
+}
+
+func (response GetRecurring200JSONResponse) VisitGetRecurringResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRecurringRequestObject struct {
+	Body *PostRecurringJSONRequestBody
+}
+
+type PostRecurringResponseObject interface {
+	VisitPostRecurringResponse(w http.ResponseWriter) error
+}
+
+type PostRecurring200JSONResponse UUIDResponse
+
+func (response PostRecurring200JSONResponse) VisitPostRecurringResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRecurringUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteRecurringUUIDResponseObject interface {
+	VisitDeleteRecurringUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteRecurringUUID200Response struct {
+}
+
+func (response DeleteRecurringUUID200Response) VisitDeleteRecurringUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetRecurringUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetRecurringUUIDResponseObject interface {
+	VisitGetRecurringUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetRecurringUUID200JSONResponse RecurringTaskDTO
+
+func (response GetRecurringUUID200JSONResponse) VisitGetRecurringUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchRecurringUUIDPauseRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchRecurringUUIDPauseJSONRequestBody
+}
+
+type PatchRecurringUUIDPauseResponseObject interface {
+	VisitPatchRecurringUUIDPauseResponse(w http.ResponseWriter) error
+}
+
+type PatchRecurringUUIDPause200Response struct {
+}
+
+func (response PatchRecurringUUIDPause200Response) VisitPatchRecurringUUIDPauseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetRecurringUUIDPreviewRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetRecurringUUIDPreviewParams
+}
+
+type GetRecurringUUIDPreviewResponseObject interface {
+	VisitGetRecurringUUIDPreviewResponse(w http.ResponseWriter) error
+}
+
+type GetRecurringUUIDPreview200JSONResponse struct {
+	Count int         `json:"count"`
+	Items []time.Time `json:"items"`
+}
+
+func (response GetRecurringUUIDPreview200JSONResponse) VisitGetRecurringUUIDPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskRequestObject struct {
+	Params GetTaskParams
+}
+
+type GetTaskResponseObject interface {
+	VisitGetTaskResponse(w http.ResponseWriter) error
+}
+
+type GetTask200ResponseHeaders struct {
+	ContentDisposition string
+	ContentType        string
+	CacheControl       string
+}
+
+type GetTask200JSONResponse struct {
+	Body struct {
+		Count      int        `json:"count"`
+		Items      []TaskDTOs `json:"items"`
+		NextCursor *string    `json:"next_cursor,omitempty"`
+		Total      int64      `json:"total"`
+	}
+	Headers GetTask200ResponseHeaders
+}
+
+func (response GetTask200JSONResponse) VisitGetTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTask200ApplicationxlsxResponse struct {
+	Body          io.Reader
+	Headers       GetTask200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTask200ApplicationxlsxResponse) VisitGetTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xlsx")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostTaskRequestObject struct {
+	Body *PostTaskJSONRequestBody
+}
+
+type PostTaskResponseObject interface {
+	VisitPostTaskResponse(w http.ResponseWriter) error
+}
+
+type PostTask200JSONResponse struct {
+	Id   int                `json:"id"`
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTask200JSONResponse) VisitPostTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskBulkRequestObject struct {
+	Body *PostTaskBulkJSONRequestBody
+}
+
+type PostTaskBulkResponseObject interface {
+	VisitPostTaskBulkResponse(w http.ResponseWriter) error
+}
+
+type PostTaskBulk200JSONResponse struct {
+	Count  int                `json:"count"`
+	Failed int                `json:"failed"`
+	Items  []BulkResultDTO    `json:"items"`
+	Uuid   openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskBulk200JSONResponse) VisitPostTaskBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskExportRequestObject struct {
+	Params GetTaskExportParams
+}
+
+type GetTaskExportResponseObject interface {
+	VisitGetTaskExportResponse(w http.ResponseWriter) error
+}
+
+type GetTaskExport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetTaskExport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitGetTaskExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTaskExport200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetTaskExport200ApplicationxNdjsonResponse) VisitGetTaskExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTaskExport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetTaskExport200TextcsvResponse) VisitGetTaskExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTaskExport202JSONResponse TaskExportDTO
+
+func (response GetTaskExport202JSONResponse) VisitGetTaskExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskExportUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskExportUUIDResponseObject interface {
+	VisitGetTaskExportUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetTaskExportUUID200JSONResponse TaskExportDTO
+
+func (response GetTaskExportUUID200JSONResponse) VisitGetTaskExportUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteTaskUUIDResponseObject interface {
+	VisitDeleteTaskUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUID200Response struct {
+}
+
+func (response DeleteTaskUUID200Response) VisitDeleteTaskUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTaskUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskUUIDResponseObject interface {
+	VisitGetTaskUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUID200ResponseHeaders struct {
+	CacheControl string
+}
+
+type GetTaskUUID200JSONResponse struct {
+	Body    TaskDTO
+	Headers GetTaskUUID200ResponseHeaders
+}
+
+func (response GetTaskUUID200JSONResponse) VisitGetTaskUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutTaskUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PutTaskUUIDJSONRequestBody
+}
+
+type PutTaskUUIDResponseObject interface {
+	VisitPutTaskUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutTaskUUID200Response struct {
+}
+
+func (response PutTaskUUID200Response) VisitPutTaskUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTaskUUIDActivityRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetTaskUUIDActivityParams
+}
+
+type GetTaskUUIDActivityResponseObject interface {
+	VisitGetTaskUUIDActivityResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDActivity200JSONResponse struct {
+	Count int           `json:"count"`
+	Items []ActivityDTO `json:"items"`
+	Total int64         `json:"total"`
+}
+
+func (response GetTaskUUIDActivity200JSONResponse) VisitGetTaskUUIDActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTaskUUIDBoardRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTaskUUIDBoardJSONRequestBody
+}
+
+type PatchTaskUUIDBoardResponseObject interface {
+	VisitPatchTaskUUIDBoardResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDBoard200JSONResponse struct {
+	BoardRank string `json:"board_rank"`
+}
+
+func (response PatchTaskUUIDBoard200JSONResponse) VisitPatchTaskUUIDBoardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskUUIDChecklistRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskUUIDChecklistResponseObject interface {
+	VisitGetTaskUUIDChecklistResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDChecklist200JSONResponse struct {
+	Count int                `json:"count"`
+	Items []ChecklistItemDTO `json:"items"`
+}
+
+func (response GetTaskUUIDChecklist200JSONResponse) VisitGetTaskUUIDChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDChecklistRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDChecklistJSONRequestBody
+}
+
+type PostTaskUUIDChecklistResponseObject interface {
+	VisitPostTaskUUIDChecklistResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDChecklist200JSONResponse ChecklistItemDTO
+
+func (response PostTaskUUIDChecklist200JSONResponse) VisitPostTaskUUIDChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDChecklistEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDChecklistEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDChecklistEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDChecklistEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDChecklistEntityUUID200Response) VisitDeleteTaskUUIDChecklistEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutTaskUUIDChecklistEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PutTaskUUIDChecklistEntityUUIDJSONRequestBody
+}
+
+type PutTaskUUIDChecklistEntityUUIDResponseObject interface {
+	VisitPutTaskUUIDChecklistEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutTaskUUIDChecklistEntityUUID200JSONResponse ChecklistItemDTO
+
+func (response PutTaskUUIDChecklistEntityUUID200JSONResponse) VisitPutTaskUUIDChecklistEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDChecklistEntityUUIDConvertRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type PostTaskUUIDChecklistEntityUUIDConvertResponseObject interface {
+	VisitPostTaskUUIDChecklistEntityUUIDConvertResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDChecklistEntityUUIDConvert200JSONResponse struct {
+	Id   int                `json:"id"`
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskUUIDChecklistEntityUUIDConvert200JSONResponse) VisitPostTaskUUIDChecklistEntityUUIDConvertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTaskUUIDChecklistEntityUUIDDoneRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchTaskUUIDChecklistEntityUUIDDoneJSONRequestBody
+}
+
+type PatchTaskUUIDChecklistEntityUUIDDoneResponseObject interface {
+	VisitPatchTaskUUIDChecklistEntityUUIDDoneResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDChecklistEntityUUIDDone200JSONResponse ChecklistItemDTO
+
+func (response PatchTaskUUIDChecklistEntityUUIDDone200JSONResponse) VisitPatchTaskUUIDChecklistEntityUUIDDoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTaskUUIDChecklistEntityUUIDMoveRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchTaskUUIDChecklistEntityUUIDMoveJSONRequestBody
+}
+
+type PatchTaskUUIDChecklistEntityUUIDMoveResponseObject interface {
+	VisitPatchTaskUUIDChecklistEntityUUIDMoveResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDChecklistEntityUUIDMove200JSONResponse ChecklistItemDTO
+
+func (response PatchTaskUUIDChecklistEntityUUIDMove200JSONResponse) VisitPatchTaskUUIDChecklistEntityUUIDMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDCloneRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDCloneJSONRequestBody
+}
+
+type PostTaskUUIDCloneResponseObject interface {
+	VisitPostTaskUUIDCloneResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDClone200JSONResponse struct {
+	Count int                `json:"count"`
+	Uuid  openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskUUIDClone200JSONResponse) VisitPostTaskUUIDCloneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskUUIDCommentRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskUUIDCommentResponseObject interface {
+	VisitGetTaskUUIDCommentResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDComment200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []CommentDTO `json:"items"`
+}
+
+func (response GetTaskUUIDComment200JSONResponse) VisitGetTaskUUIDCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDCommentRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *multipart.Reader
+}
+
+type PostTaskUUIDCommentResponseObject interface {
+	VisitPostTaskUUIDCommentResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDComment200JSONResponse struct {
+	Comment      string             `json:"comment"`
+	People       *[]UserDTO         `json:"people,omitempty"`
+	ReplyMessage *string            `json:"reply_message,omitempty"`
+	Uploads      *[]UploadDTO       `json:"uploads,omitempty"`
+	Uuid         openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskUUIDComment200JSONResponse) VisitPostTaskUUIDCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDCommentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDCommentEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDCommentEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDCommentEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDCommentEntityUUID200Response) VisitDeleteTaskUUIDCommentEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchTaskUUIDCommentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *multipart.Reader
+}
+
+type PatchTaskUUIDCommentEntityUUIDResponseObject interface {
+	VisitPatchTaskUUIDCommentEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDCommentEntityUUID200JSONResponse struct {
+	Comment      string             `json:"comment"`
+	People       *[]UserDTO         `json:"people,omitempty"`
+	ReplyMessage *string            `json:"reply_message,omitempty"`
+	Uploads      *[]UploadDTO       `json:"uploads,omitempty"`
+	Uuid         openapi_types.UUID `json:"uuid"`
+}
+
+func (response PatchTaskUUIDCommentEntityUUID200JSONResponse) VisitPatchTaskUUIDCommentEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	FileUUID   FileUUID   `json:"fileUUID"`
+}
+
+type DeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponseObject interface {
+	VisitDeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDCommentEntityUUIDFileFileUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDCommentEntityUUIDFileFileUUID200Response) VisitDeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchTaskUUIDCommentEntityUUIDLikeRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type PatchTaskUUIDCommentEntityUUIDLikeResponseObject interface {
+	VisitPatchTaskUUIDCommentEntityUUIDLikeResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDCommentEntityUUIDLike200JSONResponse struct {
+	Liked bool      `json:"liked"`
+	Likes []UserDTO `json:"likes"`
+}
+
+func (response PatchTaskUUIDCommentEntityUUIDLike200JSONResponse) VisitPatchTaskUUIDCommentEntityUUIDLikeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTaskUUIDCommentEntityUUIDPinRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type PatchTaskUUIDCommentEntityUUIDPinResponseObject interface {
+	VisitPatchTaskUUIDCommentEntityUUIDPinResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDCommentEntityUUIDPin200Response struct {
+}
+
+func (response PatchTaskUUIDCommentEntityUUIDPin200Response) VisitPatchTaskUUIDCommentEntityUUIDPinResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTaskUUIDHistoryDiffRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetTaskUUIDHistoryDiffParams
+}
+
+type GetTaskUUIDHistoryDiffResponseObject interface {
+	VisitGetTaskUUIDHistoryDiffResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDHistoryDiff200JSONResponse struct {
+	Items []TaskStateChange `json:"items"`
+}
+
+func (response GetTaskUUIDHistoryDiff200JSONResponse) VisitGetTaskUUIDHistoryDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskUUIDHistoryStateRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetTaskUUIDHistoryStateParams
+}
+
+type GetTaskUUIDHistoryStateResponseObject interface {
+	VisitGetTaskUUIDHistoryStateResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDHistoryState200JSONResponse struct {
+	At    time.Time              `json:"at"`
+	Id    int                    `json:"id"`
+	State map[string]interface{} `json:"state"`
+	Uuid  openapi_types.UUID     `json:"uuid"`
+}
+
+func (response GetTaskUUIDHistoryState200JSONResponse) VisitGetTaskUUIDHistoryStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskUUIDLinkRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskUUIDLinkResponseObject interface {
+	VisitGetTaskUUIDLinkResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDLink200JSONResponse struct {
+	Count int           `json:"count"`
+	Items []TaskLinkDTO `json:"items"`
+}
+
+func (response GetTaskUUIDLink200JSONResponse) VisitGetTaskUUIDLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDLinkRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDLinkJSONRequestBody
+}
+
+type PostTaskUUIDLinkResponseObject interface {
+	VisitPostTaskUUIDLinkResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDLink200JSONResponse UUIDResponse
+
+func (response PostTaskUUIDLink200JSONResponse) VisitPostTaskUUIDLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDLinkEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDLinkEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDLinkEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDLinkEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDLinkEntityUUID200Response) VisitDeleteTaskUUIDLinkEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostTaskUUIDMergeRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDMergeJSONRequestBody
+}
+
+type PostTaskUUIDMergeResponseObject interface {
+	VisitPostTaskUUIDMergeResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDMerge200JSONResponse struct {
+	// Conflicts Hashes of fields filled in both tasks
+	Conflicts []string `json:"conflicts"`
+}
+
+func (response PostTaskUUIDMerge200JSONResponse) VisitPostTaskUUIDMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTaskUUIDNameRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTaskUUIDNameJSONRequestBody
+}
+
+type PatchTaskUUIDNameResponseObject interface {
+	VisitPatchTaskUUIDNameResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDName200Response struct {
+}
+
+func (response PatchTaskUUIDName200Response) VisitPatchTaskUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchTaskUUIDName400Response struct {
+}
+
+func (response PatchTaskUUIDName400Response) VisitPatchTaskUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PatchTaskUUIDName401Response struct {
+}
+
+func (response PatchTaskUUIDName401Response) VisitPatchTaskUUIDNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PatchTaskUUIDParentRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTaskUUIDParentJSONRequestBody
+}
+
+type PatchTaskUUIDParentResponseObject interface {
+	VisitPatchTaskUUIDParentResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDParent200Response struct {
+}
+
+func (response PatchTaskUUIDParent200Response) VisitPatchTaskUUIDParentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchTaskUUIDProjectRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTaskUUIDProjectJSONRequestBody
+}
+
+type PatchTaskUUIDProjectResponseObject interface {
+	VisitPatchTaskUUIDProjectResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDProject200Response struct {
+}
+
+func (response PatchTaskUUIDProject200Response) VisitPatchTaskUUIDProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchTaskUUIDScheduleRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTaskUUIDScheduleJSONRequestBody
+}
+
+type PatchTaskUUIDScheduleResponseObject interface {
+	VisitPatchTaskUUIDScheduleResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDSchedule200JSONResponse struct {
+	Items []TimelineTaskDTO `json:"items"`
+}
+
+func (response PatchTaskUUIDSchedule200JSONResponse) VisitPatchTaskUUIDScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTaskUUIDStatusRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTaskUUIDStatusJSONRequestBody
+}
+
+type PatchTaskUUIDStatusResponseObject interface {
+	VisitPatchTaskUUIDStatusResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDStatus200JSONResponse struct {
+	Path     []string           `json:"path"`
+	StopUuid openapi_types.UUID `json:"stop_uuid"`
+}
+
+func (response PatchTaskUUIDStatus200JSONResponse) VisitPatchTaskUUIDStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDStopEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDStopEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDStopEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDStopEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDStopEntityUUID200Response) VisitDeleteTaskUUIDStopEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchTaskUUIDTeamRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchTaskUUIDTeamJSONRequestBody
+}
+
+type PatchTaskUUIDTeamResponseObject interface {
+	VisitPatchTaskUUIDTeamResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDTeam200JSONResponse struct {
+	CoworkersBy   []UserDTO `json:"coworkers_by"`
+	ImplementBy   *UserDTO  `json:"implement_by,omitempty"`
+	ManagedBy     *UserDTO  `json:"managed_by,omitempty"`
+	ResponsibleBy *UserDTO  `json:"responsible_by,omitempty"`
+	WatchedBy     []UserDTO `json:"watched_by"`
+}
+
+func (response PatchTaskUUIDTeam200JSONResponse) VisitPatchTaskUUIDTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDTimerRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type PostTaskUUIDTimerResponseObject interface {
+	VisitPostTaskUUIDTimerResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDTimer200JSONResponse WorklogDTO
+
+func (response PostTaskUUIDTimer200JSONResponse) VisitPostTaskUUIDTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskUUIDUploadRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskUUIDUploadResponseObject interface {
+	VisitGetTaskUUIDUploadResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDUpload200JSONResponse struct {
+	Count int         `json:"count"`
+	Items []UploadDTO `json:"items"`
+}
+
+func (response GetTaskUUIDUpload200JSONResponse) VisitGetTaskUUIDUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTaskUUIDUploadRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *multipart.Reader
+}
+
+type PatchTaskUUIDUploadResponseObject interface {
+	VisitPatchTaskUUIDUploadResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDUpload200JSONResponse UploadDTO
+
+func (response PatchTaskUUIDUpload200JSONResponse) VisitPatchTaskUUIDUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDUploadEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDUploadEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDUploadEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDUploadEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDUploadEntityUUID200Response) VisitDeleteTaskUUIDUploadEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTaskUUIDUploadEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetTaskUUIDUploadEntityUUIDResponseObject interface {
+	VisitGetTaskUUIDUploadEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDUploadEntityUUID302ResponseHeaders struct {
+	Location string
+}
+
+type GetTaskUUIDUploadEntityUUID302Response struct {
+	Headers GetTaskUUIDUploadEntityUUID302ResponseHeaders
+}
+
+func (response GetTaskUUIDUploadEntityUUID302Response) VisitGetTaskUUIDUploadEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type PostTaskUUIDUploadEntityUUIDRenameRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody
+}
+
+type PostTaskUUIDUploadEntityUUIDRenameResponseObject interface {
+	VisitPostTaskUUIDUploadEntityUUIDRenameResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDUploadEntityUUIDRename200Response struct {
+}
+
+func (response PostTaskUUIDUploadEntityUUIDRename200Response) VisitPostTaskUUIDUploadEntityUUIDRenameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTaskUUIDWorklogRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetTaskUUIDWorklogResponseObject interface {
+	VisitGetTaskUUIDWorklogResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDWorklog200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []WorklogDTO `json:"items"`
+
+	// Total seconds logged on the task
+	Total int64 `json:"total"`
+
+	// TotalWithChildren seconds logged on the task and its children
+	TotalWithChildren int64 `json:"total_with_children"`
+}
+
+func (response GetTaskUUIDWorklog200JSONResponse) VisitGetTaskUUIDWorklogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDWorklogRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostTaskUUIDWorklogJSONRequestBody
+}
+
+type PostTaskUUIDWorklogResponseObject interface {
+	VisitPostTaskUUIDWorklogResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDWorklog200JSONResponse UUIDResponse
+
+func (response PostTaskUUIDWorklog200JSONResponse) VisitPostTaskUUIDWorklogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskUUIDWorklogEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteTaskUUIDWorklogEntityUUIDResponseObject interface {
+	VisitDeleteTaskUUIDWorklogEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskUUIDWorklogEntityUUID200Response struct {
+}
+
+func (response DeleteTaskUUIDWorklogEntityUUID200Response) VisitDeleteTaskUUIDWorklogEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetTimerRequestObject struct {
+}
+
+type GetTimerResponseObject interface {
+	VisitGetTimerResponse(w http.ResponseWriter) error
+}
+
+type GetTimer200JSONResponse WorklogDTO
+
+func (response GetTimer200JSONResponse) VisitGetTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTimerStopRequestObject struct {
+	Body *PostTimerStopJSONRequestBody
+}
+
+type PostTimerStopResponseObject interface {
+	VisitPostTimerStopResponse(w http.ResponseWriter) error
+}
+
+type PostTimerStop200JSONResponse WorklogDTO
+
+func (response PostTimerStop200JSONResponse) VisitPostTimerStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetViewRequestObject struct {
+	Params GetViewParams
+}
+
+type GetViewResponseObject interface {
+	VisitGetViewResponse(w http.ResponseWriter) error
+}
+
+type GetView200JSONResponse struct {
+	Count int           `json:"count"`
+	Items []TaskViewDTO `json:"items"`
+}
+
+func (response GetView200JSONResponse) VisitGetViewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostViewRequestObject struct {
+	Body *PostViewJSONRequestBody
+}
+
+type PostViewResponseObject interface {
+	VisitPostViewResponse(w http.ResponseWriter) error
+}
+
+type PostView200JSONResponse UUIDResponse
+
+func (response PostView200JSONResponse) VisitPostViewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteViewUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteViewUUIDResponseObject interface {
+	VisitDeleteViewUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteViewUUID200Response struct {
+}
+
+func (response DeleteViewUUID200Response) VisitDeleteViewUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetViewUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetViewUUIDResponseObject interface {
+	VisitGetViewUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetViewUUID200JSONResponse TaskViewDTO
+
+func (response GetViewUUID200JSONResponse) VisitGetViewUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutViewUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PutViewUUIDJSONRequestBody
+}
+
+type PutViewUUIDResponseObject interface {
+	VisitPutViewUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutViewUUID200Response struct {
+}
+
+func (response PutViewUUID200Response) VisitPutViewUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetViewUUIDTaskRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetViewUUIDTaskParams
+}
+
+type GetViewUUIDTaskResponseObject interface {
+	VisitGetViewUUIDTaskResponse(w http.ResponseWriter) error
+}
+
+type GetViewUUIDTask200JSONResponse struct {
+	Columns    []string   `json:"columns"`
+	Count      int        `json:"count"`
+	Items      []TaskDTOs `json:"items"`
+	NextCursor *string    `json:"next_cursor,omitempty"`
+	Total      int64      `json:"total"`
+}
+
+func (response GetViewUUIDTask200JSONResponse) VisitGetViewUUIDTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorklogReportRequestObject struct {
+	Params GetWorklogReportParams
+}
+
+type GetWorklogReportResponseObject interface {
+	VisitGetWorklogReportResponse(w http.ResponseWriter) error
+}
+
+type GetWorklogReport200JSONResponse struct {
+	Count int                    `json:"count"`
+	Items []WorklogReportItemDTO `json:"items"`
+}
+
+func (response GetWorklogReport200JSONResponse) VisitGetWorklogReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /board)
+	GetBoard(ctx context.Context, request GetBoardRequestObject) (GetBoardResponseObject, error)
+
+	// (POST /import)
+	PostImport(ctx context.Context, request PostImportRequestObject) (PostImportResponseObject, error)
+
+	// (GET /import/{UUID})
+	GetImportUUID(ctx context.Context, request GetImportUUIDRequestObject) (GetImportUUIDResponseObject, error)
+
+	// (POST /import/{UUID}/check)
+	PostImportUUIDCheck(ctx context.Context, request PostImportUUIDCheckRequestObject) (PostImportUUIDCheckResponseObject, error)
+
+	// (POST /import/{UUID}/commit)
+	PostImportUUIDCommit(ctx context.Context, request PostImportUUIDCommitRequestObject) (PostImportUUIDCommitResponseObject, error)
+
+	// (GET /recurring)
+	GetRecurring(ctx context.Context, request GetRecurringRequestObject) (GetRecurringResponseObject, error)
+
+	// (POST /recurring)
+	PostRecurring(ctx context.Context, request PostRecurringRequestObject) (PostRecurringResponseObject, error)
+
+	// (DELETE /recurring/{UUID})
+	DeleteRecurringUUID(ctx context.Context, request DeleteRecurringUUIDRequestObject) (DeleteRecurringUUIDResponseObject, error)
+
+	// (GET /recurring/{UUID})
+	GetRecurringUUID(ctx context.Context, request GetRecurringUUIDRequestObject) (GetRecurringUUIDResponseObject, error)
+
+	// (PATCH /recurring/{UUID}/pause)
+	PatchRecurringUUIDPause(ctx context.Context, request PatchRecurringUUIDPauseRequestObject) (PatchRecurringUUIDPauseResponseObject, error)
+
+	// (GET /recurring/{UUID}/preview)
+	GetRecurringUUIDPreview(ctx context.Context, request GetRecurringUUIDPreviewRequestObject) (GetRecurringUUIDPreviewResponseObject, error)
+
+	// (GET /task)
+	GetTask(ctx context.Context, request GetTaskRequestObject) (GetTaskResponseObject, error)
+
+	// (POST /task)
+	PostTask(ctx context.Context, request PostTaskRequestObject) (PostTaskResponseObject, error)
+
+	// (POST /task/bulk)
+	PostTaskBulk(ctx context.Context, request PostTaskBulkRequestObject) (PostTaskBulkResponseObject, error)
+
+	// (GET /task/export)
+	GetTaskExport(ctx context.Context, request GetTaskExportRequestObject) (GetTaskExportResponseObject, error)
+
+	// (GET /task/export/{UUID})
+	GetTaskExportUUID(ctx context.Context, request GetTaskExportUUIDRequestObject) (GetTaskExportUUIDResponseObject, error)
+
+	// (DELETE /task/{UUID})
+	DeleteTaskUUID(ctx context.Context, request DeleteTaskUUIDRequestObject) (DeleteTaskUUIDResponseObject, error)
+
+	// (GET /task/{UUID})
+	GetTaskUUID(ctx context.Context, request GetTaskUUIDRequestObject) (GetTaskUUIDResponseObject, error)
+
+	// (PUT /task/{UUID})
+	PutTaskUUID(ctx context.Context, request PutTaskUUIDRequestObject) (PutTaskUUIDResponseObject, error)
+
+	// (GET /task/{UUID}/activity)
+	GetTaskUUIDActivity(ctx context.Context, request GetTaskUUIDActivityRequestObject) (GetTaskUUIDActivityResponseObject, error)
+
+	// (PATCH /task/{UUID}/board)
+	PatchTaskUUIDBoard(ctx context.Context, request PatchTaskUUIDBoardRequestObject) (PatchTaskUUIDBoardResponseObject, error)
+
+	// (GET /task/{UUID}/checklist)
+	GetTaskUUIDChecklist(ctx context.Context, request GetTaskUUIDChecklistRequestObject) (GetTaskUUIDChecklistResponseObject, error)
+
+	// (POST /task/{UUID}/checklist)
+	PostTaskUUIDChecklist(ctx context.Context, request PostTaskUUIDChecklistRequestObject) (PostTaskUUIDChecklistResponseObject, error)
+
+	// (DELETE /task/{UUID}/checklist/{entityUUID})
+	DeleteTaskUUIDChecklistEntityUUID(ctx context.Context, request DeleteTaskUUIDChecklistEntityUUIDRequestObject) (DeleteTaskUUIDChecklistEntityUUIDResponseObject, error)
+
+	// (PUT /task/{UUID}/checklist/{entityUUID})
+	PutTaskUUIDChecklistEntityUUID(ctx context.Context, request PutTaskUUIDChecklistEntityUUIDRequestObject) (PutTaskUUIDChecklistEntityUUIDResponseObject, error)
+
+	// (POST /task/{UUID}/checklist/{entityUUID}/convert)
+	PostTaskUUIDChecklistEntityUUIDConvert(ctx context.Context, request PostTaskUUIDChecklistEntityUUIDConvertRequestObject) (PostTaskUUIDChecklistEntityUUIDConvertResponseObject, error)
+
+	// (PATCH /task/{UUID}/checklist/{entityUUID}/done)
+	PatchTaskUUIDChecklistEntityUUIDDone(ctx context.Context, request PatchTaskUUIDChecklistEntityUUIDDoneRequestObject) (PatchTaskUUIDChecklistEntityUUIDDoneResponseObject, error)
+
+	// (PATCH /task/{UUID}/checklist/{entityUUID}/move)
+	PatchTaskUUIDChecklistEntityUUIDMove(ctx context.Context, request PatchTaskUUIDChecklistEntityUUIDMoveRequestObject) (PatchTaskUUIDChecklistEntityUUIDMoveResponseObject, error)
+
+	// (POST /task/{UUID}/clone)
+	PostTaskUUIDClone(ctx context.Context, request PostTaskUUIDCloneRequestObject) (PostTaskUUIDCloneResponseObject, error)
+
+	// (GET /task/{UUID}/comment)
+	GetTaskUUIDComment(ctx context.Context, request GetTaskUUIDCommentRequestObject) (GetTaskUUIDCommentResponseObject, error)
+
+	// (POST /task/{UUID}/comment)
+	PostTaskUUIDComment(ctx context.Context, request PostTaskUUIDCommentRequestObject) (PostTaskUUIDCommentResponseObject, error)
+
+	// (DELETE /task/{UUID}/comment/{entityUUID})
+	DeleteTaskUUIDCommentEntityUUID(ctx context.Context, request DeleteTaskUUIDCommentEntityUUIDRequestObject) (DeleteTaskUUIDCommentEntityUUIDResponseObject, error)
+
+	// (PATCH /task/{UUID}/comment/{entityUUID})
+	PatchTaskUUIDCommentEntityUUID(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDRequestObject) (PatchTaskUUIDCommentEntityUUIDResponseObject, error)
+
+	// (DELETE /task/{UUID}/comment/{entityUUID}/file/{fileUUID})
+	DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx context.Context, request DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject) (DeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponseObject, error)
+
+	// (PATCH /task/{UUID}/comment/{entityUUID}/like)
+	PatchTaskUUIDCommentEntityUUIDLike(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDLikeRequestObject) (PatchTaskUUIDCommentEntityUUIDLikeResponseObject, error)
+
+	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
+	PatchTaskUUIDCommentEntityUUIDPin(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDPinRequestObject) (PatchTaskUUIDCommentEntityUUIDPinResponseObject, error)
+
+	// (GET /task/{UUID}/history/diff)
+	GetTaskUUIDHistoryDiff(ctx context.Context, request GetTaskUUIDHistoryDiffRequestObject) (GetTaskUUIDHistoryDiffResponseObject, error)
+
+	// (GET /task/{UUID}/history/state)
+	GetTaskUUIDHistoryState(ctx context.Context, request GetTaskUUIDHistoryStateRequestObject) (GetTaskUUIDHistoryStateResponseObject, error)
+
+	// (GET /task/{UUID}/link)
+	GetTaskUUIDLink(ctx context.Context, request GetTaskUUIDLinkRequestObject) (GetTaskUUIDLinkResponseObject, error)
+
+	// (POST /task/{UUID}/link)
+	PostTaskUUIDLink(ctx context.Context, request PostTaskUUIDLinkRequestObject) (PostTaskUUIDLinkResponseObject, error)
+
+	// (DELETE /task/{UUID}/link/{entityUUID})
+	DeleteTaskUUIDLinkEntityUUID(ctx context.Context, request DeleteTaskUUIDLinkEntityUUIDRequestObject) (DeleteTaskUUIDLinkEntityUUIDResponseObject, error)
+
+	// (POST /task/{UUID}/merge)
+	PostTaskUUIDMerge(ctx context.Context, request PostTaskUUIDMergeRequestObject) (PostTaskUUIDMergeResponseObject, error)
+
+	// (PATCH /task/{UUID}/name)
+	PatchTaskUUIDName(ctx context.Context, request PatchTaskUUIDNameRequestObject) (PatchTaskUUIDNameResponseObject, error)
+
+	// (PATCH /task/{UUID}/parent)
+	PatchTaskUUIDParent(ctx context.Context, request PatchTaskUUIDParentRequestObject) (PatchTaskUUIDParentResponseObject, error)
+
+	// (PATCH /task/{UUID}/project)
+	PatchTaskUUIDProject(ctx context.Context, request PatchTaskUUIDProjectRequestObject) (PatchTaskUUIDProjectResponseObject, error)
+
+	// (PATCH /task/{UUID}/schedule)
+	PatchTaskUUIDSchedule(ctx context.Context, request PatchTaskUUIDScheduleRequestObject) (PatchTaskUUIDScheduleResponseObject, error)
+
+	// (PATCH /task/{UUID}/status)
+	PatchTaskUUIDStatus(ctx context.Context, request PatchTaskUUIDStatusRequestObject) (PatchTaskUUIDStatusResponseObject, error)
+
+	// (DELETE /task/{UUID}/stop/{entityUUID})
+	DeleteTaskUUIDStopEntityUUID(ctx context.Context, request DeleteTaskUUIDStopEntityUUIDRequestObject) (DeleteTaskUUIDStopEntityUUIDResponseObject, error)
+
+	// (PATCH /task/{UUID}/team)
+	PatchTaskUUIDTeam(ctx context.Context, request PatchTaskUUIDTeamRequestObject) (PatchTaskUUIDTeamResponseObject, error)
+
+	// (POST /task/{UUID}/timer)
+	PostTaskUUIDTimer(ctx context.Context, request PostTaskUUIDTimerRequestObject) (PostTaskUUIDTimerResponseObject, error)
+
+	// (GET /task/{UUID}/upload)
+	GetTaskUUIDUpload(ctx context.Context, request GetTaskUUIDUploadRequestObject) (GetTaskUUIDUploadResponseObject, error)
+
+	// (PATCH /task/{UUID}/upload)
+	PatchTaskUUIDUpload(ctx context.Context, request PatchTaskUUIDUploadRequestObject) (PatchTaskUUIDUploadResponseObject, error)
+
+	// (DELETE /task/{UUID}/upload/{entityUUID})
+	DeleteTaskUUIDUploadEntityUUID(ctx context.Context, request DeleteTaskUUIDUploadEntityUUIDRequestObject) (DeleteTaskUUIDUploadEntityUUIDResponseObject, error)
+
+	// (GET /task/{UUID}/upload/{entityUUID})
+	GetTaskUUIDUploadEntityUUID(ctx context.Context, request GetTaskUUIDUploadEntityUUIDRequestObject) (GetTaskUUIDUploadEntityUUIDResponseObject, error)
+
+	// (POST /task/{UUID}/upload/{entityUUID}/rename)
+	PostTaskUUIDUploadEntityUUIDRename(ctx context.Context, request PostTaskUUIDUploadEntityUUIDRenameRequestObject) (PostTaskUUIDUploadEntityUUIDRenameResponseObject, error)
+
+	// (GET /task/{UUID}/worklog)
+	GetTaskUUIDWorklog(ctx context.Context, request GetTaskUUIDWorklogRequestObject) (GetTaskUUIDWorklogResponseObject, error)
+
+	// (POST /task/{UUID}/worklog)
+	PostTaskUUIDWorklog(ctx context.Context, request PostTaskUUIDWorklogRequestObject) (PostTaskUUIDWorklogResponseObject, error)
+
+	// (DELETE /task/{UUID}/worklog/{entityUUID})
+	DeleteTaskUUIDWorklogEntityUUID(ctx context.Context, request DeleteTaskUUIDWorklogEntityUUIDRequestObject) (DeleteTaskUUIDWorklogEntityUUIDResponseObject, error)
+
+	// (GET /timer)
+	GetTimer(ctx context.Context, request GetTimerRequestObject) (GetTimerResponseObject, error)
+
+	// (POST /timer/stop)
+	PostTimerStop(ctx context.Context, request PostTimerStopRequestObject) (PostTimerStopResponseObject, error)
+
+	// (GET /view)
+	GetView(ctx context.Context, request GetViewRequestObject) (GetViewResponseObject, error)
+
+	// (POST /view)
+	PostView(ctx context.Context, request PostViewRequestObject) (PostViewResponseObject, error)
+
+	// (DELETE /view/{UUID})
+	DeleteViewUUID(ctx context.Context, request DeleteViewUUIDRequestObject) (DeleteViewUUIDResponseObject, error)
+
+	// (GET /view/{UUID})
+	GetViewUUID(ctx context.Context, request GetViewUUIDRequestObject) (GetViewUUIDResponseObject, error)
+
+	// (PUT /view/{UUID})
+	PutViewUUID(ctx context.Context, request PutViewUUIDRequestObject) (PutViewUUIDResponseObject, error)
+
+	// (GET /view/{UUID}/task)
+	GetViewUUIDTask(ctx context.Context, request GetViewUUIDTaskRequestObject) (GetViewUUIDTaskResponseObject, error)
+
+	// (GET /worklog/report)
+	GetWorklogReport(ctx context.Context, request GetWorklogReportRequestObject) (GetWorklogReportResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetBoard operation middleware
+func (sh *strictHandler) GetBoard(ctx echo.Context, params GetBoardParams) error {
+	var request GetBoardRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBoard(ctx.Request().Context(), request.(GetBoardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBoard")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBoardResponseObject); ok {
+		return validResponse.VisitGetBoardResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostImport operation middleware
+func (sh *strictHandler) PostImport(ctx echo.Context, params PostImportParams) error {
+	var request PostImportRequestObject
+
+	request.Params = params
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostImport(ctx.Request().Context(), request.(PostImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostImportResponseObject); ok {
+		return validResponse.VisitPostImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetImportUUID operation middleware
+func (sh *strictHandler) GetImportUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetImportUUIDRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetImportUUID(ctx.Request().Context(), request.(GetImportUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetImportUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetImportUUIDResponseObject); ok {
+		return validResponse.VisitGetImportUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostImportUUIDCheck operation middleware
+func (sh *strictHandler) PostImportUUIDCheck(ctx echo.Context, uUID Uuid) error {
+	var request PostImportUUIDCheckRequestObject
+
+	request.UUID = uUID
+
+	var body PostImportUUIDCheckJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostImportUUIDCheck(ctx.Request().Context(), request.(PostImportUUIDCheckRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostImportUUIDCheck")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostImportUUIDCheckResponseObject); ok {
+		return validResponse.VisitPostImportUUIDCheckResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostImportUUIDCommit operation middleware
+func (sh *strictHandler) PostImportUUIDCommit(ctx echo.Context, uUID Uuid) error {
+	var request PostImportUUIDCommitRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostImportUUIDCommit(ctx.Request().Context(), request.(PostImportUUIDCommitRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostImportUUIDCommit")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostImportUUIDCommitResponseObject); ok {
+		return validResponse.VisitPostImportUUIDCommitResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetRecurring operation middleware
+func (sh *strictHandler) GetRecurring(ctx echo.Context, params GetRecurringParams) error {
+	var request GetRecurringRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecurring(ctx.Request().Context(), request.(GetRecurringRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecurring")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetRecurringResponseObject); ok {
+		return validResponse.VisitGetRecurringResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRecurring operation middleware
+func (sh *strictHandler) PostRecurring(ctx echo.Context) error {
+	var request PostRecurringRequestObject
+
+	var body PostRecurringJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRecurring(ctx.Request().Context(), request.(PostRecurringRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRecurring")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostRecurringResponseObject); ok {
+		return validResponse.VisitPostRecurringResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteRecurringUUID operation middleware
+func (sh *strictHandler) DeleteRecurringUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteRecurringUUIDRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRecurringUUID(ctx.Request().Context(), request.(DeleteRecurringUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRecurringUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteRecurringUUIDResponseObject); ok {
+		return validResponse.VisitDeleteRecurringUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetRecurringUUID operation middleware
+func (sh *strictHandler) GetRecurringUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetRecurringUUIDRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecurringUUID(ctx.Request().Context(), request.(GetRecurringUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecurringUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetRecurringUUIDResponseObject); ok {
+		return validResponse.VisitGetRecurringUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchRecurringUUIDPause operation middleware
+func (sh *strictHandler) PatchRecurringUUIDPause(ctx echo.Context, uUID Uuid) error {
+	var request PatchRecurringUUIDPauseRequestObject
+
+	request.UUID = uUID
+
+	var body PatchRecurringUUIDPauseJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchRecurringUUIDPause(ctx.Request().Context(), request.(PatchRecurringUUIDPauseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchRecurringUUIDPause")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchRecurringUUIDPauseResponseObject); ok {
+		return validResponse.VisitPatchRecurringUUIDPauseResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetRecurringUUIDPreview operation middleware
+func (sh *strictHandler) GetRecurringUUIDPreview(ctx echo.Context, uUID Uuid, params GetRecurringUUIDPreviewParams) error {
+	var request GetRecurringUUIDPreviewRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecurringUUIDPreview(ctx.Request().Context(), request.(GetRecurringUUIDPreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecurringUUIDPreview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetRecurringUUIDPreviewResponseObject); ok {
+		return validResponse.VisitGetRecurringUUIDPreviewResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTask operation middleware
+func (sh *strictHandler) GetTask(ctx echo.Context, params GetTaskParams) error {
+	var request GetTaskRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTask(ctx.Request().Context(), request.(GetTaskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTask")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskResponseObject); ok {
+		return validResponse.VisitGetTaskResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTask operation middleware
+func (sh *strictHandler) PostTask(ctx echo.Context) error {
+	var request PostTaskRequestObject
+
+	var body PostTaskJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTask(ctx.Request().Context(), request.(PostTaskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTask")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskResponseObject); ok {
+		return validResponse.VisitPostTaskResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTaskBulk operation middleware
+func (sh *strictHandler) PostTaskBulk(ctx echo.Context) error {
+	var request PostTaskBulkRequestObject
+
+	var body PostTaskBulkJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskBulk(ctx.Request().Context(), request.(PostTaskBulkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskBulk")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskBulkResponseObject); ok {
+		return validResponse.VisitPostTaskBulkResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskExport operation middleware
+func (sh *strictHandler) GetTaskExport(ctx echo.Context, params GetTaskExportParams) error {
+	var request GetTaskExportRequestObject
+
+	request.Params = params
 
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskExport(ctx.Request().Context(), request.(GetTaskExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskExport")
+	}
 
-type GetTaskRequestObject struct {
-	Params GetTaskParams
-}
+	response, err := handler(ctx, request)
 
-type GetTaskResponseObject interface {
-	VisitGetTaskResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskExportResponseObject); ok {
+		return validResponse.VisitGetTaskExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetTask200ResponseHeaders struct {
-	ContentDisposition string
-	ContentType        string
-	CacheControl       string
-}
+// GetTaskExportUUID operation middleware
+func (sh *strictHandler) GetTaskExportUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskExportUUIDRequestObject
 
-type GetTask200JSONResponse struct {
-	Body struct {
-		Count int        `json:"count"`
-		Items []TaskDTOs `json:"items"`
-		Total int64      `json:"total"`
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskExportUUID(ctx.Request().Context(), request.(GetTaskExportUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskExportUUID")
 	}
-	Headers GetTask200ResponseHeaders
-}
 
-func (response GetTask200JSONResponse) VisitGetTaskResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
-	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
-	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskExportUUIDResponseObject); ok {
+		return validResponse.VisitGetTaskExportUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetTask200ApplicationxlsxResponse struct {
-	Body          io.Reader
-	Headers       GetTask200ResponseHeaders
-	ContentLength int64
-}
+// DeleteTaskUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteTaskUUIDRequestObject
 
-func (response GetTask200ApplicationxlsxResponse) VisitGetTaskResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/xlsx")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUID(ctx.Request().Context(), request.(DeleteTaskUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUID")
 	}
-	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
-	w.Header().Set("Content-Type", fmt.Sprint(response.Headers.ContentType))
-	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	return nil
 }
 
-type PostTaskRequestObject struct {
-	Body *PostTaskJSONRequestBody
-}
+// GetTaskUUID operation middleware
+func (sh *strictHandler) GetTaskUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDRequestObject
 
-type PostTaskResponseObject interface {
-	VisitPostTaskResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type PostTask200JSONResponse struct {
-	Id   int                `json:"id"`
-	Uuid openapi_types.UUID `json:"uuid"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUID(ctx.Request().Context(), request.(GetTaskUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUID")
+	}
 
-func (response PostTask200JSONResponse) VisitPostTaskResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteTaskUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// PutTaskUUID operation middleware
+func (sh *strictHandler) PutTaskUUID(ctx echo.Context, uUID Uuid) error {
+	var request PutTaskUUIDRequestObject
 
-type DeleteTaskUUIDResponseObject interface {
-	VisitDeleteTaskUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type DeleteTaskUUID200Response struct {
-}
+	var body PutTaskUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response DeleteTaskUUID200Response) VisitDeleteTaskUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutTaskUUID(ctx.Request().Context(), request.(PutTaskUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutTaskUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutTaskUUIDResponseObject); ok {
+		return validResponse.VisitPutTaskUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetTaskUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// GetTaskUUIDActivity operation middleware
+func (sh *strictHandler) GetTaskUUIDActivity(ctx echo.Context, uUID Uuid, params GetTaskUUIDActivityParams) error {
+	var request GetTaskUUIDActivityRequestObject
 
-type GetTaskUUIDResponseObject interface {
-	VisitGetTaskUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.Params = params
 
-type GetTaskUUID200ResponseHeaders struct {
-	CacheControl string
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDActivity(ctx.Request().Context(), request.(GetTaskUUIDActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDActivity")
+	}
 
-type GetTaskUUID200JSONResponse struct {
-	Body    TaskDTO
-	Headers GetTaskUUID200ResponseHeaders
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDActivityResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDActivityResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response GetTaskUUID200JSONResponse) VisitGetTaskUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("cache-control", fmt.Sprint(response.Headers.CacheControl))
-	w.WriteHeader(200)
+// PatchTaskUUIDBoard operation middleware
+func (sh *strictHandler) PatchTaskUUIDBoard(ctx echo.Context, uUID Uuid) error {
+	var request PatchTaskUUIDBoardRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	request.UUID = uUID
 
-type PutTaskUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PutTaskUUIDJSONRequestBody
-}
+	var body PatchTaskUUIDBoardJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PutTaskUUIDResponseObject interface {
-	VisitPutTaskUUIDResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDBoard(ctx.Request().Context(), request.(PatchTaskUUIDBoardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDBoard")
+	}
 
-type PutTaskUUID200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PutTaskUUID200Response) VisitPutTaskUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDBoardResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDBoardResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetTaskUUIDActivityRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetTaskUUIDActivityParams
-}
+// GetTaskUUIDChecklist operation middleware
+func (sh *strictHandler) GetTaskUUIDChecklist(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDChecklistRequestObject
 
-type GetTaskUUIDActivityResponseObject interface {
-	VisitGetTaskUUIDActivityResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type GetTaskUUIDActivity200JSONResponse struct {
-	Count int           `json:"count"`
-	Items []ActivityDTO `json:"items"`
-	Total int64         `json:"total"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDChecklist(ctx.Request().Context(), request.(GetTaskUUIDChecklistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDChecklist")
+	}
 
-func (response GetTaskUUIDActivity200JSONResponse) VisitGetTaskUUIDActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDChecklistResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDChecklistResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetTaskUUIDCommentRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// PostTaskUUIDChecklist operation middleware
+func (sh *strictHandler) PostTaskUUIDChecklist(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDChecklistRequestObject
+
+	request.UUID = uUID
+
+	var body PostTaskUUIDChecklistJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDChecklist(ctx.Request().Context(), request.(PostTaskUUIDChecklistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDChecklist")
+	}
 
-type GetTaskUUIDCommentResponseObject interface {
-	VisitGetTaskUUIDCommentResponse(w http.ResponseWriter) error
-}
+	response, err := handler(ctx, request)
 
-type GetTaskUUIDComment200JSONResponse struct {
-	Count int          `json:"count"`
-	Items []CommentDTO `json:"items"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDChecklistResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDChecklistResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response GetTaskUUIDComment200JSONResponse) VisitGetTaskUUIDCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeleteTaskUUIDChecklistEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDChecklistEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDChecklistEntityUUIDRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type PostTaskUUIDCommentRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *multipart.Reader
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUIDChecklistEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDChecklistEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUIDChecklistEntityUUID")
+	}
 
-type PostTaskUUIDCommentResponseObject interface {
-	VisitPostTaskUUIDCommentResponse(w http.ResponseWriter) error
-}
+	response, err := handler(ctx, request)
 
-type PostTaskUUIDComment200JSONResponse struct {
-	Comment      string             `json:"comment"`
-	People       *[]UserDTO         `json:"people,omitempty"`
-	ReplyMessage *string            `json:"reply_message,omitempty"`
-	Uploads      *[]UploadDTO       `json:"uploads,omitempty"`
-	Uuid         openapi_types.UUID `json:"uuid"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDChecklistEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDChecklistEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response PostTaskUUIDComment200JSONResponse) VisitPostTaskUUIDCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PutTaskUUIDChecklistEntityUUID operation middleware
+func (sh *strictHandler) PutTaskUUIDChecklistEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PutTaskUUIDChecklistEntityUUIDRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type DeleteTaskUUIDCommentEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+	var body PutTaskUUIDChecklistEntityUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type DeleteTaskUUIDCommentEntityUUIDResponseObject interface {
-	VisitDeleteTaskUUIDCommentEntityUUIDResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutTaskUUIDChecklistEntityUUID(ctx.Request().Context(), request.(PutTaskUUIDChecklistEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutTaskUUIDChecklistEntityUUID")
+	}
 
-type DeleteTaskUUIDCommentEntityUUID200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response DeleteTaskUUIDCommentEntityUUID200Response) VisitDeleteTaskUUIDCommentEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutTaskUUIDChecklistEntityUUIDResponseObject); ok {
+		return validResponse.VisitPutTaskUUIDChecklistEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchTaskUUIDCommentEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *multipart.Reader
-}
-
-type PatchTaskUUIDCommentEntityUUIDResponseObject interface {
-	VisitPatchTaskUUIDCommentEntityUUIDResponse(w http.ResponseWriter) error
-}
+// PostTaskUUIDChecklistEntityUUIDConvert operation middleware
+func (sh *strictHandler) PostTaskUUIDChecklistEntityUUIDConvert(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostTaskUUIDChecklistEntityUUIDConvertRequestObject
 
-type PatchTaskUUIDCommentEntityUUID200JSONResponse struct {
-	Comment      string             `json:"comment"`
-	People       *[]UserDTO         `json:"people,omitempty"`
-	ReplyMessage *string            `json:"reply_message,omitempty"`
-	Uploads      *[]UploadDTO       `json:"uploads,omitempty"`
-	Uuid         openapi_types.UUID `json:"uuid"`
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-func (response PatchTaskUUIDCommentEntityUUID200JSONResponse) VisitPatchTaskUUIDCommentEntityUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDChecklistEntityUUIDConvert(ctx.Request().Context(), request.(PostTaskUUIDChecklistEntityUUIDConvertRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDChecklistEntityUUIDConvert")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	FileUUID   FileUUID   `json:"fileUUID"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDChecklistEntityUUIDConvertResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDChecklistEntityUUIDConvertResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponseObject interface {
-	VisitDeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponse(w http.ResponseWriter) error
-}
+// PatchTaskUUIDChecklistEntityUUIDDone operation middleware
+func (sh *strictHandler) PatchTaskUUIDChecklistEntityUUIDDone(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDChecklistEntityUUIDDoneRequestObject
 
-type DeleteTaskUUIDCommentEntityUUIDFileFileUUID200Response struct {
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-func (response DeleteTaskUUIDCommentEntityUUIDFileFileUUID200Response) VisitDeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	var body PatchTaskUUIDChecklistEntityUUIDDoneJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PatchTaskUUIDCommentEntityUUIDLikeRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDChecklistEntityUUIDDone(ctx.Request().Context(), request.(PatchTaskUUIDChecklistEntityUUIDDoneRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDChecklistEntityUUIDDone")
+	}
 
-type PatchTaskUUIDCommentEntityUUIDLikeResponseObject interface {
-	VisitPatchTaskUUIDCommentEntityUUIDLikeResponse(w http.ResponseWriter) error
-}
+	response, err := handler(ctx, request)
 
-type PatchTaskUUIDCommentEntityUUIDLike200JSONResponse struct {
-	Liked bool      `json:"liked"`
-	Likes []UserDTO `json:"likes"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDChecklistEntityUUIDDoneResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDChecklistEntityUUIDDoneResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response PatchTaskUUIDCommentEntityUUIDLike200JSONResponse) VisitPatchTaskUUIDCommentEntityUUIDLikeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PatchTaskUUIDChecklistEntityUUIDMove operation middleware
+func (sh *strictHandler) PatchTaskUUIDChecklistEntityUUIDMove(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDChecklistEntityUUIDMoveRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type PatchTaskUUIDCommentEntityUUIDPinRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+	var body PatchTaskUUIDChecklistEntityUUIDMoveJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PatchTaskUUIDCommentEntityUUIDPinResponseObject interface {
-	VisitPatchTaskUUIDCommentEntityUUIDPinResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDChecklistEntityUUIDMove(ctx.Request().Context(), request.(PatchTaskUUIDChecklistEntityUUIDMoveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDChecklistEntityUUIDMove")
+	}
 
-type PatchTaskUUIDCommentEntityUUIDPin200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PatchTaskUUIDCommentEntityUUIDPin200Response) VisitPatchTaskUUIDCommentEntityUUIDPinResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDChecklistEntityUUIDMoveResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDChecklistEntityUUIDMoveResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchTaskUUIDNameRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchTaskUUIDNameJSONRequestBody
-}
+// PostTaskUUIDClone operation middleware
+func (sh *strictHandler) PostTaskUUIDClone(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDCloneRequestObject
 
-type PatchTaskUUIDNameResponseObject interface {
-	VisitPatchTaskUUIDNameResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type PatchTaskUUIDName200Response struct {
-}
+	var body PostTaskUUIDCloneJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response PatchTaskUUIDName200Response) VisitPatchTaskUUIDNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDClone(ctx.Request().Context(), request.(PostTaskUUIDCloneRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDClone")
+	}
 
-type PatchTaskUUIDName400Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PatchTaskUUIDName400Response) VisitPatchTaskUUIDNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDCloneResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDCloneResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchTaskUUIDName401Response struct {
-}
-
-func (response PatchTaskUUIDName401Response) VisitPatchTaskUUIDNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// GetTaskUUIDComment operation middleware
+func (sh *strictHandler) GetTaskUUIDComment(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDCommentRequestObject
 
-type PatchTaskUUIDParentRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchTaskUUIDParentJSONRequestBody
-}
+	request.UUID = uUID
 
-type PatchTaskUUIDParentResponseObject interface {
-	VisitPatchTaskUUIDParentResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDComment(ctx.Request().Context(), request.(GetTaskUUIDCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDComment")
+	}
 
-type PatchTaskUUIDParent200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PatchTaskUUIDParent200Response) VisitPatchTaskUUIDParentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDCommentResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDCommentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchTaskUUIDProjectRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchTaskUUIDProjectJSONRequestBody
-}
+// PostTaskUUIDComment operation middleware
+func (sh *strictHandler) PostTaskUUIDComment(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDCommentRequestObject
+
+	request.UUID = uUID
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
 
-type PatchTaskUUIDProjectResponseObject interface {
-	VisitPatchTaskUUIDProjectResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDComment(ctx.Request().Context(), request.(PostTaskUUIDCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDComment")
+	}
 
-type PatchTaskUUIDProject200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PatchTaskUUIDProject200Response) VisitPatchTaskUUIDProjectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDCommentResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDCommentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchTaskUUIDStatusRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchTaskUUIDStatusJSONRequestBody
-}
+// DeleteTaskUUIDCommentEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDCommentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDCommentEntityUUIDRequestObject
 
-type PatchTaskUUIDStatusResponseObject interface {
-	VisitPatchTaskUUIDStatusResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type PatchTaskUUIDStatus200JSONResponse struct {
-	Path     []string           `json:"path"`
-	StopUuid openapi_types.UUID `json:"stop_uuid"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUIDCommentEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDCommentEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUIDCommentEntityUUID")
+	}
 
-func (response PatchTaskUUIDStatus200JSONResponse) VisitPatchTaskUUIDStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDCommentEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDCommentEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteTaskUUIDStopEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+// PatchTaskUUIDCommentEntityUUID operation middleware
+func (sh *strictHandler) PatchTaskUUIDCommentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDCommentEntityUUIDRequestObject
 
-type DeleteTaskUUIDStopEntityUUIDResponseObject interface {
-	VisitDeleteTaskUUIDStopEntityUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type DeleteTaskUUIDStopEntityUUID200Response struct {
-}
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
 
-func (response DeleteTaskUUIDStopEntityUUID200Response) VisitDeleteTaskUUIDStopEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDCommentEntityUUID(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PatchTaskUUIDTeamRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *PatchTaskUUIDTeamJSONRequestBody
-}
+// DeleteTaskUUIDCommentEntityUUIDFileFileUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error {
+	var request DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject
 
-type PatchTaskUUIDTeamResponseObject interface {
-	VisitPatchTaskUUIDTeamResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.FileUUID = fileUUID
 
-type PatchTaskUUIDTeam200JSONResponse struct {
-	CoworkersBy   []UserDTO `json:"coworkers_by"`
-	ImplementBy   *UserDTO  `json:"implement_by,omitempty"`
-	ManagedBy     *UserDTO  `json:"managed_by,omitempty"`
-	ResponsibleBy *UserDTO  `json:"responsible_by,omitempty"`
-	WatchedBy     []UserDTO `json:"watched_by"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx.Request().Context(), request.(DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUIDCommentEntityUUIDFileFileUUID")
+	}
 
-func (response PatchTaskUUIDTeam200JSONResponse) VisitPatchTaskUUIDTeamResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetTaskUUIDUploadRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// PatchTaskUUIDCommentEntityUUIDLike operation middleware
+func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDLike(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDCommentEntityUUIDLikeRequestObject
 
-type GetTaskUUIDUploadResponseObject interface {
-	VisitGetTaskUUIDUploadResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type GetTaskUUIDUpload200JSONResponse struct {
-	Count int         `json:"count"`
-	Items []UploadDTO `json:"items"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDCommentEntityUUIDLike(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDLikeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDLike")
+	}
 
-func (response GetTaskUUIDUpload200JSONResponse) VisitGetTaskUUIDUploadResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDLikeResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDLikeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PatchTaskUUIDUploadRequestObject struct {
-	UUID Uuid `json:"UUID"`
-	Body *multipart.Reader
-}
+// PatchTaskUUIDCommentEntityUUIDPin operation middleware
+func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDCommentEntityUUIDPinRequestObject
 
-type PatchTaskUUIDUploadResponseObject interface {
-	VisitPatchTaskUUIDUploadResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-type PatchTaskUUIDUpload200JSONResponse UploadDTO
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDCommentEntityUUIDPin(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDPinRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDPin")
+	}
 
-func (response PatchTaskUUIDUpload200JSONResponse) VisitPatchTaskUUIDUploadResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDPinResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDPinResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteTaskUUIDUploadEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+// GetTaskUUIDHistoryDiff operation middleware
+func (sh *strictHandler) GetTaskUUIDHistoryDiff(ctx echo.Context, uUID Uuid, params GetTaskUUIDHistoryDiffParams) error {
+	var request GetTaskUUIDHistoryDiffRequestObject
 
-type DeleteTaskUUIDUploadEntityUUIDResponseObject interface {
-	VisitDeleteTaskUUIDUploadEntityUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.Params = params
 
-type DeleteTaskUUIDUploadEntityUUID200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDHistoryDiff(ctx.Request().Context(), request.(GetTaskUUIDHistoryDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDHistoryDiff")
+	}
 
-func (response DeleteTaskUUIDUploadEntityUUID200Response) VisitDeleteTaskUUIDUploadEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDHistoryDiffResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDHistoryDiffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetTaskUUIDUploadEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-}
+// GetTaskUUIDHistoryState operation middleware
+func (sh *strictHandler) GetTaskUUIDHistoryState(ctx echo.Context, uUID Uuid, params GetTaskUUIDHistoryStateParams) error {
+	var request GetTaskUUIDHistoryStateRequestObject
 
-type GetTaskUUIDUploadEntityUUIDResponseObject interface {
-	VisitGetTaskUUIDUploadEntityUUIDResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
+	request.Params = params
 
-type GetTaskUUIDUploadEntityUUID302ResponseHeaders struct {
-	Location string
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDHistoryState(ctx.Request().Context(), request.(GetTaskUUIDHistoryStateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDHistoryState")
+	}
 
-type GetTaskUUIDUploadEntityUUID302Response struct {
-	Headers GetTaskUUIDUploadEntityUUID302ResponseHeaders
-}
+	response, err := handler(ctx, request)
 
-func (response GetTaskUUIDUploadEntityUUID302Response) VisitGetTaskUUIDUploadEntityUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDHistoryStateResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDHistoryStateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PostTaskUUIDUploadEntityUUIDRenameRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody
-}
+// GetTaskUUIDLink operation middleware
+func (sh *strictHandler) GetTaskUUIDLink(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDLinkRequestObject
 
-type PostTaskUUIDUploadEntityUUIDRenameResponseObject interface {
-	VisitPostTaskUUIDUploadEntityUUIDRenameResponse(w http.ResponseWriter) error
-}
+	request.UUID = uUID
 
-type PostTaskUUIDUploadEntityUUIDRename200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDLink(ctx.Request().Context(), request.(GetTaskUUIDLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDLink")
+	}
 
-func (response PostTaskUUIDUploadEntityUUIDRename200Response) VisitPostTaskUUIDUploadEntityUUIDRenameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDLinkResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDLinkResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+// PostTaskUUIDLink operation middleware
+func (sh *strictHandler) PostTaskUUIDLink(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDLinkRequestObject
 
-	// (GET /task)
-	GetTask(ctx context.Context, request GetTaskRequestObject) (GetTaskResponseObject, error)
+	request.UUID = uUID
 
-	// (POST /task)
-	PostTask(ctx context.Context, request PostTaskRequestObject) (PostTaskResponseObject, error)
+	var body PostTaskUUIDLinkJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (DELETE /task/{UUID})
-	DeleteTaskUUID(ctx context.Context, request DeleteTaskUUIDRequestObject) (DeleteTaskUUIDResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDLink(ctx.Request().Context(), request.(PostTaskUUIDLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDLink")
+	}
 
-	// (GET /task/{UUID})
-	GetTaskUUID(ctx context.Context, request GetTaskUUIDRequestObject) (GetTaskUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (PUT /task/{UUID})
-	PutTaskUUID(ctx context.Context, request PutTaskUUIDRequestObject) (PutTaskUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDLinkResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDLinkResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (GET /task/{UUID}/activity)
-	GetTaskUUIDActivity(ctx context.Context, request GetTaskUUIDActivityRequestObject) (GetTaskUUIDActivityResponseObject, error)
+// DeleteTaskUUIDLinkEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDLinkEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDLinkEntityUUIDRequestObject
 
-	// (GET /task/{UUID}/comment)
-	GetTaskUUIDComment(ctx context.Context, request GetTaskUUIDCommentRequestObject) (GetTaskUUIDCommentResponseObject, error)
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
-	// (POST /task/{UUID}/comment)
-	PostTaskUUIDComment(ctx context.Context, request PostTaskUUIDCommentRequestObject) (PostTaskUUIDCommentResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTaskUUIDLinkEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDLinkEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTaskUUIDLinkEntityUUID")
+	}
 
-	// (DELETE /task/{UUID}/comment/{entityUUID})
-	DeleteTaskUUIDCommentEntityUUID(ctx context.Context, request DeleteTaskUUIDCommentEntityUUIDRequestObject) (DeleteTaskUUIDCommentEntityUUIDResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (PATCH /task/{UUID}/comment/{entityUUID})
-	PatchTaskUUIDCommentEntityUUID(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDRequestObject) (PatchTaskUUIDCommentEntityUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTaskUUIDLinkEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDLinkEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (DELETE /task/{UUID}/comment/{entityUUID}/file/{fileUUID})
-	DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx context.Context, request DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject) (DeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponseObject, error)
+// PostTaskUUIDMerge operation middleware
+func (sh *strictHandler) PostTaskUUIDMerge(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDMergeRequestObject
 
-	// (PATCH /task/{UUID}/comment/{entityUUID}/like)
-	PatchTaskUUIDCommentEntityUUIDLike(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDLikeRequestObject) (PatchTaskUUIDCommentEntityUUIDLikeResponseObject, error)
+	request.UUID = uUID
 
-	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
-	PatchTaskUUIDCommentEntityUUIDPin(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDPinRequestObject) (PatchTaskUUIDCommentEntityUUIDPinResponseObject, error)
+	var body PostTaskUUIDMergeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (PATCH /task/{UUID}/name)
-	PatchTaskUUIDName(ctx context.Context, request PatchTaskUUIDNameRequestObject) (PatchTaskUUIDNameResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDMerge(ctx.Request().Context(), request.(PostTaskUUIDMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDMerge")
+	}
 
-	// (PATCH /task/{UUID}/parent)
-	PatchTaskUUIDParent(ctx context.Context, request PatchTaskUUIDParentRequestObject) (PatchTaskUUIDParentResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (PATCH /task/{UUID}/project)
-	PatchTaskUUIDProject(ctx context.Context, request PatchTaskUUIDProjectRequestObject) (PatchTaskUUIDProjectResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDMergeResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDMergeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (PATCH /task/{UUID}/status)
-	PatchTaskUUIDStatus(ctx context.Context, request PatchTaskUUIDStatusRequestObject) (PatchTaskUUIDStatusResponseObject, error)
+// PatchTaskUUIDName operation middleware
+func (sh *strictHandler) PatchTaskUUIDName(ctx echo.Context, uUID Uuid) error {
+	var request PatchTaskUUIDNameRequestObject
 
-	// (DELETE /task/{UUID}/stop/{entityUUID})
-	DeleteTaskUUIDStopEntityUUID(ctx context.Context, request DeleteTaskUUIDStopEntityUUIDRequestObject) (DeleteTaskUUIDStopEntityUUIDResponseObject, error)
+	request.UUID = uUID
 
-	// (PATCH /task/{UUID}/team)
-	PatchTaskUUIDTeam(ctx context.Context, request PatchTaskUUIDTeamRequestObject) (PatchTaskUUIDTeamResponseObject, error)
+	var body PatchTaskUUIDNameJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	// (GET /task/{UUID}/upload)
-	GetTaskUUIDUpload(ctx context.Context, request GetTaskUUIDUploadRequestObject) (GetTaskUUIDUploadResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDName(ctx.Request().Context(), request.(PatchTaskUUIDNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDName")
+	}
 
-	// (PATCH /task/{UUID}/upload)
-	PatchTaskUUIDUpload(ctx context.Context, request PatchTaskUUIDUploadRequestObject) (PatchTaskUUIDUploadResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (DELETE /task/{UUID}/upload/{entityUUID})
-	DeleteTaskUUIDUploadEntityUUID(ctx context.Context, request DeleteTaskUUIDUploadEntityUUIDRequestObject) (DeleteTaskUUIDUploadEntityUUIDResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDNameResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	// (GET /task/{UUID}/upload/{entityUUID})
-	GetTaskUUIDUploadEntityUUID(ctx context.Context, request GetTaskUUIDUploadEntityUUIDRequestObject) (GetTaskUUIDUploadEntityUUIDResponseObject, error)
+// PatchTaskUUIDParent operation middleware
+func (sh *strictHandler) PatchTaskUUIDParent(ctx echo.Context, uUID Uuid) error {
+	var request PatchTaskUUIDParentRequestObject
 
-	// (POST /task/{UUID}/upload/{entityUUID}/rename)
-	PostTaskUUIDUploadEntityUUIDRename(ctx context.Context, request PostTaskUUIDUploadEntityUUIDRenameRequestObject) (PostTaskUUIDUploadEntityUUIDRenameResponseObject, error)
-}
+	request.UUID = uUID
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	var body PatchTaskUUIDParentJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDParent(ctx.Request().Context(), request.(PatchTaskUUIDParentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDParent")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDParentResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDParentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetTask operation middleware
-func (sh *strictHandler) GetTask(ctx echo.Context, params GetTaskParams) error {
-	var request GetTaskRequestObject
+// PatchTaskUUIDProject operation middleware
+func (sh *strictHandler) PatchTaskUUIDProject(ctx echo.Context, uUID Uuid) error {
+	var request PatchTaskUUIDProjectRequestObject
 
-	request.Params = params
+	request.UUID = uUID
+
+	var body PatchTaskUUIDProjectJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTask(ctx.Request().Context(), request.(GetTaskRequestObject))
+		return sh.ssi.PatchTaskUUIDProject(ctx.Request().Context(), request.(PatchTaskUUIDProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTask")
+		handler = middleware(handler, "PatchTaskUUIDProject")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTaskResponseObject); ok {
-		return validResponse.VisitGetTaskResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchTaskUUIDProjectResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDProjectResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostTask operation middleware
-func (sh *strictHandler) PostTask(ctx echo.Context) error {
-	var request PostTaskRequestObject
+// PatchTaskUUIDSchedule operation middleware
+func (sh *strictHandler) PatchTaskUUIDSchedule(ctx echo.Context, uUID Uuid) error {
+	var request PatchTaskUUIDScheduleRequestObject
 
-	var body PostTaskJSONRequestBody
+	request.UUID = uUID
+
+	var body PatchTaskUUIDScheduleJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTask(ctx.Request().Context(), request.(PostTaskRequestObject))
+		return sh.ssi.PatchTaskUUIDSchedule(ctx.Request().Context(), request.(PatchTaskUUIDScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTask")
+		handler = middleware(handler, "PatchTaskUUIDSchedule")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostTaskResponseObject); ok {
-		return validResponse.VisitPostTaskResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchTaskUUIDScheduleResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDScheduleResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTaskUUID operation middleware
-func (sh *strictHandler) DeleteTaskUUID(ctx echo.Context, uUID Uuid) error {
-	var request DeleteTaskUUIDRequestObject
+// PatchTaskUUIDStatus operation middleware
+func (sh *strictHandler) PatchTaskUUIDStatus(ctx echo.Context, uUID Uuid) error {
+	var request PatchTaskUUIDStatusRequestObject
 
 	request.UUID = uUID
 
+	var body PatchTaskUUIDStatusJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTaskUUID(ctx.Request().Context(), request.(DeleteTaskUUIDRequestObject))
+		return sh.ssi.PatchTaskUUIDStatus(ctx.Request().Context(), request.(PatchTaskUUIDStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTaskUUID")
+		handler = middleware(handler, "PatchTaskUUIDStatus")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTaskUUIDResponseObject); ok {
-		return validResponse.VisitDeleteTaskUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchTaskUUIDStatusResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDStatusResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTaskUUID operation middleware
-func (sh *strictHandler) GetTaskUUID(ctx echo.Context, uUID Uuid) error {
-	var request GetTaskUUIDRequestObject
+// DeleteTaskUUIDStopEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDStopEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDStopEntityUUIDRequestObject
 
 	request.UUID = uUID
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTaskUUID(ctx.Request().Context(), request.(GetTaskUUIDRequestObject))
+		return sh.ssi.DeleteTaskUUIDStopEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDStopEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTaskUUID")
+		handler = middleware(handler, "DeleteTaskUUIDStopEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTaskUUIDResponseObject); ok {
-		return validResponse.VisitGetTaskUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTaskUUIDStopEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDStopEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PutTaskUUID operation middleware
-func (sh *strictHandler) PutTaskUUID(ctx echo.Context, uUID Uuid) error {
-	var request PutTaskUUIDRequestObject
+// PatchTaskUUIDTeam operation middleware
+func (sh *strictHandler) PatchTaskUUIDTeam(ctx echo.Context, uUID Uuid) error {
+	var request PatchTaskUUIDTeamRequestObject
 
 	request.UUID = uUID
 
-	var body PutTaskUUIDJSONRequestBody
+	var body PatchTaskUUIDTeamJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutTaskUUID(ctx.Request().Context(), request.(PutTaskUUIDRequestObject))
+		return sh.ssi.PatchTaskUUIDTeam(ctx.Request().Context(), request.(PatchTaskUUIDTeamRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutTaskUUID")
+		handler = middleware(handler, "PatchTaskUUIDTeam")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PutTaskUUIDResponseObject); ok {
-		return validResponse.VisitPutTaskUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchTaskUUIDTeamResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDTeamResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTaskUUIDActivity operation middleware
-func (sh *strictHandler) GetTaskUUIDActivity(ctx echo.Context, uUID Uuid, params GetTaskUUIDActivityParams) error {
-	var request GetTaskUUIDActivityRequestObject
+// PostTaskUUIDTimer operation middleware
+func (sh *strictHandler) PostTaskUUIDTimer(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDTimerRequestObject
 
 	request.UUID = uUID
-	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTaskUUIDActivity(ctx.Request().Context(), request.(GetTaskUUIDActivityRequestObject))
+		return sh.ssi.PostTaskUUIDTimer(ctx.Request().Context(), request.(PostTaskUUIDTimerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTaskUUIDActivity")
+		handler = middleware(handler, "PostTaskUUIDTimer")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTaskUUIDActivityResponseObject); ok {
-		return validResponse.VisitGetTaskUUIDActivityResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTaskUUIDTimerResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDTimerResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTaskUUIDComment operation middleware
-func (sh *strictHandler) GetTaskUUIDComment(ctx echo.Context, uUID Uuid) error {
-	var request GetTaskUUIDCommentRequestObject
+// GetTaskUUIDUpload operation middleware
+func (sh *strictHandler) GetTaskUUIDUpload(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDUploadRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTaskUUIDComment(ctx.Request().Context(), request.(GetTaskUUIDCommentRequestObject))
+		return sh.ssi.GetTaskUUIDUpload(ctx.Request().Context(), request.(GetTaskUUIDUploadRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTaskUUIDComment")
+		handler = middleware(handler, "GetTaskUUIDUpload")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTaskUUIDCommentResponseObject); ok {
-		return validResponse.VisitGetTaskUUIDCommentResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTaskUUIDUploadResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDUploadResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostTaskUUIDComment operation middleware
-func (sh *strictHandler) PostTaskUUIDComment(ctx echo.Context, uUID Uuid) error {
-	var request PostTaskUUIDCommentRequestObject
+// PatchTaskUUIDUpload operation middleware
+func (sh *strictHandler) PatchTaskUUIDUpload(ctx echo.Context, uUID Uuid) error {
+	var request PatchTaskUUIDUploadRequestObject
 
 	request.UUID = uUID
 
@@ -1763,476 +5655,422 @@ func (sh *strictHandler) PostTaskUUIDComment(ctx echo.Context, uUID Uuid) error
 	}
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTaskUUIDComment(ctx.Request().Context(), request.(PostTaskUUIDCommentRequestObject))
+		return sh.ssi.PatchTaskUUIDUpload(ctx.Request().Context(), request.(PatchTaskUUIDUploadRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTaskUUIDComment")
+		handler = middleware(handler, "PatchTaskUUIDUpload")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostTaskUUIDCommentResponseObject); ok {
-		return validResponse.VisitPostTaskUUIDCommentResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchTaskUUIDUploadResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDUploadResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTaskUUIDCommentEntityUUID operation middleware
-func (sh *strictHandler) DeleteTaskUUIDCommentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteTaskUUIDCommentEntityUUIDRequestObject
+// DeleteTaskUUIDUploadEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDUploadEntityUUIDRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTaskUUIDCommentEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDCommentEntityUUIDRequestObject))
+		return sh.ssi.DeleteTaskUUIDUploadEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDUploadEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTaskUUIDCommentEntityUUID")
+		handler = middleware(handler, "DeleteTaskUUIDUploadEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTaskUUIDCommentEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteTaskUUIDCommentEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTaskUUIDUploadEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDUploadEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDCommentEntityUUID operation middleware
-func (sh *strictHandler) PatchTaskUUIDCommentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchTaskUUIDCommentEntityUUIDRequestObject
+// GetTaskUUIDUploadEntityUUID operation middleware
+func (sh *strictHandler) GetTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetTaskUUIDUploadEntityUUIDRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
 
-	if reader, err := ctx.Request().MultipartReader(); err != nil {
-		return err
-	} else {
-		request.Body = reader
-	}
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDCommentEntityUUID(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDRequestObject))
+		return sh.ssi.GetTaskUUIDUploadEntityUUID(ctx.Request().Context(), request.(GetTaskUUIDUploadEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUID")
+		handler = middleware(handler, "GetTaskUUIDUploadEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTaskUUIDUploadEntityUUIDResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDUploadEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTaskUUIDCommentEntityUUIDFileFileUUID operation middleware
-func (sh *strictHandler) DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error {
-	var request DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject
+// PostTaskUUIDUploadEntityUUIDRename operation middleware
+func (sh *strictHandler) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostTaskUUIDUploadEntityUUIDRenameRequestObject
 
 	request.UUID = uUID
 	request.EntityUUID = entityUUID
-	request.FileUUID = fileUUID
-
-	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx.Request().Context(), request.(DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTaskUUIDCommentEntityUUIDFileFileUUID")
-	}
 
-	response, err := handler(ctx, request)
-
-	if err != nil {
+	var body PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponseObject); ok {
-		return validResponse.VisitDeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponse(ctx.Response())
-	} else if response != nil {
-		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	return nil
-}
-
-// PatchTaskUUIDCommentEntityUUIDLike operation middleware
-func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDLike(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchTaskUUIDCommentEntityUUIDLikeRequestObject
-
-	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDCommentEntityUUIDLike(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDLikeRequestObject))
+		return sh.ssi.PostTaskUUIDUploadEntityUUIDRename(ctx.Request().Context(), request.(PostTaskUUIDUploadEntityUUIDRenameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDLike")
+		handler = middleware(handler, "PostTaskUUIDUploadEntityUUIDRename")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDLikeResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDLikeResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTaskUUIDUploadEntityUUIDRenameResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDUploadEntityUUIDRenameResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDCommentEntityUUIDPin operation middleware
-func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PatchTaskUUIDCommentEntityUUIDPinRequestObject
+// GetTaskUUIDWorklog operation middleware
+func (sh *strictHandler) GetTaskUUIDWorklog(ctx echo.Context, uUID Uuid) error {
+	var request GetTaskUUIDWorklogRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDCommentEntityUUIDPin(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDPinRequestObject))
+		return sh.ssi.GetTaskUUIDWorklog(ctx.Request().Context(), request.(GetTaskUUIDWorklogRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDPin")
+		handler = middleware(handler, "GetTaskUUIDWorklog")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDPinResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDPinResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTaskUUIDWorklogResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDWorklogResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDName operation middleware
-func (sh *strictHandler) PatchTaskUUIDName(ctx echo.Context, uUID Uuid) error {
-	var request PatchTaskUUIDNameRequestObject
+// PostTaskUUIDWorklog operation middleware
+func (sh *strictHandler) PostTaskUUIDWorklog(ctx echo.Context, uUID Uuid) error {
+	var request PostTaskUUIDWorklogRequestObject
 
 	request.UUID = uUID
 
-	var body PatchTaskUUIDNameJSONRequestBody
+	var body PostTaskUUIDWorklogJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDName(ctx.Request().Context(), request.(PatchTaskUUIDNameRequestObject))
+		return sh.ssi.PostTaskUUIDWorklog(ctx.Request().Context(), request.(PostTaskUUIDWorklogRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDName")
+		handler = middleware(handler, "PostTaskUUIDWorklog")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDNameResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTaskUUIDWorklogResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDWorklogResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDParent operation middleware
-func (sh *strictHandler) PatchTaskUUIDParent(ctx echo.Context, uUID Uuid) error {
-	var request PatchTaskUUIDParentRequestObject
+// DeleteTaskUUIDWorklogEntityUUID operation middleware
+func (sh *strictHandler) DeleteTaskUUIDWorklogEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request DeleteTaskUUIDWorklogEntityUUIDRequestObject
 
 	request.UUID = uUID
-
-	var body PatchTaskUUIDParentJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.EntityUUID = entityUUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDParent(ctx.Request().Context(), request.(PatchTaskUUIDParentRequestObject))
+		return sh.ssi.DeleteTaskUUIDWorklogEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDWorklogEntityUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDParent")
+		handler = middleware(handler, "DeleteTaskUUIDWorklogEntityUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDParentResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDParentResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTaskUUIDWorklogEntityUUIDResponseObject); ok {
+		return validResponse.VisitDeleteTaskUUIDWorklogEntityUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDProject operation middleware
-func (sh *strictHandler) PatchTaskUUIDProject(ctx echo.Context, uUID Uuid) error {
-	var request PatchTaskUUIDProjectRequestObject
-
-	request.UUID = uUID
-
-	var body PatchTaskUUIDProjectJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+// GetTimer operation middleware
+func (sh *strictHandler) GetTimer(ctx echo.Context) error {
+	var request GetTimerRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDProject(ctx.Request().Context(), request.(PatchTaskUUIDProjectRequestObject))
+		return sh.ssi.GetTimer(ctx.Request().Context(), request.(GetTimerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDProject")
+		handler = middleware(handler, "GetTimer")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDProjectResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDProjectResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTimerResponseObject); ok {
+		return validResponse.VisitGetTimerResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDStatus operation middleware
-func (sh *strictHandler) PatchTaskUUIDStatus(ctx echo.Context, uUID Uuid) error {
-	var request PatchTaskUUIDStatusRequestObject
-
-	request.UUID = uUID
+// PostTimerStop operation middleware
+func (sh *strictHandler) PostTimerStop(ctx echo.Context) error {
+	var request PostTimerStopRequestObject
 
-	var body PatchTaskUUIDStatusJSONRequestBody
+	var body PostTimerStopJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDStatus(ctx.Request().Context(), request.(PatchTaskUUIDStatusRequestObject))
+		return sh.ssi.PostTimerStop(ctx.Request().Context(), request.(PostTimerStopRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDStatus")
+		handler = middleware(handler, "PostTimerStop")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDStatusResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDStatusResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTimerStopResponseObject); ok {
+		return validResponse.VisitPostTimerStopResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTaskUUIDStopEntityUUID operation middleware
-func (sh *strictHandler) DeleteTaskUUIDStopEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteTaskUUIDStopEntityUUIDRequestObject
+// GetView operation middleware
+func (sh *strictHandler) GetView(ctx echo.Context, params GetViewParams) error {
+	var request GetViewRequestObject
 
-	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTaskUUIDStopEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDStopEntityUUIDRequestObject))
+		return sh.ssi.GetView(ctx.Request().Context(), request.(GetViewRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTaskUUIDStopEntityUUID")
+		handler = middleware(handler, "GetView")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTaskUUIDStopEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteTaskUUIDStopEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetViewResponseObject); ok {
+		return validResponse.VisitGetViewResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDTeam operation middleware
-func (sh *strictHandler) PatchTaskUUIDTeam(ctx echo.Context, uUID Uuid) error {
-	var request PatchTaskUUIDTeamRequestObject
-
-	request.UUID = uUID
+// PostView operation middleware
+func (sh *strictHandler) PostView(ctx echo.Context) error {
+	var request PostViewRequestObject
 
-	var body PatchTaskUUIDTeamJSONRequestBody
+	var body PostViewJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDTeam(ctx.Request().Context(), request.(PatchTaskUUIDTeamRequestObject))
+		return sh.ssi.PostView(ctx.Request().Context(), request.(PostViewRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDTeam")
+		handler = middleware(handler, "PostView")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDTeamResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDTeamResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostViewResponseObject); ok {
+		return validResponse.VisitPostViewResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTaskUUIDUpload operation middleware
-func (sh *strictHandler) GetTaskUUIDUpload(ctx echo.Context, uUID Uuid) error {
-	var request GetTaskUUIDUploadRequestObject
+// DeleteViewUUID operation middleware
+func (sh *strictHandler) DeleteViewUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteViewUUIDRequestObject
 
 	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTaskUUIDUpload(ctx.Request().Context(), request.(GetTaskUUIDUploadRequestObject))
+		return sh.ssi.DeleteViewUUID(ctx.Request().Context(), request.(DeleteViewUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTaskUUIDUpload")
+		handler = middleware(handler, "DeleteViewUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTaskUUIDUploadResponseObject); ok {
-		return validResponse.VisitGetTaskUUIDUploadResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteViewUUIDResponseObject); ok {
+		return validResponse.VisitDeleteViewUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTaskUUIDUpload operation middleware
-func (sh *strictHandler) PatchTaskUUIDUpload(ctx echo.Context, uUID Uuid) error {
-	var request PatchTaskUUIDUploadRequestObject
+// GetViewUUID operation middleware
+func (sh *strictHandler) GetViewUUID(ctx echo.Context, uUID Uuid) error {
+	var request GetViewUUIDRequestObject
 
 	request.UUID = uUID
 
-	if reader, err := ctx.Request().MultipartReader(); err != nil {
-		return err
-	} else {
-		request.Body = reader
-	}
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTaskUUIDUpload(ctx.Request().Context(), request.(PatchTaskUUIDUploadRequestObject))
+		return sh.ssi.GetViewUUID(ctx.Request().Context(), request.(GetViewUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTaskUUIDUpload")
+		handler = middleware(handler, "GetViewUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTaskUUIDUploadResponseObject); ok {
-		return validResponse.VisitPatchTaskUUIDUploadResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetViewUUIDResponseObject); ok {
+		return validResponse.VisitGetViewUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTaskUUIDUploadEntityUUID operation middleware
-func (sh *strictHandler) DeleteTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request DeleteTaskUUIDUploadEntityUUIDRequestObject
+// PutViewUUID operation middleware
+func (sh *strictHandler) PutViewUUID(ctx echo.Context, uUID Uuid) error {
+	var request PutViewUUIDRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+
+	var body PutViewUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTaskUUIDUploadEntityUUID(ctx.Request().Context(), request.(DeleteTaskUUIDUploadEntityUUIDRequestObject))
+		return sh.ssi.PutViewUUID(ctx.Request().Context(), request.(PutViewUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTaskUUIDUploadEntityUUID")
+		handler = middleware(handler, "PutViewUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTaskUUIDUploadEntityUUIDResponseObject); ok {
-		return validResponse.VisitDeleteTaskUUIDUploadEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutViewUUIDResponseObject); ok {
+		return validResponse.VisitPutViewUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTaskUUIDUploadEntityUUID operation middleware
-func (sh *strictHandler) GetTaskUUIDUploadEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request GetTaskUUIDUploadEntityUUIDRequestObject
+// GetViewUUIDTask operation middleware
+func (sh *strictHandler) GetViewUUIDTask(ctx echo.Context, uUID Uuid, params GetViewUUIDTaskParams) error {
+	var request GetViewUUIDTaskRequestObject
 
 	request.UUID = uUID
-	request.EntityUUID = entityUUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTaskUUIDUploadEntityUUID(ctx.Request().Context(), request.(GetTaskUUIDUploadEntityUUIDRequestObject))
+		return sh.ssi.GetViewUUIDTask(ctx.Request().Context(), request.(GetViewUUIDTaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTaskUUIDUploadEntityUUID")
+		handler = middleware(handler, "GetViewUUIDTask")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTaskUUIDUploadEntityUUIDResponseObject); ok {
-		return validResponse.VisitGetTaskUUIDUploadEntityUUIDResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetViewUUIDTaskResponseObject); ok {
+		return validResponse.VisitGetViewUUIDTaskResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostTaskUUIDUploadEntityUUIDRename operation middleware
-func (sh *strictHandler) PostTaskUUIDUploadEntityUUIDRename(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
-	var request PostTaskUUIDUploadEntityUUIDRenameRequestObject
-
-	request.UUID = uUID
-	request.EntityUUID = entityUUID
+// GetWorklogReport operation middleware
+func (sh *strictHandler) GetWorklogReport(ctx echo.Context, params GetWorklogReportParams) error {
+	var request GetWorklogReportRequestObject
 
-	var body PostTaskUUIDUploadEntityUUIDRenameJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTaskUUIDUploadEntityUUIDRename(ctx.Request().Context(), request.(PostTaskUUIDUploadEntityUUIDRenameRequestObject))
+		return sh.ssi.GetWorklogReport(ctx.Request().Context(), request.(GetWorklogReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTaskUUIDUploadEntityUUIDRename")
+		handler = middleware(handler, "GetWorklogReport")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostTaskUUIDUploadEntityUUIDRenameResponseObject); ok {
-		return validResponse.VisitPostTaskUUIDUploadEntityUUIDRenameResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetWorklogReportResponseObject); ok {
+		return validResponse.VisitGetWorklogReportResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -0,0 +1,149 @@
+package web
+
+import (
+	"context"
+	"errors"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProjectUUIDAutomation(ctx context.Context, request oapi.GetProjectUUIDAutomationRequestObject) (oapi.GetProjectUUIDAutomationResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := a.app.AutomationsService.GetByProject(project.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDAutomation200JSONResponse{
+		Items: lo.Map(rules, func(item domain.AutomationRule, _ int) dto.AutomationRuleDTO {
+			return dto.NewAutomationRuleDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostProjectUUIDAutomation(ctx context.Context, request oapi.PostProjectUUIDAutomationRequestObject) (oapi.PostProjectUUIDAutomationResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if request.Body == nil {
+		return nil, errors.New("body is nil")
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := domain.NewAutomationRule(
+		domain.NewCreatorFromUser(&claims),
+		project.FederationUUID,
+		project.CompanyUUID,
+		project.UUID,
+		request.Body.Name,
+		request.Body.Trigger,
+		lo.FromPtr(request.Body.Conditions),
+		request.Body.Actions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Enabled = lo.FromPtrOr(request.Body.Enabled, true)
+
+	err = a.app.AutomationsService.Create(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProjectUUIDAutomation200JSONResponse{
+		Uuid: rule.UUID,
+	}, nil
+}
+
+func (a *Web) PutProjectUUIDAutomationEntityUUID(ctx context.Context, request oapi.PutProjectUUIDAutomationEntityUUIDRequestObject) (oapi.PutProjectUUIDAutomationEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if request.Body == nil {
+		return nil, errors.New("body is nil")
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := a.app.AutomationsService.Put(
+		project.UUID,
+		request.EntityUUID,
+		request.Body.Name,
+		lo.FromPtrOr(request.Body.Enabled, true),
+		request.Body.Trigger,
+		lo.FromPtr(request.Body.Conditions),
+		request.Body.Actions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutProjectUUIDAutomationEntityUUID200JSONResponse(dto.NewAutomationRuleDTO(rule)), nil
+}
+
+func (a *Web) DeleteProjectUUIDAutomationEntityUUID(ctx context.Context, request oapi.DeleteProjectUUIDAutomationEntityUUIDRequestObject) (oapi.DeleteProjectUUIDAutomationEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.AutomationsService.Delete(project.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteProjectUUIDAutomationEntityUUID200Response{}, nil
+}
+
+func (a *Web) GetProjectUUIDAutomationEntityUUIDRuns(ctx context.Context, request oapi.GetProjectUUIDAutomationEntityUUIDRunsRequestObject) (oapi.GetProjectUUIDAutomationEntityUUIDRunsResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := a.app.AutomationsService.GetRuns(project.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDAutomationEntityUUIDRuns200JSONResponse{
+		Items: lo.Map(runs, func(item domain.AutomationRun, _ int) dto.AutomationRunDTO {
+			return dto.NewAutomationRunDTO(item)
+		}),
+	}, nil
+}
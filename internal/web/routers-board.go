@@ -0,0 +1,76 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetBoard(ctx context.Context, request oapi.GetBoardRequestObject) (oapi.GetBoardResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.Params.ProjectUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := dto.TaskSearchDTO{
+		MyEmail: &claims.Email,
+
+		IsMy:           request.Params.IsMy,
+		Participated:   request.Params.Participated,
+		FederationUUID: project.FederationUUID,
+		ProjectUUID:    project.UUID,
+		Tags:           request.Params.Tags,
+	}
+
+	err = filter.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	offset := lo.FromPtrOr(request.Params.Offset, 0)
+	limit := lo.FromPtrOr(request.Params.Limit, 20)
+
+	columns, err := a.app.TaskService.GetBoard(project, filter, request.Params.Status, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetBoard200JSONResponse{
+		Columns: columns,
+	}, nil
+}
+
+func (a *Web) PatchTaskUUIDBoard(ctx context.Context, request oapi.PatchTaskUUIDBoardRequestObject) (oapi.PatchTaskUUIDBoardResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, task.ProjectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	rank, err := a.app.TaskService.MoveCard(ctx, domain.NewCreatorFromUser(&claims), project, task, request.Body.Status, request.Body.AfterUuid, request.Body.BeforeUuid, lo.FromPtr(request.Body.Comment))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchTaskUUIDBoard200JSONResponse{
+		BoardRank: rank,
+	}, nil
+}
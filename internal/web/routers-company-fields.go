@@ -8,6 +8,7 @@ import (
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/jwt"
 	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
 )
 
 func (a *Web) PostCompanyUUIDFields(ctx context.Context, request oapi.PostCompanyUUIDFieldsRequestObject) (oapi.PostCompanyUUIDFieldsResponseObject, error) {
@@ -22,11 +23,12 @@ func (a *Web) PostCompanyUUIDFields(ctx context.Context, request oapi.PostCompan
 		Description: request.Body.Description,
 		DataType:    request.Body.DataType,
 		Icon:        request.Body.Icon,
+		Formula:     lo.FromPtr(request.Body.Formula),
 	}
 
 	dt, err := a.app.FederationService.CreateCompanyField(pf)
 	if err != nil {
-		return nil, ErrInvalidAuthHeader
+		return nil, err
 	}
 
 	return oapi.PostCompanyUUIDFields200JSONResponse{
@@ -81,6 +83,7 @@ func (a *Web) GetCompanyUUIDFields(ctx context.Context, request oapi.GetCompanyU
 			Icon:         item.Icon,
 			DataType:     int(item.DataType),
 			DataDesc:     item.FieldTypeDesc(),
+			Formula:      item.Formula,
 			ProjectsUUID: item.ProjectUUID,
 
 			TasksTotal:        item.TasksTotal,
@@ -0,0 +1,119 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+func (a *Web) GetTaskExport(ctx context.Context, request oapi.GetTaskExportRequestObject) (oapi.GetTaskExportResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.Params.ProjectUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	filterDto, err := dto.NewFilterDTO(request.Params.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldFilter, err := dto.NewFieldFilter(request.Params.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := dto.TaskSearchDTO{
+		MyEmail: &claims.Email,
+
+		Name:           request.Params.Name,
+		IsMy:           request.Params.IsMy,
+		IsEpic:         request.Params.IsEpic,
+		Status:         request.Params.Status,
+		Participated:   request.Params.Participated,
+		FederationUUID: project.FederationUUID,
+		ProjectUUID:    project.UUID,
+		Tags:           request.Params.Tags,
+		Fields:         filterDto,
+		FieldFilter:    fieldFilter,
+		Path:           request.Params.Path,
+		Search:         request.Params.Search,
+	}
+
+	err = filter.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	format := string(request.Params.Format)
+	if !lo.Contains(domain.GetExportFormats(), format) {
+		return nil, domain.ErrExportInvalidFormat
+	}
+
+	if request.Params.Background != nil && *request.Params.Background {
+		e, err := a.app.ExportsService.Start(project, filter, format, claims.UUID)
+		if err != nil {
+			return nil, err
+		}
+
+		return oapi.GetTaskExport202JSONResponse(dto.NewTaskExportDTO(e, "")), nil
+	}
+
+	return exportStream{
+		a:       a,
+		project: project,
+		filter:  filter,
+		format:  format,
+	}, nil
+}
+
+func (a *Web) GetTaskExportUUID(ctx context.Context, request oapi.GetTaskExportUUIDRequestObject) (oapi.GetTaskExportUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	e, url, err := a.app.ExportsService.Get(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskExportUUID200JSONResponse(dto.NewTaskExportDTO(e, url)), nil
+}
+
+// exportStream writes tasks straight to the response, nothing is buffered in memory except xlsx.
+type exportStream struct {
+	a       *Web
+	project dto.ProjectDTO
+	filter  dto.TaskSearchDTO
+	format  string
+}
+
+func (s exportStream) VisitGetTaskExportResponse(w http.ResponseWriter) error {
+	name := fmt.Sprintf("%s.%s", helpers.Scientific(s.project.Name), s.format)
+
+	w.Header().Set("Content-Type", domain.ExportContentType(s.format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\";", name))
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	_, err := s.a.app.ExportsService.Write(s.project, s.filter, s.format, w)
+	if err != nil {
+		// headers are already sent, the error can only be logged
+		logrus.WithField("project", s.project.UUID).Error("task export error: ", err)
+	}
+
+	return err
+}
@@ -0,0 +1,152 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/labstack/echo/v4"
+)
+
+// importMaxFileSize - max size of uploaded import file, 20 MB.
+const importMaxFileSize = 20 << 20
+
+func (a *Web) PostImport(ctx context.Context, request oapi.PostImportRequestObject) (oapi.PostImportResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.Params.ProjectUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := request.Body.NextPart()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("file is required: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	// one byte over the limit is read to tell a full file from a cut one
+	data, err := io.ReadAll(io.LimitReader(file, importMaxFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > importMaxFileSize {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("размер файла больше %d МБ", importMaxFileSize>>20))
+	}
+
+	imp, err := a.app.ImportsService.Upload(domain.NewCreatorFromUser(&claims), project, file.FileName(), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostImport200JSONResponse(dto.NewTaskImportDTO(imp)), nil
+}
+
+func (a *Web) GetImportUUID(ctx context.Context, request oapi.GetImportUUIDRequestObject) (oapi.GetImportUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	imp, err := a.app.ImportsService.Get(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetImportUUID200JSONResponse(dto.NewTaskImportDTO(imp)), nil
+}
+
+func (a *Web) PostImportUUIDCheck(ctx context.Context, request oapi.PostImportUUIDCheckRequestObject) (oapi.PostImportUUIDCheckResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	mapping := make(map[int]string, len(request.Body.Mapping))
+	for k, v := range request.Body.Mapping {
+		col, err := strconv.Atoi(k)
+		if err != nil {
+			return nil, fmt.Errorf("некорректный номер колонки: %s", k)
+		}
+
+		mapping[col] = v
+	}
+
+	imp, err := a.app.ImportsService.Check(request.UUID, claims.UUID, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostImportUUIDCheck200JSONResponse(dto.NewTaskImportDTO(imp)), nil
+}
+
+func (a *Web) PostImportUUIDCommit(ctx context.Context, request oapi.PostImportUUIDCommitRequestObject) (oapi.PostImportUUIDCommitResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	imp, err := a.app.ImportsService.Start(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan dto.TaskImportProgressDTO, 10)
+
+	// import is not bound to the request, it is finished even if client is gone
+	go a.app.ImportsService.Run(imp, ch)
+
+	return importProgressStream(ch), nil
+}
+
+// importProgressStream writes import progress as server-sent events, same format as /seed.
+type importProgressStream <-chan dto.TaskImportProgressDTO
+
+func (ch importProgressStream) VisitPostImportUUIDCommitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+
+	i := 0
+	for state := range ch {
+		i++
+
+		js, err := json.Marshal(state)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(w, "id: %v\nevent: %s\ndata: %s\n\n", i, "import", js)
+		if err != nil {
+			// client is gone, drain the channel to let the import finish
+			for range ch {
+			}
+
+			return err
+		}
+
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	return nil
+}
@@ -5,9 +5,11 @@ import (
 	"sort"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/jwt"
 	oapi "github.com/krisch/crm-backend/internal/web/oprofile"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -43,7 +45,7 @@ func (a *Web) GetProfileNotifications(ctx context.Context, _ oapi.GetProfileNoti
 			reminderUUIDSs = append(reminderUUIDSs, uid)
 		}
 
-		if item.Type == "task" {
+		if item.Type == "task" || item.Type == "deadline" {
 			taskUUIDSs = append(taskUUIDSs, uid)
 		}
 	}
@@ -118,6 +120,50 @@ func (a *Web) GetProfileNotifications(ctx context.Context, _ oapi.GetProfileNoti
 				Uploads:   state.NewUploads,
 			})
 		}
+
+		if item.Type == "bulk" {
+			state, err := a.app.NotificationsService.GetBulkState(claims.Email, taskUUID)
+			if err != nil {
+				logrus.Warnf("GetBulkState: %s", err)
+				continue
+			}
+
+			tasks, err := a.app.TaskService.GetTasksNames(ctx, state.Tasks)
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, dto.NotificationBulkDTO{
+				UUID:      item.UUID,
+				Type:      item.Type,
+				Operation: state.Operation,
+				CreatedBy: state.CreatedBy,
+				Tasks: lo.Map(tasks, func(t domain.Task, _ int) dto.NotificationBulkTaskDTO {
+					return dto.NotificationBulkTaskDTO{UUID: t.UUID, Name: t.Name}
+				}),
+				Count: len(state.Tasks),
+				Score: item.Score,
+				Star:  item.Star,
+			})
+		}
+
+		if item.Type == "deadline" {
+			state, err := a.app.NotificationsService.GetDeadlineState(claims.Email, taskUUID)
+			if err != nil {
+				logrus.Warnf("GetDeadlineState: %s", err)
+				continue
+			}
+
+			items = append(items, dto.NotificationDeadlineDTO{
+				UUID:     item.UUID,
+				Type:     item.Type,
+				Name:     taskWithNameMap[item.UUID],
+				Kind:     state.Kind,
+				FinishTo: state.FinishTo,
+				Score:    item.Score,
+				Star:     item.Star,
+			})
+		}
 	}
 
 	return oapi.GetProfileNotifications200JSONResponse{
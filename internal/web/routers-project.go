@@ -163,6 +163,7 @@ func (a *Web) GetProjectUUID(ctx context.Context, request oapi.GetProjectUUIDReq
 				RequiredOnStatuses: item.RequiredOnStatuses,
 				Style:              item.Style,
 				DataDesc:           item.FieldTypeDesc(),
+				Formula:            item.Formula,
 			}
 		}),
 
@@ -373,11 +374,51 @@ func (a *Web) PatchProjectUUIDOptions(ctx context.Context, request oapi.PatchPro
 		return nil, errors.New("options is nil")
 	}
 
+	if request.Body.Escalations != nil {
+		err := domain.ValidateEscalationLevels(*request.Body.Escalations)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if request.Body.FieldRules != nil {
+		project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+		if err != nil {
+			return nil, err
+		}
+
+		fields := lo.SliceToMap(project.Fields, func(f dto.ProjectFieldDTO) (string, string) {
+			return f.Hash, f.Name
+		})
+
+		statuses := lo.Map(lo.FromPtr(project.Statuses), func(s dto.ProjectStatusDTO, _ int) int {
+			return s.Number
+		})
+
+		err = domain.ValidateFieldRules(*request.Body.FieldRules, fields, statuses)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var estimateUnit *string
+	if request.Body.EstimateUnit != nil {
+		estimateUnit = lo.ToPtr(string(*request.Body.EstimateUnit))
+
+		err := domain.ValidateEstimateUnit(*estimateUnit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err := a.app.FederationService.ChangeProjectOptions(request.UUID, domain.ProjectOptions{
 		RequireCancelationComment: request.Body.RequireCancelationComment,
 		RequireDoneComment:        request.Body.RequireDoneComment,
 		StatusEnable:              request.Body.StatusEnable,
 		Color:                     request.Body.Color,
+		Escalations:               request.Body.Escalations,
+		FieldRules:                request.Body.FieldRules,
+		EstimateUnit:              estimateUnit,
 	})
 	if err != nil {
 		return nil, ErrInvalidAuthHeader
@@ -442,6 +483,69 @@ func (a *Web) PatchProjectUUIDGraph(ctx context.Context, request oapi.PatchProje
 	return oapi.PatchProjectUUIDGraph200JSONResponse(helpers.ToInterfaceMap(graphMap)), nil
 }
 
+func (a *Web) GetProjectUUIDGraph(ctx context.Context, request oapi.GetProjectUUIDGraphRequestObject) (oapi.GetProjectUUIDGraphResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	sg, statuses, err := projectStatusGraph(project)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDGraph200JSONResponse{
+		Graph:  sg.Graph,
+		Issues: sg.Analyze(lo.Keys(statuses)),
+	}, nil
+}
+
+func (a *Web) GetProjectUUIDGraphExport(ctx context.Context, request oapi.GetProjectUUIDGraphExportRequestObject) (oapi.GetProjectUUIDGraphExportResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	sg, statuses, err := projectStatusGraph(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Params.Format == oapi.Mermaid {
+		return oapi.GetProjectUUIDGraphExport200TextResponse(sg.Mermaid(statuses)), nil
+	}
+
+	return oapi.GetProjectUUIDGraphExport200TextResponse(sg.DOT(statuses)), nil
+}
+
+// projectStatusGraph returns the graph of the project (default one if it is not set) and names of statuses by number.
+func projectStatusGraph(project dto.ProjectDTO) (sg *domain.StatusGraph, statuses map[int]string, err error) {
+	statuses = make(map[int]string)
+	if project.Statuses != nil {
+		for _, st := range *project.Statuses {
+			statuses[st.Number] = st.Name
+		}
+	}
+
+	if project.StatusGraph == nil || len(*project.StatusGraph) == 0 {
+		return domain.DefaultStatusGraph(), statuses, nil
+	}
+
+	sg, err = domain.NewStatusGraphFromMap(*project.StatusGraph)
+
+	return sg, statuses, err
+}
+
 func (a *Web) PostProjectUUIDUser(ctx context.Context, request oapi.PostProjectUUIDUserRequestObject) (oapi.PostProjectUUIDUserResponseObject, error) {
 	_, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
@@ -619,6 +723,11 @@ func (a *Web) PostProjectUUIDFieldEntityUUID(ctx context.Context, request oapi.P
 		return nil, err
 	}
 
+	err = a.app.TaskService.RecomputeFormulas(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	return oapi.PostProjectUUIDFieldEntityUUID200Response{}, nil
 }
 
@@ -633,6 +742,11 @@ func (a *Web) DeleteProjectUUIDFieldEntityUUID(ctx context.Context, request oapi
 		return nil, err
 	}
 
+	err = a.app.TaskService.RecomputeFormulas(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
 	return oapi.DeleteProjectUUIDFieldEntityUUID200Response{}, nil
 }
 
@@ -722,3 +836,79 @@ func (a *Web) PostProjectUUIDStatus(ctx context.Context, request oapi.PostProjec
 		Uuid: *dm.UUID,
 	}, nil
 }
+
+func (a *Web) GetProjectUUIDTimeline(ctx context.Context, request oapi.GetProjectUUIDTimelineRequestObject) (oapi.GetProjectUUIDTimelineResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline, err := a.app.TaskService.GetTimeline(ctx, project.UUID, request.Params.EpicUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDTimeline200JSONResponse(dto.NewTimelineDTO(timeline)), nil
+}
+
+func (a *Web) GetProjectUUIDBurndown(ctx context.Context, request oapi.GetProjectUUIDBurndownRequestObject) (oapi.GetProjectUUIDBurndownResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := a.app.EstimatesService.GetSeries(project.UUID, request.Params.DateFrom, request.Params.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDBurndown200JSONResponse{
+		Unit: projectEstimateUnit(project),
+		Items: lo.Map(points, func(p domain.BurnPoint, _ int) dto.BurndownPointDTO {
+			return dto.NewBurndownPointDTO(p)
+		}),
+	}, nil
+}
+
+func (a *Web) GetProjectUUIDBurnup(ctx context.Context, request oapi.GetProjectUUIDBurnupRequestObject) (oapi.GetProjectUUIDBurnupResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := a.app.EstimatesService.GetSeries(project.UUID, request.Params.DateFrom, request.Params.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDBurnup200JSONResponse{
+		Unit: projectEstimateUnit(project),
+		Items: lo.Map(points, func(p domain.BurnPoint, _ int) dto.BurnupPointDTO {
+			return dto.NewBurnupPointDTO(p)
+		}),
+	}, nil
+}
+
+// projectEstimateUnit returns the unit of task estimates, points by default.
+func projectEstimateUnit(project dto.ProjectDTO) string {
+	if project.Options == nil || project.Options.EstimateUnit == nil {
+		return domain.EstimatePoints
+	}
+
+	return *project.Options.EstimateUnit
+}
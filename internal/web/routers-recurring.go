@@ -0,0 +1,153 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetRecurring(ctx context.Context, request oapi.GetRecurringRequestObject) (oapi.GetRecurringResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	var dms []domain.RecurringTask
+	var err error
+
+	if request.Params.ProjectUuid != nil {
+		dms, err = a.app.RecurringService.GetByProject(*request.Params.ProjectUuid)
+	} else {
+		dms, err = a.app.RecurringService.GetByUser(claims.UUID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := lo.Map(dms, func(dm domain.RecurringTask, _ int) dto.RecurringTaskDTO {
+		return dto.NewRecurringTaskDTO(dm, a.app.DictionaryService)
+	})
+
+	return oapi.GetRecurring200JSONResponse{
+		Count: len(dtos),
+		Items: dtos,
+	}, nil
+}
+
+func (a *Web) PostRecurring(ctx context.Context, request oapi.PostRecurringRequestObject) (oapi.PostRecurringResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, find := a.app.DictionaryService.FindProject(request.Body.ProjectUuid)
+	if !find {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	err := a.app.TaskService.CheckPath(request.Body.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := domain.NewRecurringTask(
+		project.FederationUUID,
+		project.CompanyUUID,
+		request.Body.ProjectUuid,
+		claims.Email,
+		claims.UUID,
+		request.Body.Schedule,
+		domain.TaskBlueprint{
+			Name:          request.Body.Name,
+			Description:   request.Body.Description,
+			Fields:        request.Body.Fields,
+			Tags:          request.Body.Tags,
+			Priority:      request.Body.Priority,
+			Path:          request.Body.Path,
+			ImplementBy:   request.Body.ImplementBy,
+			ResponsibleBy: request.Body.ResponsibleBy,
+			ManagedBy:     request.Body.ManagedBy,
+			CoWorkersBy:   request.Body.CoworkersBy,
+			Icon:          request.Body.Icon,
+			FinishIn:      request.Body.FinishIn,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.RecurringService.Create(dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostRecurring200JSONResponse{
+		Uuid: dm.UUID,
+	}, nil
+}
+
+func (a *Web) GetRecurringUUID(ctx context.Context, request oapi.GetRecurringUUIDRequestObject) (oapi.GetRecurringUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.RecurringService.Get(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetRecurringUUID200JSONResponse(dto.NewRecurringTaskDTO(dm, a.app.DictionaryService)), nil
+}
+
+func (a *Web) DeleteRecurringUUID(ctx context.Context, request oapi.DeleteRecurringUUIDRequestObject) (oapi.DeleteRecurringUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.RecurringService.Delete(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteRecurringUUID200Response{}, nil
+}
+
+func (a *Web) PatchRecurringUUIDPause(ctx context.Context, request oapi.PatchRecurringUUIDPauseRequestObject) (oapi.PatchRecurringUUIDPauseResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.RecurringService.Pause(request.UUID, request.Body.Paused)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchRecurringUUIDPause200Response{}, nil
+}
+
+func (a *Web) GetRecurringUUIDPreview(ctx context.Context, request oapi.GetRecurringUUIDPreviewRequestObject) (oapi.GetRecurringUUIDPreviewResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	limit := helpers.If(request.Params.Limit == nil, 10, *request.Params.Limit)
+
+	items, err := a.app.RecurringService.Preview(request.UUID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetRecurringUUIDPreview200JSONResponse{
+		Count: len(items),
+		Items: items,
+	}, nil
+}
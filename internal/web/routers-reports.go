@@ -0,0 +1,75 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProjectUUIDReportCycleTime(ctx context.Context, request oapi.GetProjectUUIDReportCycleTimeRequestObject) (oapi.GetProjectUUIDReportCycleTimeResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupBy := ""
+	if request.Params.GroupBy != nil {
+		groupBy = string(*request.Params.GroupBy)
+	}
+
+	report, err := a.app.ReportsService.CycleTime(project.UUID, request.Params.DateFrom, request.Params.DateTo, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	names := lo.SliceToMap(lo.FromPtr(project.Statuses), func(s dto.ProjectStatusDTO) (int, string) {
+		return s.Number, s.Name
+	})
+
+	return oapi.GetProjectUUIDReportCycleTime200JSONResponse(dto.NewCycleTimeReportDTO(report, names)), nil
+}
+
+func (a *Web) GetFederationUUIDWorkload(ctx context.Context, request oapi.GetFederationUUIDWorkloadRequestObject) (oapi.GetFederationUUIDWorkloadResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	_, found := a.app.DictionaryService.FindFederation(request.UUID)
+	if !found {
+		return nil, dto.NotFoundErr("федерация не найдена")
+	}
+
+	if request.Params.CompanyUuid != nil {
+		company, found := a.app.DictionaryService.FindCompany(*request.Params.CompanyUuid)
+		if !found || company.FederationUUID != request.UUID {
+			return nil, dto.NotFoundErr("компания не найдена")
+		}
+	}
+
+	dms, err := a.app.ReportsService.Workload(ctx, request.UUID, request.Params.CompanyUuid, request.Params.GroupUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDWorkload200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(item domain.Workload, _ int) dto.WorkloadDTO {
+			user, found := a.app.DictionaryService.FindUser(item.Email)
+			if !found {
+				user = nil
+			}
+
+			return dto.NewWorkloadDTO(item, user)
+		}),
+	}, nil
+}
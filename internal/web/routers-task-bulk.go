@@ -0,0 +1,107 @@
+package web
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) PostTaskBulk(ctx context.Context, request oapi.PostTaskBulkRequestObject) (oapi.PostTaskBulkResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	body := request.Body
+	operation := string(body.Operation)
+
+	uuids := lo.FromPtr(body.Uuids)
+	if len(uuids) == 0 && body.Filter != nil {
+		filter := dto.TaskSearchDTO{
+			MyEmail: &claims.Email,
+
+			Name:           body.Filter.Name,
+			IsMy:           body.Filter.IsMy,
+			IsEpic:         body.Filter.IsEpic,
+			Status:         body.Filter.Status,
+			Participated:   body.Filter.Participated,
+			FederationUUID: body.Filter.FederationUuid,
+			ProjectUUID:    body.Filter.ProjectUuid,
+			Tags:           body.Filter.Tags,
+			Path:           body.Filter.Path,
+			Search:         body.Filter.Search,
+		}
+
+		err := filter.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		uuids, err = a.app.TaskService.BulkTaskUUIDs(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	op, err := domain.NewBulkOperation(operation, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	change := dto.TaskBulkDTO{
+		Status:        body.Status,
+		Comment:       lo.FromPtr(body.Comment),
+		ImplementBy:   body.ImplementBy,
+		ResponsibleBy: body.ResponsibleBy,
+		ManagedBy:     body.ManagedBy,
+		CoworkersBy:   body.CoworkersBy,
+		WatchedBy:     body.WatchedBy,
+		Tags:          lo.FromPtr(body.Tags),
+		ProjectUUID:   body.ProjectUuid,
+	}
+
+	gate := func(task domain.Task) error {
+		if operation == domain.BulkDelete {
+			return a.app.GateService.TaskDelete(task, claims.UUID)
+		}
+
+		return a.app.GateService.TaskPatch(task, claims.UUID)
+	}
+
+	projects := make(map[uuid.UUID]dto.ProjectDTO)
+	getProject := func(uid uuid.UUID) (dto.ProjectDTO, error) {
+		if project, ok := projects[uid]; ok {
+			return project, nil
+		}
+
+		project, err := a.app.AgregateService.GetProject(ctx, uid)
+		if err == nil {
+			projects[uid] = project
+		}
+
+		return project, err
+	}
+
+	results, err := a.app.TaskService.Bulk(ctx, domain.NewCreatorFromUser(&claims), op, change, gate, getProject)
+	if err != nil {
+		return nil, err
+	}
+
+	items := lo.Map(results, func(item domain.BulkResult, _ int) dto.BulkResultDTO {
+		return dto.NewBulkResultDTO(item)
+	})
+
+	return oapi.PostTaskBulk200JSONResponse{
+		Uuid:  op.UUID,
+		Count: len(items),
+		Failed: lo.CountBy(results, func(item domain.BulkResult) bool {
+			return !item.Ok()
+		}),
+		Items: items,
+	}, nil
+}
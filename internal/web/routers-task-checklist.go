@@ -0,0 +1,144 @@
+package web
+
+import (
+	"context"
+	"errors"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetTaskUUIDChecklist(ctx context.Context, request oapi.GetTaskUUIDChecklistRequestObject) (oapi.GetTaskUUIDChecklistResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	items, err := a.app.TaskService.GetChecklist(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskUUIDChecklist200JSONResponse{
+		Count: len(items),
+		Items: lo.Map(items, func(item domain.ChecklistItem, _ int) dto.ChecklistItemDTO {
+			return dto.NewChecklistItemDTO(item)
+		}),
+	}, nil
+}
+
+func (a *Web) PostTaskUUIDChecklist(ctx context.Context, request oapi.PostTaskUUIDChecklistRequestObject) (oapi.PostTaskUUIDChecklistResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if request.Body == nil {
+		return nil, errors.New("body is nil")
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := a.app.TaskService.AddChecklistItem(domain.NewCreatorFromUser(&claims), task, request.Body.Text, lo.FromPtr(request.Body.AssignedTo), request.Body.DueAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDChecklist200JSONResponse(dto.NewChecklistItemDTO(item)), nil
+}
+
+func (a *Web) PutTaskUUIDChecklistEntityUUID(ctx context.Context, request oapi.PutTaskUUIDChecklistEntityUUIDRequestObject) (oapi.PutTaskUUIDChecklistEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if request.Body == nil {
+		return nil, errors.New("body is nil")
+	}
+
+	item, err := a.app.TaskService.PutChecklistItem(domain.NewCreatorFromUser(&claims), request.UUID, request.EntityUUID, request.Body.Text, lo.FromPtr(request.Body.AssignedTo), request.Body.DueAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutTaskUUIDChecklistEntityUUID200JSONResponse(dto.NewChecklistItemDTO(item)), nil
+}
+
+func (a *Web) DeleteTaskUUIDChecklistEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDChecklistEntityUUIDRequestObject) (oapi.DeleteTaskUUIDChecklistEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.TaskService.DeleteChecklistItem(domain.NewCreatorFromUser(&claims), request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteTaskUUIDChecklistEntityUUID200Response{}, nil
+}
+
+func (a *Web) PatchTaskUUIDChecklistEntityUUIDDone(ctx context.Context, request oapi.PatchTaskUUIDChecklistEntityUUIDDoneRequestObject) (oapi.PatchTaskUUIDChecklistEntityUUIDDoneResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if request.Body == nil {
+		return nil, errors.New("body is nil")
+	}
+
+	item, err := a.app.TaskService.ToggleChecklistItem(domain.NewCreatorFromUser(&claims), request.UUID, request.EntityUUID, request.Body.Done)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchTaskUUIDChecklistEntityUUIDDone200JSONResponse(dto.NewChecklistItemDTO(item)), nil
+}
+
+func (a *Web) PatchTaskUUIDChecklistEntityUUIDMove(ctx context.Context, request oapi.PatchTaskUUIDChecklistEntityUUIDMoveRequestObject) (oapi.PatchTaskUUIDChecklistEntityUUIDMoveResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if request.Body == nil {
+		return nil, errors.New("body is nil")
+	}
+
+	item, err := a.app.TaskService.MoveChecklistItem(domain.NewCreatorFromUser(&claims), request.UUID, request.EntityUUID, request.Body.AfterUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchTaskUUIDChecklistEntityUUIDMove200JSONResponse(dto.NewChecklistItemDTO(item)), nil
+}
+
+func (a *Web) PostTaskUUIDChecklistEntityUUIDConvert(ctx context.Context, request oapi.PostTaskUUIDChecklistEntityUUIDConvertRequestObject) (oapi.PostTaskUUIDChecklistEntityUUIDConvertResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	subtask, id, err := a.app.TaskService.ConvertChecklistItem(domain.NewCreatorFromUser(&claims), task, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDChecklistEntityUUIDConvert200JSONResponse{
+		Uuid: subtask.UUID,
+		Id:   id,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package web
+
+import (
+	"context"
+	"errors"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) PostTaskUUIDClone(ctx context.Context, request oapi.PostTaskUUIDCloneRequestObject) (oapi.PostTaskUUIDCloneResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if request.Body == nil {
+		return nil, errors.New("body is nil")
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, lo.FromPtrOr(request.Body.ProjectUuid, task.ProjectUUID))
+	if err != nil {
+		return nil, err
+	}
+
+	var parent *domain.Task
+	if request.Body.ParentUuid != nil {
+		p, err := a.app.TaskService.GetTask(ctx, *request.Body.ParentUuid, []string{})
+		if err != nil {
+			return nil, err
+		}
+
+		parent = &p
+	}
+
+	root, count, err := a.app.TaskService.CloneTask(
+		domain.NewCreatorFromUser(&claims),
+		task,
+		project,
+		parent,
+		lo.FromPtr(request.Body.Subtasks),
+		lo.FromPtr(request.Body.Files),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDClone200JSONResponse{
+		Uuid:  root.UUID,
+		Count: count,
+	}, nil
+}
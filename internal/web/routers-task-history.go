@@ -0,0 +1,43 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+)
+
+func (a *Web) GetTaskUUIDHistoryState(ctx context.Context, request oapi.GetTaskUUIDHistoryStateRequestObject) (oapi.GetTaskUUIDHistoryStateResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, state, err := a.app.TaskService.GetTaskStateAt(ctx, request.UUID, request.Params.At)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskUUIDHistoryState200JSONResponse{
+		Uuid:  task.UUID,
+		Id:    task.ID,
+		At:    request.Params.At,
+		State: state,
+	}, nil
+}
+
+func (a *Web) GetTaskUUIDHistoryDiff(ctx context.Context, request oapi.GetTaskUUIDHistoryDiffRequestObject) (oapi.GetTaskUUIDHistoryDiffResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	changes, err := a.app.TaskService.GetTaskStateDiff(ctx, request.UUID, request.Params.DateFrom, request.Params.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskUUIDHistoryDiff200JSONResponse{
+		Items: changes,
+	}, nil
+}
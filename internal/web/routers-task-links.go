@@ -0,0 +1,62 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetTaskUUIDLink(ctx context.Context, request oapi.GetTaskUUIDLinkRequestObject) (oapi.GetTaskUUIDLinkResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	links, err := a.app.TaskService.GetLinks(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := lo.Map(links, func(link domain.TaskLink, _ int) dto.TaskLinkDTO {
+		return dto.NewTaskLinkDTO(request.UUID, link, a.app.DictionaryService)
+	})
+
+	return oapi.GetTaskUUIDLink200JSONResponse{
+		Count: len(dtos),
+		Items: dtos,
+	}, nil
+}
+
+func (a *Web) PostTaskUUIDLink(ctx context.Context, request oapi.PostTaskUUIDLinkRequestObject) (oapi.PostTaskUUIDLinkResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	link, err := a.app.TaskService.CreateLink(ctx, domain.NewCreatorFromUser(&claims), request.UUID, request.Body.TaskUuid, request.Body.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDLink200JSONResponse{
+		Uuid: link.UUID,
+	}, nil
+}
+
+func (a *Web) DeleteTaskUUIDLinkEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDLinkEntityUUIDRequestObject) (oapi.DeleteTaskUUIDLinkEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.TaskService.DeleteLink(ctx, domain.NewCreatorFromUser(&claims), request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteTaskUUIDLinkEntityUUID200Response{}, nil
+}
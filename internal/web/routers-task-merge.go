@@ -0,0 +1,48 @@
+package web
+
+import (
+	"context"
+	"errors"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) PostTaskUUIDMerge(ctx context.Context, request oapi.PostTaskUUIDMergeRequestObject) (oapi.PostTaskUUIDMergeResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if request.Body == nil {
+		return nil, errors.New("body is nil")
+	}
+
+	target, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := a.app.TaskService.GetTask(ctx, request.Body.SourceUuid, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	sourceProject, err := a.app.AgregateService.GetProject(ctx, source.ProjectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := string(lo.FromPtrOr(request.Body.Policy, oapi.PostTaskUUIDMergeJSONBodyPolicy(domain.MergeKeepTarget)))
+
+	conflicts, err := a.app.TaskService.MergeTask(ctx, domain.NewCreatorFromUser(&claims), target, source, sourceProject, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDMerge200JSONResponse{
+		Conflicts: lo.Ternary(conflicts == nil, []string{}, conflicts),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) PatchTaskUUIDSchedule(ctx context.Context, request oapi.PatchTaskUUIDScheduleRequestObject) (oapi.PatchTaskUUIDScheduleResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if request.Body == nil {
+		return nil, errors.New("body is nil")
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	shifted, err := a.app.TaskService.ShiftTask(
+		ctx,
+		domain.NewCreatorFromUser(&claims),
+		task,
+		time.Duration(request.Body.Shift)*time.Minute,
+		lo.FromPtr(request.Body.Cascade),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchTaskUUIDSchedule200JSONResponse{
+		Items: lo.Map(shifted, func(t domain.Task, _ int) dto.TimelineTaskDTO {
+			return dto.NewTimelineTaskDTO(t)
+		}),
+	}, nil
+}
@@ -181,6 +181,9 @@ func (a *Web) PostTask(ctx context.Context, request oapi.PostTaskRequestObject)
 		return nil, err
 	}
 
+	task.StartAt = request.Body.StartAt
+	task.Estimate = request.Body.Estimate
+
 	id, err := a.app.TaskService.CreateTask(task)
 	if err != nil {
 		return nil, err
@@ -199,7 +202,7 @@ func (a *Web) DeleteTaskUUID(ctx context.Context, request oapi.DeleteTaskUUIDReq
 		return nil, ErrInvalidAuthHeader
 	}
 
-	err := a.app.TaskService.DeleteTask(domain.NewCreatorFromUser(&claims), request.UUID)
+	err := a.app.TaskService.DeleteTask(ctx, domain.NewCreatorFromUser(&claims), request.UUID)
 
 	return oapi.DeleteTaskUUID200Response{}, err
 }
@@ -239,6 +242,16 @@ func (a *Web) PutTaskUUID(ctx context.Context, request oapi.PutTaskUUIDRequestOb
 		shouldUpdate = append(shouldUpdate, "priority")
 	}
 
+	if request.Body.StartAt != nil {
+		task.StartAt = request.Body.StartAt
+		shouldUpdate = append(shouldUpdate, "start_at")
+	}
+
+	if request.Body.Estimate != nil {
+		task.Estimate = request.Body.Estimate
+		shouldUpdate = append(shouldUpdate, "estimate")
+	}
+
 	if request.Body.FinishTo != nil {
 		task.FinishTo = request.Body.FinishTo
 		shouldUpdate = append(shouldUpdate, "finish_to")
@@ -249,7 +262,7 @@ func (a *Web) PutTaskUUID(ctx context.Context, request oapi.PutTaskUUIDRequestOb
 		shouldUpdate = append(shouldUpdate, "icon")
 	}
 
-	err = a.app.TaskService.UpdateTask(domain.NewCreatorFromUser(&claims), task, shouldUpdate)
+	err = a.app.TaskService.UpdateTask(ctx, domain.NewCreatorFromUser(&claims), task, shouldUpdate)
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +314,7 @@ func (a *Web) GetTaskUUID(ctx context.Context, request oapi.GetTaskUUIDRequestOb
 	}
 
 	// db
-	dm, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{"activities"})
+	dm, err := a.app.TaskService.GetTask(ctx, request.UUID, []string{"activities", "links"})
 	if err != nil {
 		return nil, err
 	}
@@ -399,6 +412,11 @@ func (a *Web) GetTask(ctx context.Context, request oapi.GetTaskRequestObject) (o
 		return nil, err
 	}
 
+	fieldFilter, err := dto.NewFieldFilter(request.Params.Filter)
+	if err != nil {
+		return nil, err
+	}
+
 	filter := dto.TaskSearchDTO{
 		MyEmail: &claims.Email,
 
@@ -413,10 +431,15 @@ func (a *Web) GetTask(ctx context.Context, request oapi.GetTaskRequestObject) (o
 		ProjectUUID:    request.Params.ProjectUuid,
 		Tags:           request.Params.Tags,
 		Fields:         filterDto,
+		FieldFilter:    fieldFilter,
 		Path:           request.Params.Path,
+		Search:         request.Params.Search,
 
 		Order: request.Params.Order,
 		By:    request.Params.By,
+
+		Cursor: request.Params.Cursor,
+		Total:  (*string)(request.Params.Total),
 	}
 
 	err = filter.Validate()
@@ -424,7 +447,7 @@ func (a *Web) GetTask(ctx context.Context, request oapi.GetTaskRequestObject) (o
 		return nil, err
 	}
 
-	dtos, total, err := a.app.TaskService.GetTasksDto(ctx, filter)
+	dtos, total, next, err := a.app.TaskService.GetTasksDto(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -459,13 +482,15 @@ func (a *Web) GetTask(ctx context.Context, request oapi.GetTaskRequestObject) (o
 
 	return oapi.GetTask200JSONResponse{
 		Body: struct {
-			Count int            `json:"count"`
-			Items []dto.TaskDTOs `json:"items"`
-			Total int64          `json:"total"`
+			Count      int            `json:"count"`
+			Items      []dto.TaskDTOs `json:"items"`
+			NextCursor *string        `json:"next_cursor,omitempty"`
+			Total      int64          `json:"total"`
 		}{
-			Count: len(dtos),
-			Items: dtos,
-			Total: total,
+			Count:      len(dtos),
+			Items:      dtos,
+			NextCursor: lo.EmptyableToPtr(next),
+			Total:      total,
 		},
 		Headers: oapi.GetTask200ResponseHeaders{
 			CacheControl: "no-cache",
@@ -697,7 +722,7 @@ func (a *Web) PatchTaskUUIDProject(ctx context.Context, request oapi.PatchTaskUU
 		return nil, err
 	}
 
-	err = a.app.TaskService.PatchProject(domain.NewCreatorFromUser(&claims), task, project, request.Body.Status, request.Body.Comment)
+	err = a.app.TaskService.PatchProject(ctx, domain.NewCreatorFromUser(&claims), task, project, request.Body.Status, request.Body.Comment)
 	if err != nil {
 		return nil, err
 	}
@@ -735,7 +760,7 @@ func (a *Web) PatchTaskUUIDStatus(ctx context.Context, request oapi.PatchTaskUUI
 		return nil, err
 	}
 
-	stopUUID, path, err := a.app.TaskService.PatchStatus(domain.NewCreatorFromUser(&claims), project, task, request.Body.Status, request.Body.Comment)
+	stopUUID, path, err := a.app.TaskService.PatchStatus(ctx, domain.NewCreatorFromUser(&claims), project, task, request.Body.Status, request.Body.Comment)
 	if err != nil {
 		return nil, err
 	}
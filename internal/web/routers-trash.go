@@ -0,0 +1,57 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProjectUUIDTrash(ctx context.Context, request oapi.GetProjectUUIDTrashRequestObject) (oapi.GetProjectUUIDTrashResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := lo.FromPtrOr(request.Params.Offset, 0)
+	limit := lo.FromPtrOr(request.Params.Limit, 20)
+
+	items, total, err := a.app.TrashService.GetItems(project.UUID, string(lo.FromPtr(request.Params.Type)), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDTrash200JSONResponse{
+		Count: int(total),
+		Items: lo.Map(items, func(item domain.TrashItem, _ int) dto.TrashItemDTO {
+			return dto.NewTrashItemDTO(item, a.app.Options.TRASH_RETENTION_DAYS)
+		}),
+	}, nil
+}
+
+func (a *Web) PostProjectUUIDTrashEntityUUIDRestore(ctx context.Context, request oapi.PostProjectUUIDTrashEntityUUIDRestoreRequestObject) (oapi.PostProjectUUIDTrashEntityUUIDRestoreResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.AgregateService.GetProject(ctx, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := a.app.TrashService.Restore(domain.NewCreatorFromUser(&claims), project.UUID, string(request.Params.Type), request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProjectUUIDTrashEntityUUIDRestore200JSONResponse(dto.NewTrashItemDTO(item, a.app.Options.TRASH_RETENTION_DAYS)), nil
+}
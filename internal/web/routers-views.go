@@ -0,0 +1,181 @@
+package web
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetView(ctx context.Context, request oapi.GetViewRequestObject) (oapi.GetViewResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, err := a.app.ViewsService.GetByProject(request.Params.ProjectUuid, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := lo.Map(dms, func(dm domain.TaskView, _ int) dto.TaskViewDTO {
+		return dto.NewTaskViewDTO(dm, a.app.DictionaryService)
+	})
+
+	return oapi.GetView200JSONResponse{
+		Count: len(dtos),
+		Items: dtos,
+	}, nil
+}
+
+func (a *Web) PostView(ctx context.Context, request oapi.PostViewRequestObject) (oapi.PostViewResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, find := a.app.DictionaryService.FindProject(request.Body.ProjectUuid)
+	if !find {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	err := a.checkViewOrder(project.UUID, request.Body.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := domain.NewTaskView(
+		project.FederationUUID,
+		project.UUID,
+		claims.UUID,
+		request.Body.Name,
+		helpers.If(request.Body.IsShared == nil, false, *request.Body.IsShared),
+		request.Body.Filter,
+		request.Body.Order,
+		request.Body.By,
+		lo.FromPtr(request.Body.Columns),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.ViewsService.Create(dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostView200JSONResponse{
+		Uuid: dm.UUID,
+	}, nil
+}
+
+func (a *Web) GetViewUUID(ctx context.Context, request oapi.GetViewUUIDRequestObject) (oapi.GetViewUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.ViewsService.Get(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetViewUUID200JSONResponse(dto.NewTaskViewDTO(dm, a.app.DictionaryService)), nil
+}
+
+func (a *Web) PutViewUUID(ctx context.Context, request oapi.PutViewUUIDRequestObject) (oapi.PutViewUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.ViewsService.Get(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.checkViewOrder(dm.ProjectUUID, request.Body.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	dm.Name = request.Body.Name
+	dm.IsShared = helpers.If(request.Body.IsShared == nil, dm.IsShared, lo.FromPtr(request.Body.IsShared))
+	dm.Filter = request.Body.Filter
+	dm.Order = request.Body.Order
+	dm.By = request.Body.By
+	dm.Columns = lo.FromPtr(request.Body.Columns)
+
+	err = a.app.ViewsService.Update(claims.UUID, dm)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutViewUUID200Response{}, nil
+}
+
+func (a *Web) DeleteViewUUID(ctx context.Context, request oapi.DeleteViewUUIDRequestObject) (oapi.DeleteViewUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.ViewsService.Delete(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteViewUUID200Response{}, nil
+}
+
+func (a *Web) GetViewUUIDTask(ctx context.Context, request oapi.GetViewUUIDTaskRequestObject) (oapi.GetViewUUIDTaskResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.ViewsService.Get(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := dto.NewTaskSearchDTOFromView(dm, claims.Email, request.Params.Offset, request.Params.Limit)
+	filter.Cursor = request.Params.Cursor
+	filter.Total = (*string)(request.Params.Total)
+
+	err = filter.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	dtos, total, next, err := a.app.TaskService.GetTasksDto(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetViewUUIDTask200JSONResponse{
+		Count:      len(dtos),
+		Items:      dtos,
+		NextCursor: lo.EmptyableToPtr(next),
+		Total:      total,
+		Columns:    dto.NewTaskViewDTO(dm, a.app.DictionaryService).Columns,
+	}, nil
+}
+
+func (a *Web) checkViewOrder(projectUUID uuid.UUID, order *string) error {
+	if order == nil {
+		return nil
+	}
+
+	if lo.IndexOf(a.app.TaskService.GetSortFields(projectUUID), *order) == -1 {
+		return fmt.Errorf("сортировка по полю %s недоступна", *order)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package web
+
+import (
+	"context"
+	"errors"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/otask"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetTaskUUIDWorklog(ctx context.Context, request oapi.GetTaskUUIDWorklogRequestObject) (oapi.GetTaskUUIDWorklogResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, err := a.app.WorklogService.GetByTask(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	own, withChildren, err := a.app.WorklogService.Total(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := lo.Map(dms, func(dm domain.Worklog, _ int) dto.WorklogDTO {
+		return dto.NewWorklogDTO(dm, a.app.DictionaryService)
+	})
+
+	return oapi.GetTaskUUIDWorklog200JSONResponse{
+		Count:             len(dtos),
+		Items:             dtos,
+		Total:             own,
+		TotalWithChildren: withChildren,
+	}, nil
+}
+
+func (a *Web) PostTaskUUIDWorklog(ctx context.Context, request oapi.PostTaskUUIDWorklogRequestObject) (oapi.PostTaskUUIDWorklogResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	comment := helpers.If(request.Body.Comment == nil, "", *request.Body.Comment)
+
+	wl, err := a.app.WorklogService.Create(ctx, request.UUID, claims.UUID, request.Body.StartedAt, request.Body.Duration, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDWorklog200JSONResponse{
+		Uuid: wl.UUID,
+	}, nil
+}
+
+func (a *Web) DeleteTaskUUIDWorklogEntityUUID(ctx context.Context, request oapi.DeleteTaskUUIDWorklogEntityUUIDRequestObject) (oapi.DeleteTaskUUIDWorklogEntityUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.WorklogService.Delete(ctx, request.UUID, request.EntityUUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteTaskUUIDWorklogEntityUUID200Response{}, nil
+}
+
+func (a *Web) PostTaskUUIDTimer(ctx context.Context, request oapi.PostTaskUUIDTimerRequestObject) (oapi.PostTaskUUIDTimerResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	wl, err := a.app.WorklogService.StartTimer(ctx, request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTaskUUIDTimer200JSONResponse(dto.NewWorklogDTO(wl, a.app.DictionaryService)), nil
+}
+
+func (a *Web) GetTimer(ctx context.Context, _ oapi.GetTimerRequestObject) (oapi.GetTimerResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	wl, err := a.app.WorklogService.GetTimer(claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTimer200JSONResponse(dto.NewWorklogDTO(wl, a.app.DictionaryService)), nil
+}
+
+func (a *Web) PostTimerStop(ctx context.Context, request oapi.PostTimerStopRequestObject) (oapi.PostTimerStopResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	comment := ""
+	if request.Body != nil && request.Body.Comment != nil {
+		comment = *request.Body.Comment
+	}
+
+	wl, err := a.app.WorklogService.StopTimer(ctx, claims.UUID, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostTimerStop200JSONResponse(dto.NewWorklogDTO(wl, a.app.DictionaryService)), nil
+}
+
+func (a *Web) GetWorklogReport(ctx context.Context, request oapi.GetWorklogReportRequestObject) (oapi.GetWorklogReportResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if !request.Params.DateTo.After(request.Params.DateFrom) {
+		return nil, errors.New("дата окончания должна быть больше даты начала")
+	}
+
+	items, err := a.app.WorklogService.Report(request.Params.FederationUuid, request.Params.ProjectUuid, request.Params.DateFrom, request.Params.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := lo.Map(items, func(item domain.WorklogReportItem, _ int) dto.WorklogReportItemDTO {
+		return dto.NewWorklogReportItemDTO(item, a.app.DictionaryService)
+	})
+
+	return oapi.GetWorklogReport200JSONResponse{
+		Count: len(dtos),
+		Items: dtos,
+	}, nil
+}
@@ -434,6 +434,26 @@ func (a *Web) Init() *echo.Echo {
 			return
 		}
 
+		var ruleErr domain.FieldRuleError
+		if errors.As(err, &ruleErr) {
+			//nolint
+			c.JSON(http.StatusBadRequest, ValidationError{
+				StatusCode: http.StatusBadRequest,
+				Errors:     ruleErr.Errors,
+			})
+			return
+		}
+
+		var graphErr domain.StatusGraphError
+		if errors.As(err, &graphErr) {
+			//nolint
+			c.JSON(http.StatusBadRequest, ValidationError{
+				StatusCode: http.StatusBadRequest,
+				Errors:     graphErr.Messages(),
+			})
+			return
+		}
+
 		var httpError *echo.HTTPError
 		if errors.As(err, &httpError) {
 			message, err := httpError.Message.(string)
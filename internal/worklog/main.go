@@ -0,0 +1,154 @@
+package worklog
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/task"
+	"github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	repo *Repository
+	ts   *task.Service
+}
+
+func New(repo *Repository, ts *task.Service) *Service {
+	return &Service{
+		repo: repo,
+		ts:   ts,
+	}
+}
+
+func (s *Service) GetByTask(taskUUID uuid.UUID) ([]domain.Worklog, error) {
+	return s.repo.GetByTask(taskUUID)
+}
+
+// Total returns seconds logged on the task and on the task with its children.
+func (s *Service) Total(taskUUID uuid.UUID) (own, withChildren int64, err error) {
+	return s.repo.Total(taskUUID)
+}
+
+func (s *Service) Create(ctx context.Context, taskUUID, userUUID uuid.UUID, startedAt time.Time, duration int, comment string) (wl domain.Worklog, err error) {
+	t, err := s.ts.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return wl, err
+	}
+
+	wl, err = domain.NewWorklog(t, userUUID, startedAt, duration, comment)
+	if err != nil {
+		return wl, err
+	}
+
+	err = s.repo.Create(wl)
+	if err != nil {
+		return wl, err
+	}
+
+	return wl, s.ts.UpdateDurationTotal(t)
+}
+
+func (s *Service) Delete(ctx context.Context, taskUUID, uid, userUUID uuid.UUID) error {
+	wl, err := s.repo.Get(uid)
+	if err != nil {
+		return err
+	}
+
+	if wl.TaskUUID != taskUUID || wl.UserUUID != userUUID {
+		return dto.NotFoundErr("запись о работе не найдена")
+	}
+
+	err = s.repo.Delete(uid)
+	if err != nil {
+		return err
+	}
+
+	return s.updateDurationTotal(ctx, wl.TaskUUID)
+}
+
+// GetTimer returns running timer of the user.
+func (s *Service) GetTimer(userUUID uuid.UUID) (wl domain.Worklog, err error) {
+	wl, found, err := s.repo.GetRunning(userUUID)
+	if err != nil {
+		return wl, err
+	}
+
+	if !found {
+		return wl, dto.NotFoundErr("нет запущенного таймера")
+	}
+
+	return wl, nil
+}
+
+// StartTimer starts timer on the task. Running timer of the user is stopped first,
+// so every user has at most one running timer.
+func (s *Service) StartTimer(ctx context.Context, taskUUID, userUUID uuid.UUID) (wl domain.Worklog, err error) {
+	t, err := s.ts.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return wl, err
+	}
+
+	running, found, err := s.repo.GetRunning(userUUID)
+	if err != nil {
+		return wl, err
+	}
+
+	if found {
+		if running.TaskUUID == taskUUID {
+			return running, nil
+		}
+
+		_, err = s.stop(ctx, running, "")
+		if err != nil {
+			return wl, err
+		}
+	}
+
+	wl, err = domain.NewTimer(t, userUUID)
+	if err != nil {
+		return wl, err
+	}
+
+	return wl, s.repo.Create(wl)
+}
+
+func (s *Service) StopTimer(ctx context.Context, userUUID uuid.UUID, comment string) (wl domain.Worklog, err error) {
+	wl, err = s.GetTimer(userUUID)
+	if err != nil {
+		return wl, err
+	}
+
+	return s.stop(ctx, wl, comment)
+}
+
+func (s *Service) Report(federationUUID uuid.UUID, projectUUID *uuid.UUID, from, to time.Time) ([]domain.WorklogReportItem, error) {
+	return s.repo.Report(federationUUID, projectUUID, from, to)
+}
+
+func (s *Service) stop(ctx context.Context, wl domain.Worklog, comment string) (domain.Worklog, error) {
+	err := wl.Stop(time.Now(), comment)
+	if err != nil {
+		return wl, err
+	}
+
+	err = s.repo.Stop(wl)
+	if err != nil {
+		return wl, err
+	}
+
+	return wl, s.updateDurationTotal(ctx, wl.TaskUUID)
+}
+
+func (s *Service) updateDurationTotal(ctx context.Context, taskUUID uuid.UUID) error {
+	t, err := s.ts.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		// task could be deleted, worklog is saved anyway
+		logrus.WithField("task", taskUUID).Error("worklog task not found: ", err)
+		return nil
+	}
+
+	return s.ts.UpdateDurationTotal(t)
+}
@@ -0,0 +1,25 @@
+package worklog
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Worklog struct {
+	UUID           uuid.UUID `gorm:"<-:create;type:uuid;primary_key"`
+	TaskUUID       uuid.UUID `gorm:"<-:create;type:uuid"`
+	FederationUUID uuid.UUID `gorm:"<-:create;type:uuid"`
+	CompanyUUID    uuid.UUID `gorm:"<-:create;type:uuid"`
+	ProjectUUID    uuid.UUID `gorm:"<-:create;type:uuid"`
+	UserUUID       uuid.UUID `gorm:"<-:create;type:uuid"`
+
+	StartedAt time.Time `gorm:"type:timestamptz"`
+	Duration  int       `gorm:"type:int"`
+	Comment   string    `gorm:"type:text"`
+	IsRunning bool      `gorm:"type:boolean"`
+
+	CreatedAt time.Time `gorm:"<-:create;type:timestamptz"`
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
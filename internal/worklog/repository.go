@@ -0,0 +1,201 @@
+package worklog
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/samber/lo"
+)
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) Create(dm domain.Worklog) error {
+	orm := &Worklog{
+		UUID:           dm.UUID,
+		TaskUUID:       dm.TaskUUID,
+		FederationUUID: dm.FederationUUID,
+		CompanyUUID:    dm.CompanyUUID,
+		ProjectUUID:    dm.ProjectUUID,
+		UserUUID:       dm.UserUUID,
+		StartedAt:      dm.StartedAt,
+		Duration:       dm.Duration,
+		Comment:        dm.Comment,
+		IsRunning:      dm.IsRunning,
+		CreatedAt:      dm.CreatedAt,
+	}
+
+	return r.gorm.DB.Create(orm).Error
+}
+
+func (r *Repository) Get(uid uuid.UUID) (dm domain.Worklog, err error) {
+	orm := Worklog{}
+
+	res := r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at IS NULL").
+		Find(&orm)
+
+	if res.Error != nil {
+		return dm, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dm, dto.NotFoundErr("запись о работе не найдена")
+	}
+
+	return toDomain(orm), nil
+}
+
+func (r *Repository) GetByTask(taskUUID uuid.UUID) (dms []domain.Worklog, err error) {
+	orm := []Worklog{}
+
+	err = r.gorm.DB.
+		Where("task_uuid = ?", taskUUID).
+		Where("deleted_at IS NULL").
+		Order("started_at DESC").
+		Find(&orm).
+		Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	return lo.Map(orm, func(item Worklog, _ int) domain.Worklog {
+		return toDomain(item)
+	}), nil
+}
+
+// GetRunning returns running timer of the user, found is false when there is no timer.
+func (r *Repository) GetRunning(userUUID uuid.UUID) (dm domain.Worklog, found bool, err error) {
+	orm := Worklog{}
+
+	res := r.gorm.DB.
+		Where("user_uuid = ?", userUUID).
+		Where("is_running = true").
+		Where("deleted_at IS NULL").
+		Find(&orm)
+
+	if res.Error != nil || res.RowsAffected == 0 {
+		return dm, false, res.Error
+	}
+
+	return toDomain(orm), true, nil
+}
+
+// Stop saves stopped timer. It is applied only if timer is still running.
+func (r *Repository) Stop(dm domain.Worklog) error {
+	res := r.gorm.DB.
+		Model(&Worklog{}).
+		Where("uuid = ?", dm.UUID).
+		Where("is_running = true").
+		Where("deleted_at IS NULL").
+		Updates(map[string]interface{}{
+			"is_running": false,
+			"duration":   dm.Duration,
+			"comment":    dm.Comment,
+			"updated_at": "now()",
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrWorklogTimerStopped
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&Worklog{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at IS NULL").
+		Update("deleted_at", "now()")
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("запись о работе не найдена")
+	}
+
+	return nil
+}
+
+// Total returns seconds logged on the task itself and on the task with all its children.
+func (r *Repository) Total(taskUUID uuid.UUID) (own, withChildren int64, err error) {
+	err = r.gorm.DB.Raw(`
+		SELECT COALESCE(SUM(w.duration), 0)
+		FROM worklogs w
+		WHERE w.task_uuid = ? AND w.deleted_at IS NULL`, taskUUID).
+		Scan(&own).
+		Error
+
+	if err != nil {
+		return own, withChildren, err
+	}
+
+	err = r.gorm.DB.Raw(`
+		SELECT COALESCE(SUM(w.duration), 0)
+		FROM worklogs w
+		JOIN tasks t ON t.uuid = w.task_uuid
+		WHERE t.path ~ ? AND t.deleted_at IS NULL AND w.deleted_at IS NULL`, "*."+taskUUID.String()+".*").
+		Scan(&withChildren).
+		Error
+
+	return own, withChildren, err
+}
+
+// Report returns seconds spent per user per project, stopped worklogs started in [from, to) are counted.
+func (r *Repository) Report(federationUUID uuid.UUID, projectUUID *uuid.UUID, from, to time.Time) (items []domain.WorklogReportItem, err error) {
+	q := r.gorm.DB.
+		Model(&Worklog{}).
+		Select("user_uuid, project_uuid, SUM(duration) AS duration").
+		Where("federation_uuid = ?", federationUUID).
+		Where("started_at >= ? AND started_at < ?", from, to).
+		Where("is_running = false").
+		Where("deleted_at IS NULL")
+
+	if projectUUID != nil {
+		q = q.Where("project_uuid = ?", *projectUUID)
+	}
+
+	err = q.
+		Group("user_uuid, project_uuid").
+		Order("project_uuid, duration DESC").
+		Scan(&items).
+		Error
+
+	return items, err
+}
+
+func toDomain(orm Worklog) domain.Worklog {
+	return domain.Worklog{
+		UUID:           orm.UUID,
+		TaskUUID:       orm.TaskUUID,
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+		ProjectUUID:    orm.ProjectUUID,
+		UserUUID:       orm.UserUUID,
+		StartedAt:      orm.StartedAt,
+		Duration:       orm.Duration,
+		Comment:        orm.Comment,
+		IsRunning:      orm.IsRunning,
+		CreatedAt:      orm.CreatedAt,
+		UpdatedAt:      orm.UpdatedAt,
+	}
+}
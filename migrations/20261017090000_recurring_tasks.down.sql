@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS template_occurrences;
+
+DROP INDEX IF EXISTS templates_next_run_at_idx;
+
+ALTER TABLE
+    "public"."templates" DROP COLUMN "schedule",
+    DROP COLUMN "is_paused",
+    DROP COLUMN "next_run_at",
+    DROP COLUMN "last_run_at";
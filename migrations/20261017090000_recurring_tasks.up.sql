@@ -0,0 +1,23 @@
+ALTER TABLE
+    "public"."templates"
+ADD
+    COLUMN "schedule" varchar(255),
+ADD
+    COLUMN "is_paused" boolean NOT NULL DEFAULT false,
+ADD
+    COLUMN "next_run_at" timestamptz,
+ADD
+    COLUMN "last_run_at" timestamptz;
+
+CREATE INDEX templates_next_run_at_idx ON templates (next_run_at)
+WHERE
+    deleted_at IS NULL
+    AND is_paused = false;
+
+CREATE TABLE template_occurrences (
+    "template_uuid" uuid NOT NULL REFERENCES templates (uuid) ON DELETE CASCADE,
+    "occurrence_at" timestamptz NOT NULL,
+    "task_uuid" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("template_uuid", "occurrence_at")
+);
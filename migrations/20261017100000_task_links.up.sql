@@ -0,0 +1,18 @@
+CREATE TABLE task_links (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "from_uuid" uuid NOT NULL,
+    "to_uuid" uuid NOT NULL,
+    "type" varchar(20) NOT NULL,
+    "created_by" varchar(100) NOT NULL DEFAULT '',
+    "created_by_uuid" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE UNIQUE INDEX task_links_unique_idx ON task_links (from_uuid, to_uuid, type)
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX task_links_to_uuid_idx ON task_links (to_uuid)
+WHERE
+    deleted_at IS NULL;
@@ -0,0 +1,29 @@
+CREATE TABLE worklogs (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "task_uuid" uuid NOT NULL,
+    "federation_uuid" uuid NOT NULL,
+    "company_uuid" uuid NOT NULL,
+    "project_uuid" uuid NOT NULL,
+    "user_uuid" uuid NOT NULL,
+    "started_at" timestamptz NOT NULL,
+    "duration" int NOT NULL DEFAULT 0,
+    "comment" text NOT NULL DEFAULT '',
+    "is_running" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX worklogs_task_uuid_idx ON worklogs (task_uuid)
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX worklogs_project_started_at_idx ON worklogs (project_uuid, started_at)
+WHERE
+    deleted_at IS NULL;
+
+-- one running timer per user
+CREATE UNIQUE INDEX worklogs_running_timer_idx ON worklogs (user_uuid)
+WHERE
+    is_running = true
+    AND deleted_at IS NULL;
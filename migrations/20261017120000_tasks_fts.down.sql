@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS comments_search_vector_idx;
+
+ALTER TABLE
+    "public"."comments" DROP COLUMN "search_vector";
+
+DROP INDEX IF EXISTS tasks_search_vector_idx;
+
+ALTER TABLE
+    "public"."tasks" DROP COLUMN "search_vector";
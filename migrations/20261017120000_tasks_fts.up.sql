@@ -0,0 +1,18 @@
+-- russian config for words, simple config keeps identifiers and codes as is
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(name, '')), 'A') || setweight(to_tsvector('simple', coalesce(name, '')), 'A') || setweight(to_tsvector('russian', coalesce(description, '')), 'B') || setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX tasks_search_vector_idx ON tasks USING GIN (search_vector);
+
+ALTER TABLE
+    "public"."comments"
+ADD
+    COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(comment, '')), 'C') || setweight(to_tsvector('simple', coalesce(comment, '')), 'C')
+    ) STORED;
+
+CREATE INDEX comments_search_vector_idx ON comments USING GIN (search_vector);
@@ -0,0 +1,19 @@
+CREATE TABLE task_views (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL REFERENCES federations (uuid) ON DELETE CASCADE,
+    "project_uuid" uuid NOT NULL REFERENCES projects (uuid) ON DELETE CASCADE,
+    "created_by" uuid NOT NULL REFERENCES users (uuid) ON DELETE CASCADE,
+    "name" varchar(100) NOT NULL,
+    "is_shared" boolean NOT NULL DEFAULT false,
+    "filter" jsonb NOT NULL DEFAULT '{}' :: jsonb,
+    "order" varchar(50),
+    "by" varchar(4),
+    "columns" jsonb NOT NULL DEFAULT '[]' :: jsonb,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX task_views_project_idx ON task_views (project_uuid, created_by)
+WHERE
+    deleted_at IS NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS tasks_board_rank_idx;
+
+ALTER TABLE
+    "public"."tasks" DROP COLUMN "board_rank";
@@ -0,0 +1,9 @@
+-- ranks are compared bytewise, see domain.RankBetween
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "board_rank" varchar(255) COLLATE "C" NOT NULL DEFAULT '';
+
+CREATE INDEX tasks_board_rank_idx ON tasks (project_uuid, status, board_rank)
+WHERE
+    deleted_at IS NULL;
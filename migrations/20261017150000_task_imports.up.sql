@@ -0,0 +1,19 @@
+CREATE TABLE task_imports (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL REFERENCES federations (uuid) ON DELETE CASCADE,
+    "company_uuid" uuid NOT NULL,
+    "project_uuid" uuid NOT NULL REFERENCES projects (uuid) ON DELETE CASCADE,
+    "created_by" varchar(100) NOT NULL,
+    "created_by_uuid" uuid NOT NULL REFERENCES users (uuid) ON DELETE CASCADE,
+    "file_name" varchar(255) NOT NULL DEFAULT '',
+    "header" jsonb NOT NULL DEFAULT '[]' :: jsonb,
+    "rows" jsonb NOT NULL DEFAULT '[]' :: jsonb,
+    "mapping" jsonb NOT NULL DEFAULT '{}' :: jsonb,
+    "status" varchar(20) NOT NULL DEFAULT 'uploaded',
+    "errors" jsonb NOT NULL DEFAULT '[]' :: jsonb,
+    "created" integer NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX task_imports_project_idx ON task_imports (project_uuid, created_at);
@@ -0,0 +1,15 @@
+CREATE TABLE task_exports (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL REFERENCES federations (uuid) ON DELETE CASCADE,
+    "project_uuid" uuid NOT NULL REFERENCES projects (uuid) ON DELETE CASCADE,
+    "created_by_uuid" uuid NOT NULL REFERENCES users (uuid) ON DELETE CASCADE,
+    "format" varchar(10) NOT NULL,
+    "status" varchar(20) NOT NULL DEFAULT 'running',
+    "file_uuid" uuid,
+    "count" integer NOT NULL DEFAULT 0,
+    "error" text NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "finished_at" timestamptz
+);
+
+CREATE INDEX task_exports_created_by_idx ON task_exports (created_by_uuid, created_at);
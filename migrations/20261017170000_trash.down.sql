@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS tasks_deleted_at_idx;
+DROP INDEX IF EXISTS comments_deleted_at_idx;
+DROP INDEX IF EXISTS files_deleted_at_idx;
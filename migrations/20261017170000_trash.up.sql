@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS tasks_deleted_at_idx ON tasks (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS comments_deleted_at_idx ON comments (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS files_deleted_at_idx ON files (deleted_at) WHERE deleted_at IS NOT NULL AND to_deleted_at IS NULL;
@@ -0,0 +1,27 @@
+-- ranks are compared bytewise, see domain.RankBetween
+CREATE TABLE task_checklist_items (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "task_uuid" uuid NOT NULL,
+    "text" varchar(500) NOT NULL DEFAULT '',
+    "done" boolean NOT NULL DEFAULT false,
+    "done_at" timestamptz,
+    "done_by" varchar(100) NOT NULL DEFAULT '',
+    "assigned_to" varchar(100) NOT NULL DEFAULT '',
+    "due_at" timestamptz,
+    "rank" varchar(255) COLLATE "C" NOT NULL DEFAULT '',
+    "created_by" varchar(100) NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX task_checklist_items_task_idx ON task_checklist_items (task_uuid, rank)
+WHERE
+    deleted_at IS NULL;
+
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "checklist_total" int NOT NULL DEFAULT 0,
+ADD
+    COLUMN "checklist_done" int NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE
+    "public"."company_fields" DROP COLUMN "formula";
@@ -0,0 +1,4 @@
+ALTER TABLE
+    "public"."company_fields"
+ADD
+    COLUMN "formula" text NOT NULL DEFAULT '';
@@ -0,0 +1,34 @@
+CREATE TABLE automation_rules (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "federation_uuid" uuid NOT NULL,
+    "company_uuid" uuid NOT NULL,
+    "project_uuid" uuid NOT NULL,
+    "name" varchar(100) NOT NULL DEFAULT '',
+    "enabled" boolean NOT NULL DEFAULT true,
+    "trigger" varchar(50) NOT NULL,
+    "trigger_params" jsonb NOT NULL DEFAULT '{}',
+    "conditions" jsonb NOT NULL DEFAULT '[]',
+    "actions" jsonb NOT NULL DEFAULT '[]',
+    "created_by" varchar(100) NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    "deleted_at" timestamptz
+);
+
+CREATE INDEX automation_rules_project_trigger_idx ON automation_rules (project_uuid, trigger)
+WHERE
+    deleted_at IS NULL;
+
+CREATE TABLE automation_runs (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "rule_uuid" uuid NOT NULL,
+    "task_uuid" uuid NOT NULL,
+    "trigger" varchar(50) NOT NULL,
+    "depth" int NOT NULL DEFAULT 0,
+    "status" varchar(20) NOT NULL,
+    "error" text NOT NULL DEFAULT '',
+    "actions" int NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX automation_runs_rule_created_at_idx ON automation_runs (rule_uuid, created_at);
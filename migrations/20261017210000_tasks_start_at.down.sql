@@ -0,0 +1,2 @@
+ALTER TABLE
+    "public"."tasks" DROP COLUMN "start_at";
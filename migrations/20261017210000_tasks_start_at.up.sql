@@ -0,0 +1,4 @@
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "start_at" timestamptz DEFAULT NULL;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS project_snapshots;
+
+ALTER TABLE
+    "public"."tasks" DROP COLUMN "estimate",
+    DROP COLUMN "estimate_total";
@@ -0,0 +1,21 @@
+ALTER TABLE
+    "public"."tasks"
+ADD
+    COLUMN "estimate" numeric DEFAULT NULL,
+ADD
+    COLUMN "estimate_total" numeric NOT NULL DEFAULT 0;
+
+CREATE TABLE project_snapshots (
+    "uuid" uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "project_uuid" uuid NOT NULL,
+    "date" date NOT NULL,
+    "total" numeric NOT NULL DEFAULT 0,
+    "completed" numeric NOT NULL DEFAULT 0,
+    "remaining" numeric NOT NULL DEFAULT 0,
+    "tasks_total" int NOT NULL DEFAULT 0,
+    "tasks_done" int NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+
+-- one snapshot per project and day, it is overwritten until the day ends
+CREATE UNIQUE INDEX project_snapshots_project_date_idx ON project_snapshots (project_uuid, date);
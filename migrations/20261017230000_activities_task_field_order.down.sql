@@ -0,0 +1,5 @@
+-- the swap is symmetric, so it is reverted by the same update
+UPDATE activities
+SET meta = jsonb_set(jsonb_set(meta, '{old}', COALESCE(meta->'new', 'null'::jsonb)), '{new}', COALESCE(meta->'old', 'null'::jsonb))
+WHERE type = 1
+  AND meta->>'name' IN ('name', 'priority', 'tags', 'fields', 'description');
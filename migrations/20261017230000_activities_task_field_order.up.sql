@@ -0,0 +1,5 @@
+-- task updates and renames wrote the new value to "old" and the old one to "new", changes of the project were written right
+UPDATE activities
+SET meta = jsonb_set(jsonb_set(meta, '{old}', COALESCE(meta->'new', 'null'::jsonb)), '{new}', COALESCE(meta->'old', 'null'::jsonb))
+WHERE type = 1
+  AND meta->>'name' IN ('name', 'priority', 'tags', 'fields', 'description');
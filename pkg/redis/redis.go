@@ -69,6 +69,11 @@ func (rds *RDS) SetStr(ctx context.Context, key, value string, ttl int) error {
 	return err
 }
 
+// SetNX sets the key only if it does not exist, ttl - in seconds.
+func (rds *RDS) SetNX(ctx context.Context, key, value string, ttl int) (bool, error) {
+	return rds.rdb.SetNX(ctx, key, value, time.Duration(ttl)*time.Second).Result()
+}
+
 func (rds *RDS) Del(ctx context.Context, key string) error {
 	err := rds.rdb.Del(ctx, key).Err()
 